@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
+
+const (
+	AuthorizationHeader = "authorization"
+	ApiKeyHeader        = "x-api-key"
+	bearerPrefix        = "bearer "
+)
+
+// Authenticator resolves the caller of a request from a service API key or a
+// bearer token
+type Authenticator struct {
+	verifier    *Verifier
+	credentials []config.ServiceCredentialConfig
+}
+
+func NewAuthenticator(
+	authConfig config.AuthConfig,
+	credentials []config.ServiceCredentialConfig,
+) (*Authenticator, *apperrors.AppError) {
+	verifier, err := NewVerifier(authConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, credential := range credentials {
+		if credential.Name == "" || credential.ApiKey == "" {
+			return nil, apperrors.New(apperrors.CodeInvalidInput, "service credentials require a name and an api key")
+		}
+	}
+
+	return &Authenticator{
+		verifier:    verifier,
+		credentials: credentials,
+	}, nil
+}
+
+// UnaryServerInterceptor authenticates calls and enforces the method policies.
+// The caller is put into the context, public methods may be called anonymously.
+func UnaryServerInterceptor(authenticator *Authenticator, policies MethodPolicies) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		policy, exists := policies[info.FullMethod]
+		if !exists {
+			return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeForbidden, "method is not allowed"))
+		}
+
+		principal, authenticated, err := authenticator.authenticate(ctx)
+		if err != nil {
+			return nil, apperrors.ToGRPCError(err)
+		}
+
+		if !authenticated {
+			if policy.Public {
+				return handler(ctx, req)
+			}
+			return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeUnauthorized, "missing credentials"))
+		}
+
+		if !policy.Public && !policy.allows(principal) {
+			return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeForbidden, "caller is not allowed to call this method"))
+		}
+
+		return handler(WithPrincipal(ctx, principal), req)
+	}
+}
+
+// ServiceCredentialsClientInterceptor authenticates outgoing calls with the
+// service api key
+func ServiceCredentialsClientInterceptor(apiKey string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if apiKey != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, ApiKeyHeader, apiKey)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func (a *Authenticator) authenticate(ctx context.Context) (Principal, bool, *apperrors.AppError) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Principal{}, false, nil
+	}
+
+	if values := md.Get(ApiKeyHeader); len(values) > 0 {
+		service, found := a.serviceByApiKey(values[0])
+		if !found {
+			return Principal{}, false, apperrors.New(apperrors.CodeUnauthorized, "invalid api key")
+		}
+		return Principal{Role: RoleService, Service: service}, true, nil
+	}
+
+	values := md.Get(AuthorizationHeader)
+	if len(values) == 0 {
+		return Principal{}, false, nil
+	}
+
+	if len(values[0]) <= len(bearerPrefix) || !strings.EqualFold(values[0][:len(bearerPrefix)], bearerPrefix) {
+		return Principal{}, false, apperrors.New(apperrors.CodeUnauthorized, "authorization header must be a bearer token")
+	}
+
+	principal, err := a.verifier.Verify(strings.TrimSpace(values[0][len(bearerPrefix):]))
+	if err != nil {
+		return Principal{}, false, err
+	}
+
+	return principal, true, nil
+}
+
+func (a *Authenticator) serviceByApiKey(apiKey string) (string, bool) {
+	for _, credential := range a.credentials {
+		if subtle.ConstantTimeCompare([]byte(credential.ApiKey), []byte(apiKey)) == 1 {
+			return credential.Name, true
+		}
+	}
+	return "", false
+}
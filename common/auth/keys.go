@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+)
+
+type verificationKey struct {
+	method jwt.SigningMethod
+	key    any
+}
+
+// KeySet holds the verification keys indexed by their kid
+type KeySet struct {
+	keys map[string]verificationKey
+}
+
+func LoadKeySet(cfgs []config.AuthKeyConfig) (*KeySet, *apperrors.AppError) {
+	if len(cfgs) == 0 {
+		return nil, apperrors.New(apperrors.CodeInvalidInput, "auth requires at least one key")
+	}
+
+	keySet := &KeySet{keys: make(map[string]verificationKey, len(cfgs))}
+
+	for _, cfg := range cfgs {
+		if cfg.Id == "" {
+			return nil, apperrors.New(apperrors.CodeInvalidInput, "auth key id is required")
+		}
+		if _, exists := keySet.keys[cfg.Id]; exists {
+			return nil, apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("duplicate auth key id: %s", cfg.Id))
+		}
+
+		key, err := loadKey(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		keySet.keys[cfg.Id] = key
+	}
+
+	return keySet, nil
+}
+
+func loadKey(cfg config.AuthKeyConfig) (verificationKey, *apperrors.AppError) {
+	switch strings.ToUpper(cfg.Algorithm) {
+	case AlgorithmHS256:
+		secret := []byte(cfg.Secret)
+		if cfg.SecretEnv != "" {
+			secret = []byte(os.Getenv(cfg.SecretEnv))
+		}
+		if cfg.KeyFile != "" {
+			content, err := os.ReadFile(cfg.KeyFile)
+			if err != nil {
+				return verificationKey{}, apperrors.Wrap(err, apperrors.CodeInternalServer,
+					fmt.Sprintf("failed to read auth key file for key: %s", cfg.Id))
+			}
+			secret = []byte(strings.TrimSpace(string(content)))
+		}
+
+		if len(secret) == 0 {
+			return verificationKey{}, apperrors.New(apperrors.CodeInvalidInput,
+				fmt.Sprintf("auth key %s has an empty secret", cfg.Id))
+		}
+
+		return verificationKey{method: jwt.SigningMethodHS256, key: secret}, nil
+
+	case AlgorithmRS256:
+		content, err := os.ReadFile(cfg.KeyFile)
+		if err != nil {
+			return verificationKey{}, apperrors.Wrap(err, apperrors.CodeInternalServer,
+				fmt.Sprintf("failed to read auth key file for key: %s", cfg.Id))
+		}
+
+		publicKey, err := jwt.ParseRSAPublicKeyFromPEM(content)
+		if err != nil {
+			return verificationKey{}, apperrors.Wrap(err, apperrors.CodeInvalidInput,
+				fmt.Sprintf("auth key %s is not a PEM encoded RSA public key", cfg.Id))
+		}
+
+		return verificationKey{method: jwt.SigningMethodRS256, key: publicKey}, nil
+
+	default:
+		return verificationKey{}, apperrors.New(apperrors.CodeInvalidInput,
+			fmt.Sprintf("unsupported auth key algorithm: %s", cfg.Algorithm))
+	}
+}
+
+func (k *KeySet) lookup(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, exists := k.keys[kid]
+	if !exists {
+		return nil, fmt.Errorf("unknown key id: %q", kid)
+	}
+
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %s for key %s", token.Method.Alg(), kid)
+	}
+
+	return key.key, nil
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"slices"
+)
+
+// Policy lists the callers allowed on a gRPC method. Roles are matched against
+// token roles, Services against the names of service credentials.
+type Policy struct {
+	Public   bool
+	Roles    []Role
+	Services []string
+}
+
+// MethodPolicies maps full gRPC method names to their policy. Methods without
+// a policy are denied.
+type MethodPolicies map[string]Policy
+
+func Public() Policy {
+	return Policy{Public: true}
+}
+
+func AllowRoles(roles ...Role) Policy {
+	return Policy{Roles: roles}
+}
+
+func AllowServices(services ...string) Policy {
+	return Policy{Services: services}
+}
+
+// AndServices extends the policy with the given service callers
+func (p Policy) AndServices(services ...string) Policy {
+	p.Services = append(slices.Clone(p.Services), services...)
+	return p
+}
+
+func (p Policy) allows(principal Principal) bool {
+	if principal.Role == RoleService {
+		return slices.Contains(p.Services, principal.Service)
+	}
+	return slices.Contains(p.Roles, principal.Role)
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
+
+type Role string
+
+const (
+	RolePlayer  Role = "player"
+	RoleAdmin   Role = "admin"
+	RoleService Role = "service"
+)
+
+// Service credential names used in method policies
+const (
+	ServiceUser        = "user-service"
+	ServiceTournament  = "tournament-service"
+	ServiceLeaderboard = "leaderboard-service"
+)
+
+// Principal is the authenticated caller. Players and admins are identified by
+// the token subject, services by the name of their credential.
+type Principal struct {
+	Subject string
+	Role    Role
+	Service string
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// SubjectFromContext returns the authenticated user id of the call, if any
+func SubjectFromContext(ctx context.Context) (string, bool) {
+	principal, ok := PrincipalFromContext(ctx)
+	return principal.Subject, ok && principal.Subject != ""
+}
+
+// ResolveUserId returns the user id a handler should act on. Players act on
+// their token subject and a differing user id in the request is rejected.
+// Services and admins act on the requested user id, admins default to
+// themselves. Unauthenticated calls fall back to the requested user id.
+func ResolveUserId(ctx context.Context, requestedUserId string) (string, *apperrors.AppError) {
+	principal, ok := PrincipalFromContext(ctx)
+
+	switch {
+	case !ok || principal.Role == RoleService:
+		if requestedUserId == "" {
+			return "", apperrors.New(apperrors.CodeInvalidInput, "user id is required")
+		}
+		return requestedUserId, nil
+
+	case principal.Role == RoleAdmin:
+		if requestedUserId == "" {
+			return principal.Subject, nil
+		}
+		return requestedUserId, nil
+
+	default:
+		if requestedUserId != "" && requestedUserId != principal.Subject {
+			return "", apperrors.New(apperrors.CodeForbidden, "user id does not match the authenticated user")
+		}
+		return principal.Subject, nil
+	}
+}
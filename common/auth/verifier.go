@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
+
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Role string `json:"role,omitempty"`
+}
+
+// Verifier validates signed JWTs against a key set
+type Verifier struct {
+	keySet *KeySet
+	parser *jwt.Parser
+}
+
+func NewVerifier(cfg config.AuthConfig) (*Verifier, *apperrors.AppError) {
+	keySet, err := LoadKeySet(cfg.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{AlgorithmHS256, AlgorithmRS256}),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &Verifier{
+		keySet: keySet,
+		parser: jwt.NewParser(options...),
+	}, nil
+}
+
+// Verify checks the token signature and registered claims and returns the
+// user it was issued to. Tokens without a role claim belong to players.
+func (v *Verifier) Verify(tokenString string) (Principal, *apperrors.AppError) {
+	var claims tokenClaims
+
+	if _, err := v.parser.ParseWithClaims(tokenString, &claims, v.keySet.lookup); err != nil {
+		return Principal{}, apperrors.Wrap(err, apperrors.CodeUnauthorized, "invalid access token")
+	}
+
+	if claims.Subject == "" {
+		return Principal{}, apperrors.New(apperrors.CodeUnauthorized, "access token has no subject")
+	}
+
+	role := Role(claims.Role)
+	switch role {
+	case "":
+		role = RolePlayer
+	case RolePlayer, RoleAdmin:
+	default:
+		return Principal{}, apperrors.New(apperrors.CodeUnauthorized, "access token has an invalid role")
+	}
+
+	return Principal{Subject: claims.Subject, Role: role}, nil
+}
@@ -1,16 +1,31 @@
 package config
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	AWS      AWSConfig
-	DynamoDB DynamoDBConfig
-	Server   ServerConfig
-	NATS     NATSConfig
-	Redis    RedisConfig
+	AWS          AWSConfig
+	DynamoDB     DynamoDBConfig
+	Server       ServerConfig
+	NATS         NATSConfig
+	Redis        RedisConfig
+	Progression  ProgressionConfig
+	DisplayName  DisplayNameConfig
+	Auth         AuthConfig
+	Idempotency  IdempotencyConfig
+	DailyReward  DailyRewardConfig
+	Achievements AchievementsConfig
+	Friends      FriendsConfig
+	Inbox        InboxConfig
+	Push         PushConfig
+	Referrals    ReferralsConfig
+	Seasons      SeasonsConfig
 }
 
 type AWSConfig struct {
@@ -34,6 +49,22 @@ type ServerConfig struct {
 	LogLevel                  string
 	UserServiceAddress        string
 	LeaderboardServiceAddress string
+	// ApiKey authenticates this service on its calls to other services, it is
+	// read from the ApiKeyEnv environment variable or from ApiKeyFile when set
+	ApiKey     string
+	ApiKeyEnv  string
+	ApiKeyFile string
+	// ServiceCredentials are the services allowed to call this server
+	ServiceCredentials []ServiceCredentialConfig
+}
+
+// ServiceCredentialConfig is a service allowed to call this server, its key is
+// read like the ApiKey of the server
+type ServiceCredentialConfig struct {
+	Name       string
+	ApiKey     string
+	ApiKeyEnv  string
+	ApiKeyFile string
 }
 
 type NATSConfig struct {
@@ -43,6 +74,148 @@ type NATSConfig struct {
 	TimeoutSeconds       int
 }
 
+// ProgressionConfig tunes the user XP curve. XPTable takes precedence over the
+// XPBase/XPExponent formula, MilestoneRewards maps a level to its bonus coin.
+type ProgressionConfig struct {
+	XPTable          []int
+	XPBase           int
+	XPExponent       float64
+	LevelCap         int
+	PrestigeEnabled  bool
+	RewardPerLevel   int
+	MilestoneRewards map[string]int
+}
+
+// DisplayNameConfig is the user display name policy, Blocklist entries are
+// matched case-insensitively against whole words of the name
+type DisplayNameConfig struct {
+	MinLength int
+	MaxLength int
+	Blocklist []string
+}
+
+// AuthConfig is the JWT verification setup shared by every gRPC server. Tokens
+// are accepted when their kid header matches one of Keys, Issuer and Audience
+// are only checked when set.
+type AuthConfig struct {
+	Enabled  bool
+	Issuer   string
+	Audience string
+	Keys     []AuthKeyConfig
+}
+
+// AuthKeyConfig is a single verification key. HS256 keys take the shared
+// secret inline, from the SecretEnv environment variable or from KeyFile,
+// RS256 keys read a PEM public key from KeyFile.
+type AuthKeyConfig struct {
+	Id        string
+	Algorithm string
+	Secret    string
+	SecretEnv string
+	KeyFile   string
+}
+
+// IdempotencyConfig sets how long responses of idempotent requests are kept
+type IdempotencyConfig struct {
+	TTLHours int
+}
+
+// DailyRewardConfig tunes the login streak. Rewards[n] is paid on day n+1 of a
+// streak and the last entry repeats, Timezone is an IANA name deciding when a
+// day starts.
+type DailyRewardConfig struct {
+	Timezone          string
+	Rewards           []int
+	StreakFreezePrice int
+	MaxStreakFreezes  int
+}
+
+// AchievementsConfig declares the achievements, an achievement unlocks once
+// its metric reaches the threshold and pays Reward coins
+type AchievementsConfig struct {
+	Definitions []AchievementDefinitionConfig
+}
+
+type AchievementDefinitionConfig struct {
+	Id          string
+	Name        string
+	Description string
+	Metric      string
+	Threshold   int
+	Reward      int
+}
+
+// FriendsConfig limits the friends graph, MaxFriends counts pending requests too
+type FriendsConfig struct {
+	MaxFriends int
+}
+
+// InboxConfig keeps inbox messages for TTLDays. Templates override the default
+// title and body of a message type, they are Go text/templates.
+type InboxConfig struct {
+	TTLDays   int
+	Templates []InboxTemplateConfig
+}
+
+type InboxTemplateConfig struct {
+	Type  string
+	Title string
+	Body  string
+}
+
+// PushConfig configures the push dispatcher. MessageTypes selects the inbox
+// message types that are pushed, empty pushes every type. Provider is "log" or
+// "http". Workers is the number of users pushed to at the same time.
+type PushConfig struct {
+	Enabled            bool
+	Provider           string
+	MessageTypes       []string
+	BatchWindowSeconds int
+	MaxBatchSize       int
+	Workers            int
+	MaxPerHour         int
+	MaxAttempts        int
+	DeliveryTTLDays    int
+	HTTP               PushHTTPConfig
+	Log                PushLogConfig
+}
+
+type PushHTTPConfig struct {
+	URL            string
+	AuthToken      string
+	TimeoutSeconds int
+}
+
+// PushLogConfig writes pushes to the service log, and to FilePath as JSON lines when set
+type PushLogConfig struct {
+	FilePath string
+}
+
+// ReferralsConfig rewards both sides of a referral once the referee reaches the
+// milestone, TOURNAMENT_ENTERED or LEVEL with MilestoneLevel. A referrer is paid
+// for at most MaxRewardedReferrals referrals and their code accepts at most
+// MaxSignupsPerDay sign ups a day.
+type ReferralsConfig struct {
+	Milestone            string
+	MilestoneLevel       int
+	ReferrerReward       int
+	RefereeReward        int
+	MaxRewardedReferrals int
+	MaxSignupsPerDay     int
+}
+
+// SeasonsConfig is the season payout setup. PointsAckCutover is the RFC3339
+// time the leaderboard started to acknowledge applied season points, tournaments
+// finalized before it are paid without an ack.
+type SeasonsConfig struct {
+	PointsAckCutover string
+}
+
+const EnvironmentDevelopment = "development"
+
+// devApiKeyPrefix marks api keys meant for local runs only
+const devApiKeyPrefix = "dev-"
+
 type RedisConfig struct {
 	Address  string
 	Password string
@@ -67,5 +240,66 @@ func Load(configPath string) (*Config, *apperrors.AppError) {
 		return nil, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshall config")
 	}
 
+	// Every method policy is skipped without auth, only local development may run so
+	if !cfg.Auth.Enabled && cfg.Server.Environment != EnvironmentDevelopment {
+		return nil, apperrors.New(apperrors.CodeInternalServer, "auth can only be disabled in the development environment")
+	}
+
+	if err := resolveApiKeys(&cfg.Server); err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
+
+// resolveApiKeys reads the api keys of the server and its callers from their
+// environment variables or files. Outside development a service that calls
+// others needs its key and no key may be a dev key.
+func resolveApiKeys(server *ServerConfig) *apperrors.AppError {
+	development := server.Environment == EnvironmentDevelopment
+
+	apiKey, err := readApiKey("server", server.ApiKey, server.ApiKeyEnv, server.ApiKeyFile, development)
+	if err != nil {
+		return err
+	}
+	server.ApiKey = apiKey
+
+	callsServices := server.UserServiceAddress != "" || server.LeaderboardServiceAddress != ""
+	if callsServices && server.ApiKey == "" && !development {
+		return apperrors.New(apperrors.CodeInternalServer, "server api key is required outside the development environment")
+	}
+
+	for i, credential := range server.ServiceCredentials {
+		apiKey, err := readApiKey(credential.Name, credential.ApiKey, credential.ApiKeyEnv, credential.ApiKeyFile, development)
+		if err != nil {
+			return err
+		}
+		server.ServiceCredentials[i].ApiKey = apiKey
+	}
+
+	return nil
+}
+
+// readApiKey takes the key from the environment variable or file when one is
+// named, a named source that holds no key fails
+func readApiKey(owner, apiKey, apiKeyEnv, apiKeyFile string, development bool) (string, *apperrors.AppError) {
+	if apiKeyEnv != "" {
+		apiKey = os.Getenv(apiKeyEnv)
+	}
+	if apiKeyFile != "" {
+		content, err := os.ReadFile(apiKeyFile)
+		if err != nil {
+			return "", apperrors.Wrap(err, apperrors.CodeInternalServer, fmt.Sprintf("failed to read api key file of %s", owner))
+		}
+		apiKey = strings.TrimSpace(string(content))
+	}
+
+	if apiKey == "" && (apiKeyEnv != "" || apiKeyFile != "") {
+		return "", apperrors.New(apperrors.CodeInternalServer, fmt.Sprintf("api key of %s is empty", owner))
+	}
+	if strings.HasPrefix(apiKey, devApiKeyPrefix) && !development {
+		return "", apperrors.New(apperrors.CodeInternalServer, fmt.Sprintf("api key of %s is a development key", owner))
+	}
+
+	return apiKey, nil
+}
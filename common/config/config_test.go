@@ -0,0 +1,118 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveApiKeys(t *testing.T) {
+	t.Setenv("GOODSWIPE_TEST_API_KEY", "key-from-env")
+	t.Setenv("GOODSWIPE_TEST_EMPTY_API_KEY", "")
+
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	if err := os.WriteFile(keyFile, []byte("key-from-file\n"), 0o600); err != nil {
+		t.Fatalf("write key file: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		server         ServerConfig
+		wantApiKey     string
+		wantCredential string
+		wantErr        bool
+	}{
+		{
+			name:       "inline key",
+			server:     ServerConfig{Environment: "production", ApiKey: "key-inline"},
+			wantApiKey: "key-inline",
+		},
+		{
+			name:       "key from the environment",
+			server:     ServerConfig{Environment: "production", ApiKeyEnv: "GOODSWIPE_TEST_API_KEY"},
+			wantApiKey: "key-from-env",
+		},
+		{
+			name:       "key from a file",
+			server:     ServerConfig{Environment: "production", ApiKeyFile: keyFile},
+			wantApiKey: "key-from-file",
+		},
+		{
+			name: "credential from the environment",
+			server: ServerConfig{
+				Environment:        "production",
+				ServiceCredentials: []ServiceCredentialConfig{{Name: "tournament-service", ApiKeyEnv: "GOODSWIPE_TEST_API_KEY"}},
+			},
+			wantCredential: "key-from-env",
+		},
+		{
+			name:    "empty environment variable",
+			server:  ServerConfig{Environment: EnvironmentDevelopment, ApiKeyEnv: "GOODSWIPE_TEST_EMPTY_API_KEY"},
+			wantErr: true,
+		},
+		{
+			name: "empty credential",
+			server: ServerConfig{
+				Environment:        EnvironmentDevelopment,
+				ServiceCredentials: []ServiceCredentialConfig{{Name: "tournament-service", ApiKeyEnv: "GOODSWIPE_TEST_EMPTY_API_KEY"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			server:  ServerConfig{Environment: EnvironmentDevelopment, ApiKeyFile: filepath.Join(t.TempDir(), "missing")},
+			wantErr: true,
+		},
+		{
+			name:    "calls services without a key",
+			server:  ServerConfig{Environment: "production", UserServiceAddress: "user-service:9090"},
+			wantErr: true,
+		},
+		{
+			name:   "calls services without a key in development",
+			server: ServerConfig{Environment: EnvironmentDevelopment, UserServiceAddress: "user-service:9090"},
+		},
+		{
+			name:    "dev key",
+			server:  ServerConfig{Environment: "production", ApiKey: "dev-tournament"},
+			wantErr: true,
+		},
+		{
+			name: "dev credential",
+			server: ServerConfig{
+				Environment:        "production",
+				ServiceCredentials: []ServiceCredentialConfig{{Name: "tournament-service", ApiKey: "dev-tournament"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "dev key in development",
+			server:     ServerConfig{Environment: EnvironmentDevelopment, ApiKey: "dev-tournament"},
+			wantApiKey: "dev-tournament",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tt.server
+			err := resolveApiKeys(&server)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("resolveApiKeys succeeded, want an error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("resolveApiKeys: %v", err)
+			}
+			if server.ApiKey != tt.wantApiKey {
+				t.Errorf("api key = %q, want %q", server.ApiKey, tt.wantApiKey)
+			}
+			if tt.wantCredential != "" && server.ServiceCredentials[0].ApiKey != tt.wantCredential {
+				t.Errorf("credential api key = %q, want %q", server.ServiceCredentials[0].ApiKey, tt.wantCredential)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
+
+const (
+	// DynamoDB accepts at most 100 keys per batch get
+	batchGetLimit       = 100
+	batchGetMaxAttempts = 5
+	batchGetBaseBackoff = 50 * time.Millisecond
+)
+
+// BatchGetItems reads the items of the given keys in chunks of 100, retrying
+// unprocessed keys with backoff. Missing items are left out of the result,
+// the order of the result is not defined.
+func (c *DynamoDBClient) BatchGetItems(
+	ctx context.Context,
+	keys []map[string]types.AttributeValue,
+) ([]map[string]types.AttributeValue, *apperrors.AppError) {
+	items := make([]map[string]types.AttributeValue, 0, len(keys))
+
+	for start := 0; start < len(keys); start += batchGetLimit {
+		end := min(start+batchGetLimit, len(keys))
+
+		chunkItems, err := c.batchGet(ctx, keys[start:end])
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, chunkItems...)
+	}
+
+	return items, nil
+}
+
+func (c *DynamoDBClient) batchGet(
+	ctx context.Context,
+	keys []map[string]types.AttributeValue,
+) ([]map[string]types.AttributeValue, *apperrors.AppError) {
+	items := make([]map[string]types.AttributeValue, 0, len(keys))
+	pending := map[string]types.KeysAndAttributes{
+		c.Table(): {Keys: keys, ConsistentRead: aws.Bool(false)},
+	}
+
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt >= batchGetMaxAttempts {
+			return nil, apperrors.New(apperrors.CodeDatabaseError, "failed to get all items, unprocessed keys remain")
+		}
+
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, apperrors.Wrap(ctx.Err(), apperrors.CodeDatabaseError, "batch get cancelled")
+			case <-time.After(batchGetBaseBackoff << (attempt - 1)):
+			}
+		}
+
+		result, err := c.Client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{
+			RequestItems: pending,
+		})
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to batch get items")
+		}
+
+		items = append(items, result.Responses[c.Table()]...)
+		pending = result.UnprocessedKeys
+	}
+
+	return items, nil
+}
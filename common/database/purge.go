@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
+
+const (
+	// DynamoDB accepts at most 25 requests per batch write
+	batchWriteLimit       = 25
+	batchWriteMaxAttempts = 5
+)
+
+// DeleteByKeyPrefix deletes every item of the partition whose sort key starts
+// with skPrefix, an empty prefix deletes the whole partition. Returns the number
+// of deleted items.
+func (c *DynamoDBClient) DeleteByKeyPrefix(ctx context.Context, pk, skPrefix string) (int, *apperrors.AppError) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(c.Table()),
+		KeyConditionExpression: aws.String("PK = :pk"),
+		ProjectionExpression:   aws.String("PK, SK"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: pk},
+		},
+	}
+
+	if skPrefix != "" {
+		input.KeyConditionExpression = aws.String("PK = :pk AND begins_with(SK, :sk)")
+		input.ExpressionAttributeValues[":sk"] = &types.AttributeValueMemberS{Value: skPrefix}
+	}
+
+	deleted := 0
+	paginator := dynamodb.NewQueryPaginator(c.Client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return deleted, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to query items for deletion")
+		}
+
+		for start := 0; start < len(page.Items); start += batchWriteLimit {
+			end := min(start+batchWriteLimit, len(page.Items))
+
+			if err := c.batchDelete(ctx, page.Items[start:end]); err != nil {
+				return deleted, err
+			}
+			deleted += end - start
+		}
+	}
+
+	return deleted, nil
+}
+
+func (c *DynamoDBClient) batchDelete(ctx context.Context, keys []map[string]types.AttributeValue) *apperrors.AppError {
+	requests := make([]types.WriteRequest, 0, len(keys))
+	for _, key := range keys {
+		requests = append(requests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{Key: key},
+		})
+	}
+
+	pending := map[string][]types.WriteRequest{c.Table(): requests}
+
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt >= batchWriteMaxAttempts {
+			return apperrors.New(apperrors.CodeDatabaseError, "failed to delete all items, unprocessed items remain")
+		}
+
+		result, err := c.Client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: pending,
+		})
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to batch delete items")
+		}
+
+		pending = result.UnprocessedItems
+	}
+
+	return nil
+}
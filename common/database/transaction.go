@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
@@ -51,6 +52,16 @@ func (tb *TransactionBuilder) AddDelete(item types.Delete) *apperrors.AppError {
 	return nil
 }
 
+func (tb *TransactionBuilder) AddConditionCheck(item types.ConditionCheck) *apperrors.AppError {
+	if len(tb.items) >= tb.limit {
+		return apperrors.New(apperrors.CodeTransactionError, fmt.Sprintf("transaction limit exceeded: %d items", tb.limit))
+	}
+	tb.items = append(tb.items, types.TransactWriteItem{
+		ConditionCheck: &item,
+	})
+	return nil
+}
+
 func (tb *TransactionBuilder) Execute(ctx context.Context, client *dynamodb.Client) *apperrors.AppError {
 	if len(tb.items) == 0 {
 		return apperrors.New(apperrors.CodeTransactionError, "no items in transaction")
@@ -70,3 +81,18 @@ func (tb *TransactionBuilder) Execute(ctx context.Context, client *dynamodb.Clie
 func (tb *TransactionBuilder) Count() int {
 	return len(tb.items)
 }
+
+// IsConditionalCheckFailed reports whether the transaction item at index was cancelled by its condition
+func IsConditionalCheckFailed(err *apperrors.AppError, index int) bool {
+	if err == nil || err.Err == nil {
+		return false
+	}
+
+	var txErr *types.TransactionCanceledException
+	if !errors.As(err.Err, &txErr) || index < 0 || index >= len(txErr.CancellationReasons) {
+		return false
+	}
+
+	reason := txErr.CancellationReasons[index]
+	return reason.Code != nil && *reason.Code == "ConditionalCheckFailed"
+}
@@ -8,6 +8,7 @@ const (
 	CodeUnauthorized           = "UNAUTHORIZED"
 	CodeForbidden              = "FORBIDDEN"
 	CodeConflict               = "CONFLICT"
+	CodePreconditionFailed     = "PRECONDITION_FAILED"
 	CodeInternalServer         = "INTERNAL_SERVER"
 	CodeServiceUnavailable     = "SERVICE_UNAVAILABLE"
 	CodeEventPublishError      = "EVENT_PUBLISH_ERROR"
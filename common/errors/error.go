@@ -2,12 +2,15 @@ package errors
 
 import (
 	"fmt"
+
+	"google.golang.org/protobuf/protoadapt"
 )
 
 type AppError struct {
 	Code    string
 	Message string
 	Err     error
+	Details []protoadapt.MessageV1
 }
 
 func (e *AppError) Error() string {
@@ -36,3 +39,9 @@ func Wrap(err error, code, message string) *AppError {
 		Err:     err,
 	}
 }
+
+// WithDetails attaches structured details that are forwarded as gRPC status details
+func (e *AppError) WithDetails(details ...protoadapt.MessageV1) *AppError {
+	e.Details = append(e.Details, details...)
+	return e
+}
@@ -15,7 +15,13 @@ func ToGRPCError(err *AppError) error {
 	var appErr *AppError
 	if errors.As(err, &appErr) {
 		code := mapErrorCodeToGRPC(appErr.Code)
-		return status.Error(code, appErr.Message)
+		st := status.New(code, appErr.Message)
+		if len(appErr.Details) > 0 {
+			if detailed, err := st.WithDetails(appErr.Details...); err == nil {
+				st = detailed
+			}
+		}
+		return st.Err()
 	}
 
 	return status.Error(codes.Internal, err.Error())
@@ -35,6 +41,8 @@ func mapErrorCodeToGRPC(code string) codes.Code {
 		return codes.PermissionDenied
 	case CodeConflict:
 		return codes.Aborted
+	case CodePreconditionFailed:
+		return codes.FailedPrecondition
 	case CodeServiceUnavailable:
 		return codes.Unavailable
 	default:
@@ -2,17 +2,38 @@ package events
 
 const (
 	// Streams
-	UserEventsStream       = "USER_EVENTS"
-	TournamentEventsStream = "TOURNAMENT_EVENTS"
+	UserEventsStream        = "USER_EVENTS"
+	TournamentEventsStream  = "TOURNAMENT_EVENTS"
+	PurgeEventsStream       = "PURGE_EVENTS"
+	LeaderboardEventsStream = "LEADERBOARD_EVENTS"
 
 	// Events
 	UserCreated = "events.user.created"
 	UserLevelUp = "events.user.levelUp"
 
+	UserDisplayNameChanged = "events.user.displayNameChanged"
+	UserCountryChanged     = "events.user.countryChanged"
+	UserDeleted            = "events.user.deleted"
+	UserFriendAdded        = "events.user.friendAdded"
+	UserFriendRemoved      = "events.user.friendRemoved"
+
+	UserReservationRolledBack = "events.user.reservationRolledBack"
+	UserBalanceAdjusted       = "events.user.balanceAdjusted"
+	UserModerationChanged     = "events.user.moderationChanged"
+
 	TournamentParticipationScoreUpdated = "events.tournament.participationScoreUpdated"
 	TournamentEntered                   = "events.tournament.entered"
+	TournamentFinished                  = "events.tournament.finished"
+	TournamentRewardClaimed             = "events.tournament.rewardClaimed"
+
+	UserDataPurged = "events.purge.userDataPurged"
+
+	LeaderboardTournamentStandingFinalized = "events.leaderboard.tournamentStandingFinalized"
+	LeaderboardGroupRankOvertaken          = "events.leaderboard.groupRankOvertaken"
 
 	// Event Wildcards
-	UserEventsWildcard       = "events.user.*"
-	TournamentEventsWildcard = "events.tournament.*"
+	UserEventsWildcard        = "events.user.*"
+	TournamentEventsWildcard  = "events.tournament.*"
+	PurgeEventsWildcard       = "events.purge.*"
+	LeaderboardEventsWildcard = "events.leaderboard.*"
 )
@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: v1/events/leaderboard_events.proto
+
+package events
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Published for every human participant once a tournament is finished. Rank
+// excludes bots, the same way rewards are paid.
+type TournamentStandingFinalized struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournamentId,proto3" json:"tournamentId,omitempty"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Rank          int32                  `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Score         int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Reward        int32                  `protobuf:"varint,6,opt,name=reward,proto3" json:"reward,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	TimeStamp     int64                  `protobuf:"varint,8,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentStandingFinalized) Reset() {
+	*x = TournamentStandingFinalized{}
+	mi := &file_v1_events_leaderboard_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentStandingFinalized) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStandingFinalized) ProtoMessage() {}
+
+func (x *TournamentStandingFinalized) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_leaderboard_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStandingFinalized.ProtoReflect.Descriptor instead.
+func (*TournamentStandingFinalized) Descriptor() ([]byte, []int) {
+	return file_v1_events_leaderboard_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TournamentStandingFinalized) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TournamentStandingFinalized) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *TournamentStandingFinalized) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *TournamentStandingFinalized) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TournamentStandingFinalized) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TournamentStandingFinalized) GetReward() int32 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *TournamentStandingFinalized) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TournamentStandingFinalized) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+// Published for a human participant who was passed by another group member
+type GroupRankOvertaken struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserId                 string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TournamentId           string                 `protobuf:"bytes,2,opt,name=tournamentId,proto3" json:"tournamentId,omitempty"`
+	GroupId                string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	OvertakenById          string                 `protobuf:"bytes,4,opt,name=overtakenById,proto3" json:"overtakenById,omitempty"`
+	OvertakenByDisplayName string                 `protobuf:"bytes,5,opt,name=overtakenByDisplayName,proto3" json:"overtakenByDisplayName,omitempty"`
+	Rank                   int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	TimeStamp              int64                  `protobuf:"varint,7,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GroupRankOvertaken) Reset() {
+	*x = GroupRankOvertaken{}
+	mi := &file_v1_events_leaderboard_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupRankOvertaken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRankOvertaken) ProtoMessage() {}
+
+func (x *GroupRankOvertaken) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_leaderboard_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRankOvertaken.ProtoReflect.Descriptor instead.
+func (*GroupRankOvertaken) Descriptor() ([]byte, []int) {
+	return file_v1_events_leaderboard_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupRankOvertaken) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GroupRankOvertaken) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *GroupRankOvertaken) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupRankOvertaken) GetOvertakenById() string {
+	if x != nil {
+		return x.OvertakenById
+	}
+	return ""
+}
+
+func (x *GroupRankOvertaken) GetOvertakenByDisplayName() string {
+	if x != nil {
+		return x.OvertakenByDisplayName
+	}
+	return ""
+}
+
+func (x *GroupRankOvertaken) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GroupRankOvertaken) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+var File_v1_events_leaderboard_events_proto protoreflect.FileDescriptor
+
+const file_v1_events_leaderboard_events_proto_rawDesc = "" +
+	"\n" +
+	"\"v1/events/leaderboard_events.proto\x12\x06events\"\xef\x01\n" +
+	"\x1bTournamentStandingFinalized\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\ftournamentId\x18\x02 \x01(\tR\ftournamentId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\x05R\x04rank\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\x12\x16\n" +
+	"\x06reward\x18\x06 \x01(\x05R\x06reward\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1c\n" +
+	"\ttimeStamp\x18\b \x01(\x03R\ttimeStamp\"\xfa\x01\n" +
+	"\x12GroupRankOvertaken\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\ftournamentId\x18\x02 \x01(\tR\ftournamentId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12$\n" +
+	"\rovertakenById\x18\x04 \x01(\tR\rovertakenById\x126\n" +
+	"\x16overtakenByDisplayName\x18\x05 \x01(\tR\x16overtakenByDisplayName\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x05R\x04rank\x12\x1c\n" +
+	"\ttimeStamp\x18\a \x01(\x03R\ttimeStampB4Z2github.com/burakmert236/goodswipe/generated/eventsb\x06proto3"
+
+var (
+	file_v1_events_leaderboard_events_proto_rawDescOnce sync.Once
+	file_v1_events_leaderboard_events_proto_rawDescData []byte
+)
+
+func file_v1_events_leaderboard_events_proto_rawDescGZIP() []byte {
+	file_v1_events_leaderboard_events_proto_rawDescOnce.Do(func() {
+		file_v1_events_leaderboard_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_events_leaderboard_events_proto_rawDesc), len(file_v1_events_leaderboard_events_proto_rawDesc)))
+	})
+	return file_v1_events_leaderboard_events_proto_rawDescData
+}
+
+var file_v1_events_leaderboard_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_events_leaderboard_events_proto_goTypes = []any{
+	(*TournamentStandingFinalized)(nil), // 0: events.TournamentStandingFinalized
+	(*GroupRankOvertaken)(nil),          // 1: events.GroupRankOvertaken
+}
+var file_v1_events_leaderboard_events_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_v1_events_leaderboard_events_proto_init() }
+func file_v1_events_leaderboard_events_proto_init() {
+	if File_v1_events_leaderboard_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_events_leaderboard_events_proto_rawDesc), len(file_v1_events_leaderboard_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_events_leaderboard_events_proto_goTypes,
+		DependencyIndexes: file_v1_events_leaderboard_events_proto_depIdxs,
+		MessageInfos:      file_v1_events_leaderboard_events_proto_msgTypes,
+	}.Build()
+	File_v1_events_leaderboard_events_proto = out.File
+	file_v1_events_leaderboard_events_proto_goTypes = nil
+	file_v1_events_leaderboard_events_proto_depIdxs = nil
+}
@@ -104,6 +104,7 @@ type TournamentEntered struct {
 	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
 	TournamentId  string                 `protobuf:"bytes,4,opt,name=tournamentId,proto3" json:"tournamentId,omitempty"`
 	TimeStamp     int64                  `protobuf:"varint,5,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	IsBot         bool                   `protobuf:"varint,6,opt,name=isBot,proto3" json:"isBot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -173,6 +174,182 @@ func (x *TournamentEntered) GetTimeStamp() int64 {
 	return 0
 }
 
+func (x *TournamentEntered) GetIsBot() bool {
+	if x != nil {
+		return x.IsBot
+	}
+	return false
+}
+
+type TournamentFinished struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId    string                 `protobuf:"bytes,1,opt,name=tournamentId,proto3" json:"tournamentId,omitempty"`
+	SeasonId        string                 `protobuf:"bytes,2,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	SeasonPointsMap map[string]int32       `protobuf:"bytes,3,rep,name=seasonPointsMap,proto3" json:"seasonPointsMap,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	TimeStamp       int64                  `protobuf:"varint,4,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	RewardingMap    map[string]int32       `protobuf:"bytes,5,rep,name=rewardingMap,proto3" json:"rewardingMap,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	RewardCurrency  string                 `protobuf:"bytes,6,opt,name=rewardCurrency,proto3" json:"rewardCurrency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TournamentFinished) Reset() {
+	*x = TournamentFinished{}
+	mi := &file_v1_events_tournament_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentFinished) ProtoMessage() {}
+
+func (x *TournamentFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_tournament_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentFinished.ProtoReflect.Descriptor instead.
+func (*TournamentFinished) Descriptor() ([]byte, []int) {
+	return file_v1_events_tournament_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TournamentFinished) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *TournamentFinished) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *TournamentFinished) GetSeasonPointsMap() map[string]int32 {
+	if x != nil {
+		return x.SeasonPointsMap
+	}
+	return nil
+}
+
+func (x *TournamentFinished) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *TournamentFinished) GetRewardingMap() map[string]int32 {
+	if x != nil {
+		return x.RewardingMap
+	}
+	return nil
+}
+
+func (x *TournamentFinished) GetRewardCurrency() string {
+	if x != nil {
+		return x.RewardCurrency
+	}
+	return ""
+}
+
+// Published once per participation when the user claims the tournament result
+type TournamentRewardClaimed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournamentId,proto3" json:"tournamentId,omitempty"`
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Reward        int32                  `protobuf:"varint,4,opt,name=reward,proto3" json:"reward,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	TimeStamp     int64                  `protobuf:"varint,6,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRewardClaimed) Reset() {
+	*x = TournamentRewardClaimed{}
+	mi := &file_v1_events_tournament_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRewardClaimed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRewardClaimed) ProtoMessage() {}
+
+func (x *TournamentRewardClaimed) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_tournament_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRewardClaimed.ProtoReflect.Descriptor instead.
+func (*TournamentRewardClaimed) Descriptor() ([]byte, []int) {
+	return file_v1_events_tournament_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TournamentRewardClaimed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TournamentRewardClaimed) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *TournamentRewardClaimed) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TournamentRewardClaimed) GetReward() int32 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *TournamentRewardClaimed) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TournamentRewardClaimed) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
 var File_v1_events_tournament_events_proto protoreflect.FileDescriptor
 
 const file_v1_events_tournament_events_proto_rawDesc = "" +
@@ -183,13 +360,34 @@ const file_v1_events_tournament_events_proto_rawDesc = "" +
 	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\"\n" +
 	"\ftournamentId\x18\x03 \x01(\tR\ftournamentId\x12\x1a\n" +
 	"\bnewScore\x18\x04 \x01(\x05R\bnewScore\x12\x1c\n" +
-	"\ttimeStamp\x18\x05 \x01(\x03R\ttimeStamp\"\xa9\x01\n" +
+	"\ttimeStamp\x18\x05 \x01(\x03R\ttimeStamp\"\xbf\x01\n" +
 	"\x11TournamentEntered\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
 	"\vdisplayName\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
 	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\"\n" +
 	"\ftournamentId\x18\x04 \x01(\tR\ftournamentId\x12\x1c\n" +
-	"\ttimeStamp\x18\x05 \x01(\x03R\ttimeStampB4Z2github.com/burakmert236/goodswipe/generated/eventsb\x06proto3"
+	"\ttimeStamp\x18\x05 \x01(\x03R\ttimeStamp\x12\x14\n" +
+	"\x05isBot\x18\x06 \x01(\bR\x05isBot\"\xcc\x03\n" +
+	"\x12TournamentFinished\x12\"\n" +
+	"\ftournamentId\x18\x01 \x01(\tR\ftournamentId\x12\x1a\n" +
+	"\bseasonId\x18\x02 \x01(\tR\bseasonId\x12Y\n" +
+	"\x0fseasonPointsMap\x18\x03 \x03(\v2/.events.TournamentFinished.SeasonPointsMapEntryR\x0fseasonPointsMap\x12\x1c\n" +
+	"\ttimeStamp\x18\x04 \x01(\x03R\ttimeStamp\x12P\n" +
+	"\frewardingMap\x18\x05 \x03(\v2,.events.TournamentFinished.RewardingMapEntryR\frewardingMap\x12&\n" +
+	"\x0erewardCurrency\x18\x06 \x01(\tR\x0erewardCurrency\x1aB\n" +
+	"\x14SeasonPointsMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a?\n" +
+	"\x11RewardingMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xbb\x01\n" +
+	"\x17TournamentRewardClaimed\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\ftournamentId\x18\x02 \x01(\tR\ftournamentId\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x16\n" +
+	"\x06reward\x18\x04 \x01(\x05R\x06reward\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\ttimeStamp\x18\x06 \x01(\x03R\ttimeStampB4Z2github.com/burakmert236/goodswipe/generated/eventsb\x06proto3"
 
 var (
 	file_v1_events_tournament_events_proto_rawDescOnce sync.Once
@@ -203,17 +401,23 @@ func file_v1_events_tournament_events_proto_rawDescGZIP() []byte {
 	return file_v1_events_tournament_events_proto_rawDescData
 }
 
-var file_v1_events_tournament_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_events_tournament_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_v1_events_tournament_events_proto_goTypes = []any{
 	(*TournamentParticipationScoreUpdated)(nil), // 0: events.TournamentParticipationScoreUpdated
 	(*TournamentEntered)(nil),                   // 1: events.TournamentEntered
+	(*TournamentFinished)(nil),                  // 2: events.TournamentFinished
+	(*TournamentRewardClaimed)(nil),             // 3: events.TournamentRewardClaimed
+	nil,                                         // 4: events.TournamentFinished.SeasonPointsMapEntry
+	nil,                                         // 5: events.TournamentFinished.RewardingMapEntry
 }
 var file_v1_events_tournament_events_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: events.TournamentFinished.seasonPointsMap:type_name -> events.TournamentFinished.SeasonPointsMapEntry
+	5, // 1: events.TournamentFinished.rewardingMap:type_name -> events.TournamentFinished.RewardingMapEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_v1_events_tournament_events_proto_init() }
@@ -227,7 +431,7 @@ func file_v1_events_tournament_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_events_tournament_events_proto_rawDesc), len(file_v1_events_tournament_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
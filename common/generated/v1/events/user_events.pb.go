@@ -22,10 +22,12 @@ const (
 )
 
 type UserCreated struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	TimeStamp     int64                  `protobuf:"varint,3,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	TimeStamp   int64                  `protobuf:"varint,3,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	// ISO 3166-1 alpha-2 code, empty when the user gave none
+	Country       string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,12 +83,20 @@ func (x *UserCreated) GetTimeStamp() int64 {
 	return 0
 }
 
+func (x *UserCreated) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
 type UserLevelUp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
 	LevelIncrease int32                  `protobuf:"varint,2,opt,name=levelIncrease,proto3" json:"levelIncrease,omitempty"`
 	NewLevel      int32                  `protobuf:"varint,3,opt,name=newLevel,proto3" json:"newLevel,omitempty"`
 	TimeStamp     int64                  `protobuf:"varint,4,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	Prestige      int32                  `protobuf:"varint,5,opt,name=prestige,proto3" json:"prestige,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -149,20 +159,734 @@ func (x *UserLevelUp) GetTimeStamp() int64 {
 	return 0
 }
 
+func (x *UserLevelUp) GetPrestige() int32 {
+	if x != nil {
+		return x.Prestige
+	}
+	return 0
+}
+
+type UserDisplayNameChanged struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	DisplayName         string                 `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	PreviousDisplayName string                 `protobuf:"bytes,3,opt,name=previousDisplayName,proto3" json:"previousDisplayName,omitempty"`
+	TimeStamp           int64                  `protobuf:"varint,4,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UserDisplayNameChanged) Reset() {
+	*x = UserDisplayNameChanged{}
+	mi := &file_v1_events_user_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDisplayNameChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDisplayNameChanged) ProtoMessage() {}
+
+func (x *UserDisplayNameChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_user_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDisplayNameChanged.ProtoReflect.Descriptor instead.
+func (*UserDisplayNameChanged) Descriptor() ([]byte, []int) {
+	return file_v1_events_user_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserDisplayNameChanged) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDisplayNameChanged) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserDisplayNameChanged) GetPreviousDisplayName() string {
+	if x != nil {
+		return x.PreviousDisplayName
+	}
+	return ""
+}
+
+func (x *UserDisplayNameChanged) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+// Published when a user sets or clears their country
+type UserCountryChanged struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Country         string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	PreviousCountry string                 `protobuf:"bytes,3,opt,name=previousCountry,proto3" json:"previousCountry,omitempty"`
+	TimeStamp       int64                  `protobuf:"varint,4,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserCountryChanged) Reset() {
+	*x = UserCountryChanged{}
+	mi := &file_v1_events_user_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCountryChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCountryChanged) ProtoMessage() {}
+
+func (x *UserCountryChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_user_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCountryChanged.ProtoReflect.Descriptor instead.
+func (*UserCountryChanged) Descriptor() ([]byte, []int) {
+	return file_v1_events_user_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserCountryChanged) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserCountryChanged) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *UserCountryChanged) GetPreviousCountry() string {
+	if x != nil {
+		return x.PreviousCountry
+	}
+	return ""
+}
+
+func (x *UserCountryChanged) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+type UserDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TimeStamp     int64                  `protobuf:"varint,2,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeleted) Reset() {
+	*x = UserDeleted{}
+	mi := &file_v1_events_user_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeleted) ProtoMessage() {}
+
+func (x *UserDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_user_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeleted.ProtoReflect.Descriptor instead.
+func (*UserDeleted) Descriptor() ([]byte, []int) {
+	return file_v1_events_user_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserDeleted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDeleted) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+// Published once per friendship, when a request is accepted
+type UserFriendAdded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FriendId      string                 `protobuf:"bytes,2,opt,name=friendId,proto3" json:"friendId,omitempty"`
+	TimeStamp     int64                  `protobuf:"varint,3,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFriendAdded) Reset() {
+	*x = UserFriendAdded{}
+	mi := &file_v1_events_user_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFriendAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFriendAdded) ProtoMessage() {}
+
+func (x *UserFriendAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_user_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFriendAdded.ProtoReflect.Descriptor instead.
+func (*UserFriendAdded) Descriptor() ([]byte, []int) {
+	return file_v1_events_user_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserFriendAdded) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserFriendAdded) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
+
+func (x *UserFriendAdded) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+type UserFriendRemoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	FriendId      string                 `protobuf:"bytes,2,opt,name=friendId,proto3" json:"friendId,omitempty"`
+	TimeStamp     int64                  `protobuf:"varint,3,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFriendRemoved) Reset() {
+	*x = UserFriendRemoved{}
+	mi := &file_v1_events_user_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFriendRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFriendRemoved) ProtoMessage() {}
+
+func (x *UserFriendRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_user_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFriendRemoved.ProtoReflect.Descriptor instead.
+func (*UserFriendRemoved) Descriptor() ([]byte, []int) {
+	return file_v1_events_user_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserFriendRemoved) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserFriendRemoved) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
+
+func (x *UserFriendRemoved) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+// Published when the coins reserved for a tournament entry are returned
+type UserReservationRolledBack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournamentId,proto3" json:"tournamentId,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	TimeStamp     int64                  `protobuf:"varint,5,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserReservationRolledBack) Reset() {
+	*x = UserReservationRolledBack{}
+	mi := &file_v1_events_user_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserReservationRolledBack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReservationRolledBack) ProtoMessage() {}
+
+func (x *UserReservationRolledBack) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_user_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReservationRolledBack.ProtoReflect.Descriptor instead.
+func (*UserReservationRolledBack) Descriptor() ([]byte, []int) {
+	return file_v1_events_user_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserReservationRolledBack) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserReservationRolledBack) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *UserReservationRolledBack) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UserReservationRolledBack) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UserReservationRolledBack) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+// Published when support staff granted or revoked currency, type is GRANT or REVOKE
+type UserBalanceAdjusted struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	RequestId       string                 `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount          int32                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceAfter    int32                  `protobuf:"varint,6,opt,name=balanceAfter,proto3" json:"balanceAfter,omitempty"`
+	OperatorId      string                 `protobuf:"bytes,7,opt,name=operatorId,proto3" json:"operatorId,omitempty"`
+	Reason          string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	TicketReference string                 `protobuf:"bytes,9,opt,name=ticketReference,proto3" json:"ticketReference,omitempty"`
+	TimeStamp       int64                  `protobuf:"varint,10,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserBalanceAdjusted) Reset() {
+	*x = UserBalanceAdjusted{}
+	mi := &file_v1_events_user_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserBalanceAdjusted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBalanceAdjusted) ProtoMessage() {}
+
+func (x *UserBalanceAdjusted) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_user_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBalanceAdjusted.ProtoReflect.Descriptor instead.
+func (*UserBalanceAdjusted) Descriptor() ([]byte, []int) {
+	return file_v1_events_user_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserBalanceAdjusted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserBalanceAdjusted) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *UserBalanceAdjusted) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserBalanceAdjusted) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UserBalanceAdjusted) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UserBalanceAdjusted) GetBalanceAfter() int32 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *UserBalanceAdjusted) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *UserBalanceAdjusted) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UserBalanceAdjusted) GetTicketReference() string {
+	if x != nil {
+		return x.TicketReference
+	}
+	return ""
+}
+
+func (x *UserBalanceAdjusted) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+// Published when an admin suspends, bans or reinstates a user, status is
+// ACTIVE, SUSPENDED or BANNED
+type UserModerationChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	SuspendedUntil int64                  `protobuf:"varint,3,opt,name=suspendedUntil,proto3" json:"suspendedUntil,omitempty"`
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,5,opt,name=operatorId,proto3" json:"operatorId,omitempty"`
+	TimeStamp      int64                  `protobuf:"varint,6,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserModerationChanged) Reset() {
+	*x = UserModerationChanged{}
+	mi := &file_v1_events_user_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserModerationChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserModerationChanged) ProtoMessage() {}
+
+func (x *UserModerationChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_user_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserModerationChanged.ProtoReflect.Descriptor instead.
+func (*UserModerationChanged) Descriptor() ([]byte, []int) {
+	return file_v1_events_user_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserModerationChanged) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserModerationChanged) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserModerationChanged) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *UserModerationChanged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UserModerationChanged) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *UserModerationChanged) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+// Published by every service once it removed the user's data
+type UserDataPurged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	TimeStamp     int64                  `protobuf:"varint,3,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataPurged) Reset() {
+	*x = UserDataPurged{}
+	mi := &file_v1_events_user_events_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataPurged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataPurged) ProtoMessage() {}
+
+func (x *UserDataPurged) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_user_events_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataPurged.ProtoReflect.Descriptor instead.
+func (*UserDataPurged) Descriptor() ([]byte, []int) {
+	return file_v1_events_user_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UserDataPurged) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDataPurged) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *UserDataPurged) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
 var File_v1_events_user_events_proto protoreflect.FileDescriptor
 
 const file_v1_events_user_events_proto_rawDesc = "" +
 	"\n" +
-	"\x1bv1/events/user_events.proto\x12\x06events\"e\n" +
+	"\x1bv1/events/user_events.proto\x12\x06events\"\x7f\n" +
 	"\vUserCreated\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
 	"\vdisplayName\x18\x02 \x01(\tR\vdisplayName\x12\x1c\n" +
-	"\ttimeStamp\x18\x03 \x01(\x03R\ttimeStamp\"\x85\x01\n" +
+	"\ttimeStamp\x18\x03 \x01(\x03R\ttimeStamp\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\"\xa1\x01\n" +
 	"\vUserLevelUp\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12$\n" +
 	"\rlevelIncrease\x18\x02 \x01(\x05R\rlevelIncrease\x12\x1a\n" +
 	"\bnewLevel\x18\x03 \x01(\x05R\bnewLevel\x12\x1c\n" +
-	"\ttimeStamp\x18\x04 \x01(\x03R\ttimeStampB4Z2github.com/burakmert236/goodswipe/generated/eventsb\x06proto3"
+	"\ttimeStamp\x18\x04 \x01(\x03R\ttimeStamp\x12\x1a\n" +
+	"\bprestige\x18\x05 \x01(\x05R\bprestige\"\xa2\x01\n" +
+	"\x16UserDisplayNameChanged\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vdisplayName\x18\x02 \x01(\tR\vdisplayName\x120\n" +
+	"\x13previousDisplayName\x18\x03 \x01(\tR\x13previousDisplayName\x12\x1c\n" +
+	"\ttimeStamp\x18\x04 \x01(\x03R\ttimeStamp\"\x8e\x01\n" +
+	"\x12UserCountryChanged\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12(\n" +
+	"\x0fpreviousCountry\x18\x03 \x01(\tR\x0fpreviousCountry\x12\x1c\n" +
+	"\ttimeStamp\x18\x04 \x01(\x03R\ttimeStamp\"C\n" +
+	"\vUserDeleted\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\ttimeStamp\x18\x02 \x01(\x03R\ttimeStamp\"c\n" +
+	"\x0fUserFriendAdded\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfriendId\x18\x02 \x01(\tR\bfriendId\x12\x1c\n" +
+	"\ttimeStamp\x18\x03 \x01(\x03R\ttimeStamp\"e\n" +
+	"\x11UserFriendRemoved\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfriendId\x18\x02 \x01(\tR\bfriendId\x12\x1c\n" +
+	"\ttimeStamp\x18\x03 \x01(\x03R\ttimeStamp\"\xa9\x01\n" +
+	"\x19UserReservationRolledBack\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\ftournamentId\x18\x02 \x01(\tR\ftournamentId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\ttimeStamp\x18\x05 \x01(\x03R\ttimeStamp\"\xb7\x02\n" +
+	"\x13UserBalanceAdjusted\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\trequestId\x18\x02 \x01(\tR\trequestId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x05R\x06amount\x12\"\n" +
+	"\fbalanceAfter\x18\x06 \x01(\x05R\fbalanceAfter\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\a \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12(\n" +
+	"\x0fticketReference\x18\t \x01(\tR\x0fticketReference\x12\x1c\n" +
+	"\ttimeStamp\x18\n" +
+	" \x01(\x03R\ttimeStamp\"\xc5\x01\n" +
+	"\x15UserModerationChanged\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12&\n" +
+	"\x0esuspendedUntil\x18\x03 \x01(\x03R\x0esuspendedUntil\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x05 \x01(\tR\n" +
+	"operatorId\x12\x1c\n" +
+	"\ttimeStamp\x18\x06 \x01(\x03R\ttimeStamp\"`\n" +
+	"\x0eUserDataPurged\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12\x1c\n" +
+	"\ttimeStamp\x18\x03 \x01(\x03R\ttimeStampB4Z2github.com/burakmert236/goodswipe/generated/eventsb\x06proto3"
 
 var (
 	file_v1_events_user_events_proto_rawDescOnce sync.Once
@@ -176,10 +900,19 @@ func file_v1_events_user_events_proto_rawDescGZIP() []byte {
 	return file_v1_events_user_events_proto_rawDescData
 }
 
-var file_v1_events_user_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_events_user_events_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_v1_events_user_events_proto_goTypes = []any{
-	(*UserCreated)(nil), // 0: events.UserCreated
-	(*UserLevelUp)(nil), // 1: events.UserLevelUp
+	(*UserCreated)(nil),               // 0: events.UserCreated
+	(*UserLevelUp)(nil),               // 1: events.UserLevelUp
+	(*UserDisplayNameChanged)(nil),    // 2: events.UserDisplayNameChanged
+	(*UserCountryChanged)(nil),        // 3: events.UserCountryChanged
+	(*UserDeleted)(nil),               // 4: events.UserDeleted
+	(*UserFriendAdded)(nil),           // 5: events.UserFriendAdded
+	(*UserFriendRemoved)(nil),         // 6: events.UserFriendRemoved
+	(*UserReservationRolledBack)(nil), // 7: events.UserReservationRolledBack
+	(*UserBalanceAdjusted)(nil),       // 8: events.UserBalanceAdjusted
+	(*UserModerationChanged)(nil),     // 9: events.UserModerationChanged
+	(*UserDataPurged)(nil),            // 10: events.UserDataPurged
 }
 var file_v1_events_user_events_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -200,7 +933,7 @@ func file_v1_events_user_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_events_user_events_proto_rawDesc), len(file_v1_events_user_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
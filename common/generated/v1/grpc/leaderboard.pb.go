@@ -21,9 +21,27 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Requests
+// The global leaderboard ranks players by lifetime tournament points, the sum of
+// their scores over every tournament they played. Bots are not ranked.
+// Pages are selected by around_me, page_token or offset in that order. A limit of
+// 0 returns up to 1000 entries. around_me returns that many entries above and
+// below the user instead of a page.
+// DAILY, WEEKLY and MONTHLY rank only the points scored within a UTC day, ISO week
+// or calendar month. Closed windows return their archived final standings.
 type GetGlobalLeaderboardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Offset int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token of the previous page
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only read with around_me
+	UserId   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AroundMe int32  `protobuf:"varint,5,opt,name=around_me,json=aroundMe,proto3" json:"around_me,omitempty"`
+	// ALL_TIME (default), DAILY, WEEKLY or MONTHLY
+	Period string `protobuf:"bytes,6,opt,name=period,proto3" json:"period,omitempty"`
+	// Window of the period, e.g. 2026-10-18, 2026-W42 or 2026-10. Empty means the
+	// current window, ignored for ALL_TIME.
+	PeriodId      string `protobuf:"bytes,7,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -58,10 +76,64 @@ func (*GetGlobalLeaderboardRequest) Descriptor() ([]byte, []int) {
 	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetGlobalLeaderboardRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetGlobalLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetGlobalLeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetGlobalLeaderboardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetGlobalLeaderboardRequest) GetAroundMe() int32 {
+	if x != nil {
+		return x.AroundMe
+	}
+	return 0
+}
+
+func (x *GetGlobalLeaderboardRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetGlobalLeaderboardRequest) GetPeriodId() string {
+	if x != nil {
+		return x.PeriodId
+	}
+	return ""
+}
+
+// Paged the same way as GetGlobalLeaderboardRequest within the user's group
 type GetTournamentLeaderboardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	AroundMe      int32                  `protobuf:"varint,6,opt,name=around_me,json=aroundMe,proto3" json:"around_me,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,10 +182,40 @@ func (x *GetTournamentLeaderboardRequest) GetTournamentId() string {
 	return ""
 }
 
+func (x *GetTournamentLeaderboardRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTournamentLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTournamentLeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTournamentLeaderboardRequest) GetAroundMe() int32 {
+	if x != nil {
+		return x.AroundMe
+	}
+	return 0
+}
+
 type GetTournamentRankRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TournamentId string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// Bots are skipped when ranking, used for reward payouts
+	ExcludeBots   bool `protobuf:"varint,3,opt,name=exclude_bots,json=excludeBots,proto3" json:"exclude_bots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -162,17 +264,231 @@ func (x *GetTournamentRankRequest) GetTournamentId() string {
 	return ""
 }
 
+func (x *GetTournamentRankRequest) GetExcludeBots() bool {
+	if x != nil {
+		return x.ExcludeBots
+	}
+	return false
+}
+
+type GetSeasonLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonLeaderboardRequest) Reset() {
+	*x = GetSeasonLeaderboardRequest{}
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetSeasonLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSeasonLeaderboardRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *GetSeasonLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Ranks the user and their friends by their tournament scores, across groups.
+// Without a tournament id their lifetime tournament points are used.
+type GetFriendsLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendsLeaderboardRequest) Reset() {
+	*x = GetFriendsLeaderboardRequest{}
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendsLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendsLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetFriendsLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendsLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendsLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFriendsLeaderboardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetFriendsLeaderboardRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+// Ranks the players of one country the same way as the global leaderboard.
+// Windowed periods only cover the current window.
+type GetRegionalLeaderboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO 3166-1 alpha-2 code, e.g. DE
+	Country string `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	// ALL_TIME (default), DAILY, WEEKLY or MONTHLY
+	Period    string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Offset    int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only read with around_me
+	UserId        string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AroundMe      int32  `protobuf:"varint,7,opt,name=around_me,json=aroundMe,proto3" json:"around_me,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegionalLeaderboardRequest) Reset() {
+	*x = GetRegionalLeaderboardRequest{}
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegionalLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionalLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetRegionalLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionalLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetRegionalLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRegionalLeaderboardRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *GetRegionalLeaderboardRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetRegionalLeaderboardRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetRegionalLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRegionalLeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetRegionalLeaderboardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRegionalLeaderboardRequest) GetAroundMe() int32 {
+	if x != nil {
+		return x.AroundMe
+	}
+	return 0
+}
+
 // Responses
 type GetGlobalLeaderboardResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Window the users were ranked in, empty for ALL_TIME
+	PeriodId      string `protobuf:"bytes,3,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetGlobalLeaderboardResponse) Reset() {
 	*x = GetGlobalLeaderboardResponse{}
-	mi := &file_v1_grpc_leaderboard_proto_msgTypes[3]
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -184,7 +500,7 @@ func (x *GetGlobalLeaderboardResponse) String() string {
 func (*GetGlobalLeaderboardResponse) ProtoMessage() {}
 
 func (x *GetGlobalLeaderboardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_leaderboard_proto_msgTypes[3]
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +513,7 @@ func (x *GetGlobalLeaderboardResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGlobalLeaderboardResponse.ProtoReflect.Descriptor instead.
 func (*GetGlobalLeaderboardResponse) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{3}
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetGlobalLeaderboardResponse) GetUsers() []*UserInfo {
@@ -207,16 +523,92 @@ func (x *GetGlobalLeaderboardResponse) GetUsers() []*UserInfo {
 	return nil
 }
 
+func (x *GetGlobalLeaderboardResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetGlobalLeaderboardResponse) GetPeriodId() string {
+	if x != nil {
+		return x.PeriodId
+	}
+	return ""
+}
+
+type GetRegionalLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Window the users were ranked in, empty for ALL_TIME
+	PeriodId      string `protobuf:"bytes,3,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegionalLeaderboardResponse) Reset() {
+	*x = GetRegionalLeaderboardResponse{}
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegionalLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionalLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetRegionalLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionalLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetRegionalLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRegionalLeaderboardResponse) GetUsers() []*UserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetRegionalLeaderboardResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetRegionalLeaderboardResponse) GetPeriodId() string {
+	if x != nil {
+		return x.PeriodId
+	}
+	return ""
+}
+
 type GetTournamentLeaderboardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTournamentLeaderboardResponse) Reset() {
 	*x = GetTournamentLeaderboardResponse{}
-	mi := &file_v1_grpc_leaderboard_proto_msgTypes[4]
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +620,7 @@ func (x *GetTournamentLeaderboardResponse) String() string {
 func (*GetTournamentLeaderboardResponse) ProtoMessage() {}
 
 func (x *GetTournamentLeaderboardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_leaderboard_proto_msgTypes[4]
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +633,7 @@ func (x *GetTournamentLeaderboardResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTournamentLeaderboardResponse.ProtoReflect.Descriptor instead.
 func (*GetTournamentLeaderboardResponse) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{4}
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetTournamentLeaderboardResponse) GetUsers() []*UserInfo {
@@ -251,6 +643,13 @@ func (x *GetTournamentLeaderboardResponse) GetUsers() []*UserInfo {
 	return nil
 }
 
+func (x *GetTournamentLeaderboardResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetTournamentRankResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
@@ -260,7 +659,7 @@ type GetTournamentRankResponse struct {
 
 func (x *GetTournamentRankResponse) Reset() {
 	*x = GetTournamentRankResponse{}
-	mi := &file_v1_grpc_leaderboard_proto_msgTypes[5]
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +671,7 @@ func (x *GetTournamentRankResponse) String() string {
 func (*GetTournamentRankResponse) ProtoMessage() {}
 
 func (x *GetTournamentRankResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_leaderboard_proto_msgTypes[5]
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +684,7 @@ func (x *GetTournamentRankResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTournamentRankResponse.ProtoReflect.Descriptor instead.
 func (*GetTournamentRankResponse) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{5}
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetTournamentRankResponse) GetRank() int32 {
@@ -295,19 +694,121 @@ func (x *GetTournamentRankResponse) GetRank() int32 {
 	return 0
 }
 
+type GetSeasonLeaderboardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Tournaments whose season points are included, points are applied
+	// asynchronously after a tournament is finalized
+	AppliedTournamentIds []string `protobuf:"bytes,2,rep,name=applied_tournament_ids,json=appliedTournamentIds,proto3" json:"applied_tournament_ids,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetSeasonLeaderboardResponse) Reset() {
+	*x = GetSeasonLeaderboardResponse{}
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetSeasonLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetSeasonLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSeasonLeaderboardResponse) GetUsers() []*UserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetSeasonLeaderboardResponse) GetAppliedTournamentIds() []string {
+	if x != nil {
+		return x.AppliedTournamentIds
+	}
+	return nil
+}
+
+type GetFriendsLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendsLeaderboardResponse) Reset() {
+	*x = GetFriendsLeaderboardResponse{}
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendsLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendsLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetFriendsLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendsLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetFriendsLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetFriendsLeaderboardResponse) GetUsers() []*UserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
 // Types
 type UserInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Score         int64                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Tournament score, lifetime tournament points on the global leaderboard
+	Score int64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	IsBot bool  `protobuf:"varint,4,opt,name=is_bot,json=isBot,proto3" json:"is_bot,omitempty"`
+	// Absolute place on the leaderboard, starting at 1
+	Rank          int64 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
-	mi := &file_v1_grpc_leaderboard_proto_msgTypes[6]
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -319,7 +820,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_leaderboard_proto_msgTypes[6]
+	mi := &file_v1_grpc_leaderboard_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +833,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{6}
+	return file_v1_grpc_leaderboard_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UserInfo) GetUserId() string {
@@ -356,32 +857,92 @@ func (x *UserInfo) GetScore() int64 {
 	return 0
 }
 
+func (x *UserInfo) GetIsBot() bool {
+	if x != nil {
+		return x.IsBot
+	}
+	return false
+}
+
+func (x *UserInfo) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
 var File_v1_grpc_leaderboard_proto protoreflect.FileDescriptor
 
 const file_v1_grpc_leaderboard_proto_rawDesc = "" +
 	"\n" +
-	"\x19v1/grpc/leaderboard.proto\x12\x04grpc\"\x1d\n" +
-	"\x1bGetGlobalLeaderboardRequest\"_\n" +
+	"\x19v1/grpc/leaderboard.proto\x12\x04grpc\"\xd5\x01\n" +
+	"\x1bGetGlobalLeaderboardRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1b\n" +
+	"\taround_me\x18\x05 \x01(\x05R\baroundMe\x12\x16\n" +
+	"\x06period\x18\x06 \x01(\tR\x06period\x12\x1b\n" +
+	"\tperiod_id\x18\a \x01(\tR\bperiodId\"\xc9\x01\n" +
 	"\x1fGetTournamentLeaderboardRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
-	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"X\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\taround_me\x18\x06 \x01(\x05R\baroundMe\"{\n" +
 	"\x18GetTournamentRankRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
-	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"D\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\x12!\n" +
+	"\fexclude_bots\x18\x03 \x01(\bR\vexcludeBots\"P\n" +
+	"\x1bGetSeasonLeaderboardRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\\\n" +
+	"\x1cGetFriendsLeaderboardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"\xd4\x01\n" +
+	"\x1dGetRegionalLeaderboardRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12\x1b\n" +
+	"\taround_me\x18\a \x01(\x05R\baroundMe\"\x89\x01\n" +
 	"\x1cGetGlobalLeaderboardResponse\x12$\n" +
-	"\x05users\x18\x01 \x03(\v2\x0e.grpc.UserInfoR\x05users\"H\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.grpc.UserInfoR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1b\n" +
+	"\tperiod_id\x18\x03 \x01(\tR\bperiodId\"\x8b\x01\n" +
+	"\x1eGetRegionalLeaderboardResponse\x12$\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.grpc.UserInfoR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1b\n" +
+	"\tperiod_id\x18\x03 \x01(\tR\bperiodId\"p\n" +
 	" GetTournamentLeaderboardResponse\x12$\n" +
-	"\x05users\x18\x01 \x03(\v2\x0e.grpc.UserInfoR\x05users\"/\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.grpc.UserInfoR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
 	"\x19GetTournamentRankResponse\x12\x12\n" +
-	"\x04rank\x18\x01 \x01(\x05R\x04rank\"\\\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\"z\n" +
+	"\x1cGetSeasonLeaderboardResponse\x12$\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.grpc.UserInfoR\x05users\x124\n" +
+	"\x16applied_tournament_ids\x18\x02 \x03(\tR\x14appliedTournamentIds\"E\n" +
+	"\x1dGetFriendsLeaderboardResponse\x12$\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.grpc.UserInfoR\x05users\"\x87\x01\n" +
 	"\bUserInfo\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
-	"\x05score\x18\x03 \x01(\x03R\x05score2\xb4\x02\n" +
+	"\x05score\x18\x03 \x01(\x03R\x05score\x12\x15\n" +
+	"\x06is_bot\x18\x04 \x01(\bR\x05isBot\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\x03R\x04rank2\xda\x04\n" +
 	"\x12LeaderboardService\x12]\n" +
 	"\x14GetGlobalLeaderboard\x12!.grpc.GetGlobalLeaderboardRequest\x1a\".grpc.GetGlobalLeaderboardResponse\x12i\n" +
 	"\x18GetTournamentLeaderboard\x12%.grpc.GetTournamentLeaderboardRequest\x1a&.grpc.GetTournamentLeaderboardResponse\x12T\n" +
-	"\x11GetTournamentRank\x12\x1e.grpc.GetTournamentRankRequest\x1a\x1f.grpc.GetTournamentRankResponseB9Z7github.com/burakmert236/goodswipe-common/generated/grpcb\x06proto3"
+	"\x11GetTournamentRank\x12\x1e.grpc.GetTournamentRankRequest\x1a\x1f.grpc.GetTournamentRankResponse\x12]\n" +
+	"\x14GetSeasonLeaderboard\x12!.grpc.GetSeasonLeaderboardRequest\x1a\".grpc.GetSeasonLeaderboardResponse\x12`\n" +
+	"\x15GetFriendsLeaderboard\x12\".grpc.GetFriendsLeaderboardRequest\x1a#.grpc.GetFriendsLeaderboardResponse\x12c\n" +
+	"\x16GetRegionalLeaderboard\x12#.grpc.GetRegionalLeaderboardRequest\x1a$.grpc.GetRegionalLeaderboardResponseB9Z7github.com/burakmert236/goodswipe-common/generated/grpcb\x06proto3"
 
 var (
 	file_v1_grpc_leaderboard_proto_rawDescOnce sync.Once
@@ -395,30 +956,45 @@ func file_v1_grpc_leaderboard_proto_rawDescGZIP() []byte {
 	return file_v1_grpc_leaderboard_proto_rawDescData
 }
 
-var file_v1_grpc_leaderboard_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_v1_grpc_leaderboard_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_v1_grpc_leaderboard_proto_goTypes = []any{
 	(*GetGlobalLeaderboardRequest)(nil),      // 0: grpc.GetGlobalLeaderboardRequest
 	(*GetTournamentLeaderboardRequest)(nil),  // 1: grpc.GetTournamentLeaderboardRequest
 	(*GetTournamentRankRequest)(nil),         // 2: grpc.GetTournamentRankRequest
-	(*GetGlobalLeaderboardResponse)(nil),     // 3: grpc.GetGlobalLeaderboardResponse
-	(*GetTournamentLeaderboardResponse)(nil), // 4: grpc.GetTournamentLeaderboardResponse
-	(*GetTournamentRankResponse)(nil),        // 5: grpc.GetTournamentRankResponse
-	(*UserInfo)(nil),                         // 6: grpc.UserInfo
+	(*GetSeasonLeaderboardRequest)(nil),      // 3: grpc.GetSeasonLeaderboardRequest
+	(*GetFriendsLeaderboardRequest)(nil),     // 4: grpc.GetFriendsLeaderboardRequest
+	(*GetRegionalLeaderboardRequest)(nil),    // 5: grpc.GetRegionalLeaderboardRequest
+	(*GetGlobalLeaderboardResponse)(nil),     // 6: grpc.GetGlobalLeaderboardResponse
+	(*GetRegionalLeaderboardResponse)(nil),   // 7: grpc.GetRegionalLeaderboardResponse
+	(*GetTournamentLeaderboardResponse)(nil), // 8: grpc.GetTournamentLeaderboardResponse
+	(*GetTournamentRankResponse)(nil),        // 9: grpc.GetTournamentRankResponse
+	(*GetSeasonLeaderboardResponse)(nil),     // 10: grpc.GetSeasonLeaderboardResponse
+	(*GetFriendsLeaderboardResponse)(nil),    // 11: grpc.GetFriendsLeaderboardResponse
+	(*UserInfo)(nil),                         // 12: grpc.UserInfo
 }
 var file_v1_grpc_leaderboard_proto_depIdxs = []int32{
-	6, // 0: grpc.GetGlobalLeaderboardResponse.users:type_name -> grpc.UserInfo
-	6, // 1: grpc.GetTournamentLeaderboardResponse.users:type_name -> grpc.UserInfo
-	0, // 2: grpc.LeaderboardService.GetGlobalLeaderboard:input_type -> grpc.GetGlobalLeaderboardRequest
-	1, // 3: grpc.LeaderboardService.GetTournamentLeaderboard:input_type -> grpc.GetTournamentLeaderboardRequest
-	2, // 4: grpc.LeaderboardService.GetTournamentRank:input_type -> grpc.GetTournamentRankRequest
-	3, // 5: grpc.LeaderboardService.GetGlobalLeaderboard:output_type -> grpc.GetGlobalLeaderboardResponse
-	4, // 6: grpc.LeaderboardService.GetTournamentLeaderboard:output_type -> grpc.GetTournamentLeaderboardResponse
-	5, // 7: grpc.LeaderboardService.GetTournamentRank:output_type -> grpc.GetTournamentRankResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: grpc.GetGlobalLeaderboardResponse.users:type_name -> grpc.UserInfo
+	12, // 1: grpc.GetRegionalLeaderboardResponse.users:type_name -> grpc.UserInfo
+	12, // 2: grpc.GetTournamentLeaderboardResponse.users:type_name -> grpc.UserInfo
+	12, // 3: grpc.GetSeasonLeaderboardResponse.users:type_name -> grpc.UserInfo
+	12, // 4: grpc.GetFriendsLeaderboardResponse.users:type_name -> grpc.UserInfo
+	0,  // 5: grpc.LeaderboardService.GetGlobalLeaderboard:input_type -> grpc.GetGlobalLeaderboardRequest
+	1,  // 6: grpc.LeaderboardService.GetTournamentLeaderboard:input_type -> grpc.GetTournamentLeaderboardRequest
+	2,  // 7: grpc.LeaderboardService.GetTournamentRank:input_type -> grpc.GetTournamentRankRequest
+	3,  // 8: grpc.LeaderboardService.GetSeasonLeaderboard:input_type -> grpc.GetSeasonLeaderboardRequest
+	4,  // 9: grpc.LeaderboardService.GetFriendsLeaderboard:input_type -> grpc.GetFriendsLeaderboardRequest
+	5,  // 10: grpc.LeaderboardService.GetRegionalLeaderboard:input_type -> grpc.GetRegionalLeaderboardRequest
+	6,  // 11: grpc.LeaderboardService.GetGlobalLeaderboard:output_type -> grpc.GetGlobalLeaderboardResponse
+	8,  // 12: grpc.LeaderboardService.GetTournamentLeaderboard:output_type -> grpc.GetTournamentLeaderboardResponse
+	9,  // 13: grpc.LeaderboardService.GetTournamentRank:output_type -> grpc.GetTournamentRankResponse
+	10, // 14: grpc.LeaderboardService.GetSeasonLeaderboard:output_type -> grpc.GetSeasonLeaderboardResponse
+	11, // 15: grpc.LeaderboardService.GetFriendsLeaderboard:output_type -> grpc.GetFriendsLeaderboardResponse
+	7,  // 16: grpc.LeaderboardService.GetRegionalLeaderboard:output_type -> grpc.GetRegionalLeaderboardResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_v1_grpc_leaderboard_proto_init() }
@@ -432,7 +1008,7 @@ func file_v1_grpc_leaderboard_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_grpc_leaderboard_proto_rawDesc), len(file_v1_grpc_leaderboard_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,9 @@ const (
 	LeaderboardService_GetGlobalLeaderboard_FullMethodName     = "/grpc.LeaderboardService/GetGlobalLeaderboard"
 	LeaderboardService_GetTournamentLeaderboard_FullMethodName = "/grpc.LeaderboardService/GetTournamentLeaderboard"
 	LeaderboardService_GetTournamentRank_FullMethodName        = "/grpc.LeaderboardService/GetTournamentRank"
+	LeaderboardService_GetSeasonLeaderboard_FullMethodName     = "/grpc.LeaderboardService/GetSeasonLeaderboard"
+	LeaderboardService_GetFriendsLeaderboard_FullMethodName    = "/grpc.LeaderboardService/GetFriendsLeaderboard"
+	LeaderboardService_GetRegionalLeaderboard_FullMethodName   = "/grpc.LeaderboardService/GetRegionalLeaderboard"
 )
 
 // LeaderboardServiceClient is the client API for LeaderboardService service.
@@ -33,6 +36,9 @@ type LeaderboardServiceClient interface {
 	GetGlobalLeaderboard(ctx context.Context, in *GetGlobalLeaderboardRequest, opts ...grpc.CallOption) (*GetGlobalLeaderboardResponse, error)
 	GetTournamentLeaderboard(ctx context.Context, in *GetTournamentLeaderboardRequest, opts ...grpc.CallOption) (*GetTournamentLeaderboardResponse, error)
 	GetTournamentRank(ctx context.Context, in *GetTournamentRankRequest, opts ...grpc.CallOption) (*GetTournamentRankResponse, error)
+	GetSeasonLeaderboard(ctx context.Context, in *GetSeasonLeaderboardRequest, opts ...grpc.CallOption) (*GetSeasonLeaderboardResponse, error)
+	GetFriendsLeaderboard(ctx context.Context, in *GetFriendsLeaderboardRequest, opts ...grpc.CallOption) (*GetFriendsLeaderboardResponse, error)
+	GetRegionalLeaderboard(ctx context.Context, in *GetRegionalLeaderboardRequest, opts ...grpc.CallOption) (*GetRegionalLeaderboardResponse, error)
 }
 
 type leaderboardServiceClient struct {
@@ -73,6 +79,36 @@ func (c *leaderboardServiceClient) GetTournamentRank(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *leaderboardServiceClient) GetSeasonLeaderboard(ctx context.Context, in *GetSeasonLeaderboardRequest, opts ...grpc.CallOption) (*GetSeasonLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeasonLeaderboardResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetSeasonLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) GetFriendsLeaderboard(ctx context.Context, in *GetFriendsLeaderboardRequest, opts ...grpc.CallOption) (*GetFriendsLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFriendsLeaderboardResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetFriendsLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) GetRegionalLeaderboard(ctx context.Context, in *GetRegionalLeaderboardRequest, opts ...grpc.CallOption) (*GetRegionalLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRegionalLeaderboardResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetRegionalLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeaderboardServiceServer is the server API for LeaderboardService service.
 // All implementations must embed UnimplementedLeaderboardServiceServer
 // for forward compatibility.
@@ -82,6 +118,9 @@ type LeaderboardServiceServer interface {
 	GetGlobalLeaderboard(context.Context, *GetGlobalLeaderboardRequest) (*GetGlobalLeaderboardResponse, error)
 	GetTournamentLeaderboard(context.Context, *GetTournamentLeaderboardRequest) (*GetTournamentLeaderboardResponse, error)
 	GetTournamentRank(context.Context, *GetTournamentRankRequest) (*GetTournamentRankResponse, error)
+	GetSeasonLeaderboard(context.Context, *GetSeasonLeaderboardRequest) (*GetSeasonLeaderboardResponse, error)
+	GetFriendsLeaderboard(context.Context, *GetFriendsLeaderboardRequest) (*GetFriendsLeaderboardResponse, error)
+	GetRegionalLeaderboard(context.Context, *GetRegionalLeaderboardRequest) (*GetRegionalLeaderboardResponse, error)
 	mustEmbedUnimplementedLeaderboardServiceServer()
 }
 
@@ -101,6 +140,15 @@ func (UnimplementedLeaderboardServiceServer) GetTournamentLeaderboard(context.Co
 func (UnimplementedLeaderboardServiceServer) GetTournamentRank(context.Context, *GetTournamentRankRequest) (*GetTournamentRankResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTournamentRank not implemented")
 }
+func (UnimplementedLeaderboardServiceServer) GetSeasonLeaderboard(context.Context, *GetSeasonLeaderboardRequest) (*GetSeasonLeaderboardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSeasonLeaderboard not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) GetFriendsLeaderboard(context.Context, *GetFriendsLeaderboardRequest) (*GetFriendsLeaderboardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFriendsLeaderboard not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) GetRegionalLeaderboard(context.Context, *GetRegionalLeaderboardRequest) (*GetRegionalLeaderboardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRegionalLeaderboard not implemented")
+}
 func (UnimplementedLeaderboardServiceServer) mustEmbedUnimplementedLeaderboardServiceServer() {}
 func (UnimplementedLeaderboardServiceServer) testEmbeddedByValue()                            {}
 
@@ -176,6 +224,60 @@ func _LeaderboardService_GetTournamentRank_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaderboardService_GetSeasonLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetSeasonLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetSeasonLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetSeasonLeaderboard(ctx, req.(*GetSeasonLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_GetFriendsLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendsLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetFriendsLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetFriendsLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetFriendsLeaderboard(ctx, req.(*GetFriendsLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_GetRegionalLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionalLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetRegionalLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetRegionalLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetRegionalLeaderboard(ctx, req.(*GetRegionalLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LeaderboardService_ServiceDesc is the grpc.ServiceDesc for LeaderboardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,6 +297,18 @@ var LeaderboardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTournamentRank",
 			Handler:    _LeaderboardService_GetTournamentRank_Handler,
 		},
+		{
+			MethodName: "GetSeasonLeaderboard",
+			Handler:    _LeaderboardService_GetSeasonLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetFriendsLeaderboard",
+			Handler:    _LeaderboardService_GetFriendsLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetRegionalLeaderboard",
+			Handler:    _LeaderboardService_GetRegionalLeaderboard_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/grpc/leaderboard.proto",
@@ -23,8 +23,12 @@ const (
 
 // Requests
 type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Optional code of the user who referred the new user
+	ReferralCode string `protobuf:"bytes,2,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	// Optional ISO 3166-1 alpha-2 code, e.g. DE
+	Country       string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +70,20 @@ func (x *CreateUserRequest) GetDisplayName() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
 type GetUserByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -110,17 +128,62 @@ func (x *GetUserByIdRequest) GetUserId() string {
 	return ""
 }
 
+type GetUsersByIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByIdsRequest) Reset() {
+	*x = GetUsersByIdsRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIdsRequest) ProtoMessage() {}
+
+func (x *GetUsersByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUsersByIdsRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
 type UpdateProgressRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ProgressAmount int32                  `protobuf:"varint,2,opt,name=progress_amount,json=progressAmount,proto3" json:"progress_amount,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// XP gained
+	ProgressAmount int32 `protobuf:"varint,2,opt,name=progress_amount,json=progressAmount,proto3" json:"progress_amount,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateProgressRequest) Reset() {
 	*x = UpdateProgressRequest{}
-	mi := &file_v1_grpc_user_proto_msgTypes[2]
+	mi := &file_v1_grpc_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -132,7 +195,7 @@ func (x *UpdateProgressRequest) String() string {
 func (*UpdateProgressRequest) ProtoMessage() {}
 
 func (x *UpdateProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_user_proto_msgTypes[2]
+	mi := &file_v1_grpc_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -145,7 +208,7 @@ func (x *UpdateProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProgressRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProgressRequest) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_user_proto_rawDescGZIP(), []int{2}
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdateProgressRequest) GetUserId() string {
@@ -167,13 +230,14 @@ type CollectTournamentRewardRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
 	Coin          int32                  `protobuf:"varint,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CollectTournamentRewardRequest) Reset() {
 	*x = CollectTournamentRewardRequest{}
-	mi := &file_v1_grpc_user_proto_msgTypes[3]
+	mi := &file_v1_grpc_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -185,7 +249,7 @@ func (x *CollectTournamentRewardRequest) String() string {
 func (*CollectTournamentRewardRequest) ProtoMessage() {}
 
 func (x *CollectTournamentRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_user_proto_msgTypes[3]
+	mi := &file_v1_grpc_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +262,7 @@ func (x *CollectTournamentRewardRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectTournamentRewardRequest.ProtoReflect.Descriptor instead.
 func (*CollectTournamentRewardRequest) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_user_proto_rawDescGZIP(), []int{3}
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CollectTournamentRewardRequest) GetUserId() string {
@@ -222,30 +286,37 @@ func (x *CollectTournamentRewardRequest) GetCoin() int32 {
 	return 0
 }
 
-type ReserveCoinsRequest struct {
+func (x *CollectTournamentRewardRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CollectSeasonRewardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	TournamentId  string                 `protobuf:"bytes,3,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	SeasonId      string                 `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	Coin          int32                  `protobuf:"varint,3,opt,name=coin,proto3" json:"coin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveCoinsRequest) Reset() {
-	*x = ReserveCoinsRequest{}
-	mi := &file_v1_grpc_user_proto_msgTypes[4]
+func (x *CollectSeasonRewardRequest) Reset() {
+	*x = CollectSeasonRewardRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveCoinsRequest) String() string {
+func (x *CollectSeasonRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveCoinsRequest) ProtoMessage() {}
+func (*CollectSeasonRewardRequest) ProtoMessage() {}
 
-func (x *ReserveCoinsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_user_proto_msgTypes[4]
+func (x *CollectSeasonRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,55 +327,56 @@ func (x *ReserveCoinsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveCoinsRequest.ProtoReflect.Descriptor instead.
-func (*ReserveCoinsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_user_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CollectSeasonRewardRequest.ProtoReflect.Descriptor instead.
+func (*CollectSeasonRewardRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ReserveCoinsRequest) GetUserId() string {
+func (x *CollectSeasonRewardRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ReserveCoinsRequest) GetAmount() int64 {
+func (x *CollectSeasonRewardRequest) GetSeasonId() string {
 	if x != nil {
-		return x.Amount
+		return x.SeasonId
 	}
-	return 0
+	return ""
 }
 
-func (x *ReserveCoinsRequest) GetTournamentId() string {
+func (x *CollectSeasonRewardRequest) GetCoin() int32 {
 	if x != nil {
-		return x.TournamentId
+		return x.Coin
 	}
-	return ""
+	return 0
 }
 
-type ConfirmReservationRequest struct {
+type ListCoinTransactionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmReservationRequest) Reset() {
-	*x = ConfirmReservationRequest{}
-	mi := &file_v1_grpc_user_proto_msgTypes[5]
+func (x *ListCoinTransactionsRequest) Reset() {
+	*x = ListCoinTransactionsRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmReservationRequest) String() string {
+func (x *ListCoinTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmReservationRequest) ProtoMessage() {}
+func (*ListCoinTransactionsRequest) ProtoMessage() {}
 
-func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_user_proto_msgTypes[5]
+func (x *ListCoinTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,48 +387,55 @@ func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmReservationRequest.ProtoReflect.Descriptor instead.
-func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_user_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListCoinTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCoinTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ConfirmReservationRequest) GetUserId() string {
+func (x *ListCoinTransactionsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ConfirmReservationRequest) GetTournamentId() string {
+func (x *ListCoinTransactionsRequest) GetPageToken() string {
 	if x != nil {
-		return x.TournamentId
+		return x.PageToken
 	}
 	return ""
 }
 
-type RollbackReservationRequest struct {
+func (x *ListCoinTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type UpdateDisplayNameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RollbackReservationRequest) Reset() {
-	*x = RollbackReservationRequest{}
-	mi := &file_v1_grpc_user_proto_msgTypes[6]
+func (x *UpdateDisplayNameRequest) Reset() {
+	*x = UpdateDisplayNameRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollbackReservationRequest) String() string {
+func (x *UpdateDisplayNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollbackReservationRequest) ProtoMessage() {}
+func (*UpdateDisplayNameRequest) ProtoMessage() {}
 
-func (x *RollbackReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_user_proto_msgTypes[6]
+func (x *UpdateDisplayNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,48 +446,49 @@ func (x *RollbackReservationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollbackReservationRequest.ProtoReflect.Descriptor instead.
-func (*RollbackReservationRequest) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_user_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdateDisplayNameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDisplayNameRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RollbackReservationRequest) GetUserId() string {
+func (x *UpdateDisplayNameRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *RollbackReservationRequest) GetTournamentId() string {
+func (x *UpdateDisplayNameRequest) GetDisplayName() string {
 	if x != nil {
-		return x.TournamentId
+		return x.DisplayName
 	}
 	return ""
 }
 
-// Responses
-type CreateUserResponse struct {
+// An empty country clears it
+type UpdateCountryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
-	mi := &file_v1_grpc_user_proto_msgTypes[7]
+func (x *UpdateCountryRequest) Reset() {
+	*x = UpdateCountryRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *UpdateCountryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*UpdateCountryRequest) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_user_proto_msgTypes[7]
+func (x *UpdateCountryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,43 +499,47 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_user_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdateCountryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCountryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateUserResponse) GetUserId() string {
+func (x *UpdateCountryRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetUserByIdResponse struct {
+func (x *UpdateCountryRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type ClaimDailyRewardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
-	Coin          int32                  `protobuf:"varint,4,opt,name=coin,proto3" json:"coin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByIdResponse) Reset() {
-	*x = GetUserByIdResponse{}
-	mi := &file_v1_grpc_user_proto_msgTypes[8]
+func (x *ClaimDailyRewardRequest) Reset() {
+	*x = ClaimDailyRewardRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserByIdResponse) String() string {
+func (x *ClaimDailyRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByIdResponse) ProtoMessage() {}
+func (*ClaimDailyRewardRequest) ProtoMessage() {}
 
-func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_user_proto_msgTypes[8]
+func (x *ClaimDailyRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,63 +550,84 @@ func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetUserByIdResponse) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_user_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ClaimDailyRewardRequest.ProtoReflect.Descriptor instead.
+func (*ClaimDailyRewardRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetUserByIdResponse) GetUserId() string {
+func (x *ClaimDailyRewardRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetUserByIdResponse) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+type PurchaseStreakFreezeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByIdResponse) GetLevel() int32 {
+func (x *PurchaseStreakFreezeRequest) Reset() {
+	*x = PurchaseStreakFreezeRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseStreakFreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseStreakFreezeRequest) ProtoMessage() {}
+
+func (x *PurchaseStreakFreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[10]
 	if x != nil {
-		return x.Level
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetUserByIdResponse) GetCoin() int32 {
+// Deprecated: Use PurchaseStreakFreezeRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseStreakFreezeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PurchaseStreakFreezeRequest) GetUserId() string {
 	if x != nil {
-		return x.Coin
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-type UpdateProgressResponse struct {
+type ListAchievementsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
-	Coin          int32                  `protobuf:"varint,3,opt,name=coin,proto3" json:"coin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProgressResponse) Reset() {
-	*x = UpdateProgressResponse{}
-	mi := &file_v1_grpc_user_proto_msgTypes[9]
+func (x *ListAchievementsRequest) Reset() {
+	*x = ListAchievementsRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProgressResponse) String() string {
+func (x *ListAchievementsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProgressResponse) ProtoMessage() {}
+func (*ListAchievementsRequest) ProtoMessage() {}
 
-func (x *UpdateProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_grpc_user_proto_msgTypes[9]
+func (x *ListAchievementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,75 +638,3395 @@ func (x *UpdateProgressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProgressResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProgressResponse) Descriptor() ([]byte, []int) {
-	return file_v1_grpc_user_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListAchievementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAchievementsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateProgressResponse) GetUserId() string {
+func (x *ListAchievementsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateProgressResponse) GetLevel() int32 {
+type SendFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FriendId      string                 `protobuf:"bytes,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendRequestRequest) Reset() {
+	*x = SendFriendRequestRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestRequest) ProtoMessage() {}
+
+func (x *SendFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[12]
 	if x != nil {
-		return x.Level
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateProgressResponse) GetCoin() int32 {
+// Deprecated: Use SendFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SendFriendRequestRequest) GetUserId() string {
 	if x != nil {
-		return x.Coin
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-var File_v1_grpc_user_proto protoreflect.FileDescriptor
+func (x *SendFriendRequestRequest) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
 
-const file_v1_grpc_user_proto_rawDesc = "" +
-	"\n" +
-	"\x12v1/grpc/user.proto\x12\x04grpc\x1a\x14v1/grpc/common.proto\"6\n" +
-	"\x11CreateUserRequest\x12!\n" +
-	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\"-\n" +
-	"\x12GetUserByIdRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"Y\n" +
-	"\x15UpdateProgressRequest\x12\x17\n" +
+type AcceptFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FriendId      string                 `protobuf:"bytes,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFriendRequestRequest) Reset() {
+	*x = AcceptFriendRequestRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequestRequest) ProtoMessage() {}
+
+func (x *AcceptFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AcceptFriendRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptFriendRequestRequest) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
+
+// Removes a friend, declines an incoming request or cancels an outgoing one
+type RemoveFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FriendId      string                 `protobuf:"bytes,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendRequest) Reset() {
+	*x = RemoveFriendRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendRequest) ProtoMessage() {}
+
+func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveFriendRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFriendRequest) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
+
+type ListFriendsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Empty lists every status
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsRequest) Reset() {
+	*x = ListFriendsRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsRequest) ProtoMessage() {}
+
+func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListFriendsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListFriendsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListInboxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxRequest) Reset() {
+	*x = ListInboxRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxRequest) ProtoMessage() {}
+
+func (x *ListInboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxRequest.ProtoReflect.Descriptor instead.
+func (*ListInboxRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListInboxRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListInboxRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListInboxRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Without message ids every unread message is marked as read
+type MarkInboxReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MessageIds    []string               `protobuf:"bytes,2,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInboxReadRequest) Reset() {
+	*x = MarkInboxReadRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInboxReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInboxReadRequest) ProtoMessage() {}
+
+func (x *MarkInboxReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInboxReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkInboxReadRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MarkInboxReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkInboxReadRequest) GetMessageIds() []string {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+type GetInboxUnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInboxUnreadCountRequest) Reset() {
+	*x = GetInboxUnreadCountRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInboxUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInboxUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetInboxUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInboxUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetInboxUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetInboxUnreadCountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPushPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPushPreferencesRequest) Reset() {
+	*x = GetPushPreferencesRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPushPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPushPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPushPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Replaces every push preference of the user. Quiet hours are HH:MM in the
+// timezone, both empty disables them.
+type UpdatePushPreferencesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Enabled         bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MutedTypes      []string               `protobuf:"bytes,3,rep,name=muted_types,json=mutedTypes,proto3" json:"muted_types,omitempty"`
+	QuietHoursStart string                 `protobuf:"bytes,4,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string                 `protobuf:"bytes,5,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`
+	Timezone        string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdatePushPreferencesRequest) Reset() {
+	*x = UpdatePushPreferencesRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePushPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePushPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePushPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePushPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePushPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdatePushPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePushPreferencesRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdatePushPreferencesRequest) GetMutedTypes() []string {
+	if x != nil {
+		return x.MutedTypes
+	}
+	return nil
+}
+
+func (x *UpdatePushPreferencesRequest) GetQuietHoursStart() string {
+	if x != nil {
+		return x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *UpdatePushPreferencesRequest) GetQuietHoursEnd() string {
+	if x != nil {
+		return x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *UpdatePushPreferencesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type GetReferralInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralInfoRequest) Reset() {
+	*x = GetReferralInfoRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralInfoRequest) ProtoMessage() {}
+
+func (x *GetReferralInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetReferralInfoRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetReferralInfoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Admin only. request_id makes the grant idempotent, repeating it returns the
+// recorded adjustment. The operator is the authenticated admin, operator_id is
+// optional and must match them, the same holds for every admin request.
+type GrantCurrencyRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Currency        string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount          int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OperatorId      string                 `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	TicketReference string                 `protobuf:"bytes,6,opt,name=ticket_reference,json=ticketReference,proto3" json:"ticket_reference,omitempty"`
+	RequestId       string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GrantCurrencyRequest) Reset() {
+	*x = GrantCurrencyRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantCurrencyRequest) ProtoMessage() {}
+
+func (x *GrantCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*GrantCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GrantCurrencyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GrantCurrencyRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GrantCurrencyRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GrantCurrencyRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *GrantCurrencyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GrantCurrencyRequest) GetTicketReference() string {
+	if x != nil {
+		return x.TicketReference
+	}
+	return ""
+}
+
+func (x *GrantCurrencyRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// Admin only. Fails instead of driving the balance negative.
+type RevokeCurrencyRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Currency        string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount          int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OperatorId      string                 `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	TicketReference string                 `protobuf:"bytes,6,opt,name=ticket_reference,json=ticketReference,proto3" json:"ticket_reference,omitempty"`
+	RequestId       string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RevokeCurrencyRequest) Reset() {
+	*x = RevokeCurrencyRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCurrencyRequest) ProtoMessage() {}
+
+func (x *RevokeCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RevokeCurrencyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeCurrencyRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RevokeCurrencyRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RevokeCurrencyRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *RevokeCurrencyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RevokeCurrencyRequest) GetTicketReference() string {
+	if x != nil {
+		return x.TicketReference
+	}
+	return ""
+}
+
+func (x *RevokeCurrencyRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// Admin only. Blocks gameplay until suspended_until (unix seconds).
+type SuspendUserRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SuspendedUntil int64                  `protobuf:"varint,2,opt,name=suspended_until,json=suspendedUntil,proto3" json:"suspended_until,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SuspendUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *SuspendUserRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Admin only. Blocks gameplay for good and hides the user from leaderboards.
+type BanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BanUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Admin only. Lifts a suspension or ban.
+type ReinstateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateUserRequest) Reset() {
+	*x = ReinstateUserRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateUserRequest) ProtoMessage() {}
+
+func (x *ReinstateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReinstateUserRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReinstateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReinstateUserRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *ReinstateUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserDeletionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDeletionStatusRequest) Reset() {
+	*x = GetUserDeletionStatusRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDeletionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDeletionStatusRequest) ProtoMessage() {}
+
+func (x *GetUserDeletionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDeletionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDeletionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUserDeletionStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReserveCoinsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,3,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveCoinsRequest) Reset() {
+	*x = ReserveCoinsRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveCoinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveCoinsRequest) ProtoMessage() {}
+
+func (x *ReserveCoinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveCoinsRequest.ProtoReflect.Descriptor instead.
+func (*ReserveCoinsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReserveCoinsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReserveCoinsRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReserveCoinsRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *ReserveCoinsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ConfirmReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmReservationRequest) Reset() {
+	*x = ConfirmReservationRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReservationRequest) ProtoMessage() {}
+
+func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReservationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ConfirmReservationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmReservationRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type RollbackReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackReservationRequest) Reset() {
+	*x = RollbackReservationRequest{}
+	mi := &file_v1_grpc_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackReservationRequest) ProtoMessage() {}
+
+func (x *RollbackReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackReservationRequest.ProtoReflect.Descriptor instead.
+func (*RollbackReservationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RollbackReservationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RollbackReservationRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+// Responses
+type CreateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReferralCode  string                 `protobuf:"bytes,2,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateUserResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateUserResponse) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+type GetUserByIdResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Level       int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Coin        int32                  `protobuf:"varint,4,opt,name=coin,proto3" json:"coin,omitempty"`
+	CreatedAt   int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Balances    map[string]int32       `protobuf:"bytes,6,rep,name=balances,proto3" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Xp          int32                  `protobuf:"varint,7,opt,name=xp,proto3" json:"xp,omitempty"`
+	Prestige    int32                  `protobuf:"varint,8,opt,name=prestige,proto3" json:"prestige,omitempty"`
+	// ACTIVE, SUSPENDED or BANNED, an ended suspension is ACTIVE
+	ModerationStatus string `protobuf:"bytes,9,opt,name=moderation_status,json=moderationStatus,proto3" json:"moderation_status,omitempty"`
+	SuspendedUntil   int64  `protobuf:"varint,10,opt,name=suspended_until,json=suspendedUntil,proto3" json:"suspended_until,omitempty"`
+	Country          string `protobuf:"bytes,11,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetUserByIdResponse) Reset() {
+	*x = GetUserByIdResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIdResponse) ProtoMessage() {}
+
+func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetUserByIdResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUserByIdResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserByIdResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *GetUserByIdResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GetUserByIdResponse) GetCoin() int32 {
+	if x != nil {
+		return x.Coin
+	}
+	return 0
+}
+
+func (x *GetUserByIdResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GetUserByIdResponse) GetBalances() map[string]int32 {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *GetUserByIdResponse) GetXp() int32 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *GetUserByIdResponse) GetPrestige() int32 {
+	if x != nil {
+		return x.Prestige
+	}
+	return 0
+}
+
+func (x *GetUserByIdResponse) GetModerationStatus() string {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ""
+}
+
+func (x *GetUserByIdResponse) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *GetUserByIdResponse) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type GetUsersByIdsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Users          []*GetUserByIdResponse `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	MissingUserIds []string               `protobuf:"bytes,2,rep,name=missing_user_ids,json=missingUserIds,proto3" json:"missing_user_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUsersByIdsResponse) Reset() {
+	*x = GetUsersByIdsResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIdsResponse) ProtoMessage() {}
+
+func (x *GetUsersByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetUsersByIdsResponse) GetUsers() []*GetUserByIdResponse {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetUsersByIdsResponse) GetMissingUserIds() []string {
+	if x != nil {
+		return x.MissingUserIds
+	}
+	return nil
+}
+
+type UpdateProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Coin          int32                  `protobuf:"varint,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	Xp            int32                  `protobuf:"varint,4,opt,name=xp,proto3" json:"xp,omitempty"`
+	Prestige      int32                  `protobuf:"varint,5,opt,name=prestige,proto3" json:"prestige,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProgressResponse) Reset() {
+	*x = UpdateProgressResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProgressResponse) ProtoMessage() {}
+
+func (x *UpdateProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProgressResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProgressResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateProgressResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateProgressResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *UpdateProgressResponse) GetCoin() int32 {
+	if x != nil {
+		return x.Coin
+	}
+	return 0
+}
+
+func (x *UpdateProgressResponse) GetXp() int32 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *UpdateProgressResponse) GetPrestige() int32 {
+	if x != nil {
+		return x.Prestige
+	}
+	return 0
+}
+
+type ListCoinTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*CoinTransaction     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoinTransactionsResponse) Reset() {
+	*x = ListCoinTransactionsResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoinTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoinTransactionsResponse) ProtoMessage() {}
+
+func (x *ListCoinTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoinTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCoinTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListCoinTransactionsResponse) GetTransactions() []*CoinTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListCoinTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateDisplayNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDisplayNameResponse) Reset() {
+	*x = UpdateDisplayNameResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDisplayNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDisplayNameResponse) ProtoMessage() {}
+
+func (x *UpdateDisplayNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDisplayNameResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDisplayNameResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateDisplayNameResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateDisplayNameResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type UpdateCountryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCountryResponse) Reset() {
+	*x = UpdateCountryResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCountryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCountryResponse) ProtoMessage() {}
+
+func (x *UpdateCountryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCountryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCountryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateCountryResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateCountryResponse) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type ClaimDailyRewardResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Calendar day of the claim in the configured timezone, YYYY-MM-DD
+	Day            string `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	Streak         int32  `protobuf:"varint,3,opt,name=streak,proto3" json:"streak,omitempty"`
+	Reward         int32  `protobuf:"varint,4,opt,name=reward,proto3" json:"reward,omitempty"`
+	FreezesUsed    int32  `protobuf:"varint,5,opt,name=freezes_used,json=freezesUsed,proto3" json:"freezes_used,omitempty"`
+	StreakFreezes  int32  `protobuf:"varint,6,opt,name=streak_freezes,json=streakFreezes,proto3" json:"streak_freezes,omitempty"`
+	Coin           int32  `protobuf:"varint,7,opt,name=coin,proto3" json:"coin,omitempty"`
+	AlreadyClaimed bool   `protobuf:"varint,8,opt,name=already_claimed,json=alreadyClaimed,proto3" json:"already_claimed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClaimDailyRewardResponse) Reset() {
+	*x = ClaimDailyRewardResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimDailyRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimDailyRewardResponse) ProtoMessage() {}
+
+func (x *ClaimDailyRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimDailyRewardResponse.ProtoReflect.Descriptor instead.
+func (*ClaimDailyRewardResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ClaimDailyRewardResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClaimDailyRewardResponse) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *ClaimDailyRewardResponse) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *ClaimDailyRewardResponse) GetReward() int32 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *ClaimDailyRewardResponse) GetFreezesUsed() int32 {
+	if x != nil {
+		return x.FreezesUsed
+	}
+	return 0
+}
+
+func (x *ClaimDailyRewardResponse) GetStreakFreezes() int32 {
+	if x != nil {
+		return x.StreakFreezes
+	}
+	return 0
+}
+
+func (x *ClaimDailyRewardResponse) GetCoin() int32 {
+	if x != nil {
+		return x.Coin
+	}
+	return 0
+}
+
+func (x *ClaimDailyRewardResponse) GetAlreadyClaimed() bool {
+	if x != nil {
+		return x.AlreadyClaimed
+	}
+	return false
+}
+
+type PurchaseStreakFreezeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StreakFreezes int32                  `protobuf:"varint,2,opt,name=streak_freezes,json=streakFreezes,proto3" json:"streak_freezes,omitempty"`
+	Coin          int32                  `protobuf:"varint,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseStreakFreezeResponse) Reset() {
+	*x = PurchaseStreakFreezeResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseStreakFreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseStreakFreezeResponse) ProtoMessage() {}
+
+func (x *PurchaseStreakFreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseStreakFreezeResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseStreakFreezeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PurchaseStreakFreezeResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PurchaseStreakFreezeResponse) GetStreakFreezes() int32 {
+	if x != nil {
+		return x.StreakFreezes
+	}
+	return 0
+}
+
+func (x *PurchaseStreakFreezeResponse) GetCoin() int32 {
+	if x != nil {
+		return x.Coin
+	}
+	return 0
+}
+
+type ListAchievementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Achievements  []*Achievement         `protobuf:"bytes,1,rep,name=achievements,proto3" json:"achievements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAchievementsResponse) Reset() {
+	*x = ListAchievementsResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAchievementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAchievementsResponse) ProtoMessage() {}
+
+func (x *ListAchievementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAchievementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAchievementsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListAchievementsResponse) GetAchievements() []*Achievement {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+type FriendshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friend        *Friend                `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendshipResponse) Reset() {
+	*x = FriendshipResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendshipResponse) ProtoMessage() {}
+
+func (x *FriendshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendshipResponse.ProtoReflect.Descriptor instead.
+func (*FriendshipResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FriendshipResponse) GetFriend() *Friend {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+type ListFriendsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friends       []*Friend              `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsResponse) Reset() {
+	*x = ListFriendsResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsResponse) ProtoMessage() {}
+
+func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsResponse.ProtoReflect.Descriptor instead.
+func (*ListFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListFriendsResponse) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+type ListInboxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*InboxMessage        `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxResponse) Reset() {
+	*x = ListInboxResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxResponse) ProtoMessage() {}
+
+func (x *ListInboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxResponse.ProtoReflect.Descriptor instead.
+func (*ListInboxResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListInboxResponse) GetMessages() []*InboxMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListInboxResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListInboxResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkInboxReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Marked        int32                  `protobuf:"varint,1,opt,name=marked,proto3" json:"marked,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInboxReadResponse) Reset() {
+	*x = MarkInboxReadResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInboxReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInboxReadResponse) ProtoMessage() {}
+
+func (x *MarkInboxReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInboxReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkInboxReadResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MarkInboxReadResponse) GetMarked() int32 {
+	if x != nil {
+		return x.Marked
+	}
+	return 0
+}
+
+func (x *MarkInboxReadResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type InboxUnreadCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int32                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxUnreadCountResponse) Reset() {
+	*x = InboxUnreadCountResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxUnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxUnreadCountResponse) ProtoMessage() {}
+
+func (x *InboxUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*InboxUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *InboxUnreadCountResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type PushPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *PushPreferences       `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushPreferencesResponse) Reset() {
+	*x = PushPreferencesResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPreferencesResponse) ProtoMessage() {}
+
+func (x *PushPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*PushPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PushPreferencesResponse) GetPreferences() *PushPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type GetReferralInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferralCode  string                 `protobuf:"bytes,1,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	ReferredBy    string                 `protobuf:"bytes,2,opt,name=referred_by,json=referredBy,proto3" json:"referred_by,omitempty"`
+	Referrals     []*Referral            `protobuf:"bytes,3,rep,name=referrals,proto3" json:"referrals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralInfoResponse) Reset() {
+	*x = GetReferralInfoResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralInfoResponse) ProtoMessage() {}
+
+func (x *GetReferralInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetReferralInfoResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetReferralInfoResponse) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+func (x *GetReferralInfoResponse) GetReferredBy() string {
+	if x != nil {
+		return x.ReferredBy
+	}
+	return ""
+}
+
+func (x *GetReferralInfoResponse) GetReferrals() []*Referral {
+	if x != nil {
+		return x.Referrals
+	}
+	return nil
+}
+
+type BalanceAdjustmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Adjustment     *BalanceAdjustment     `protobuf:"bytes,1,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+	BalanceAfter   int32                  `protobuf:"varint,2,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	AlreadyApplied bool                   `protobuf:"varint,3,opt,name=already_applied,json=alreadyApplied,proto3" json:"already_applied,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BalanceAdjustmentResponse) Reset() {
+	*x = BalanceAdjustmentResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceAdjustmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceAdjustmentResponse) ProtoMessage() {}
+
+func (x *BalanceAdjustmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceAdjustmentResponse.ProtoReflect.Descriptor instead.
+func (*BalanceAdjustmentResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BalanceAdjustmentResponse) GetAdjustment() *BalanceAdjustment {
+	if x != nil {
+		return x.Adjustment
+	}
+	return nil
+}
+
+func (x *BalanceAdjustmentResponse) GetBalanceAfter() int32 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *BalanceAdjustmentResponse) GetAlreadyApplied() bool {
+	if x != nil {
+		return x.AlreadyApplied
+	}
+	return false
+}
+
+type ModerationResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// ACTIVE, SUSPENDED or BANNED
+	Status         string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	SuspendedUntil int64  `protobuf:"varint,3,opt,name=suspended_until,json=suspendedUntil,proto3" json:"suspended_until,omitempty"`
+	Reason         string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorId     string `protobuf:"bytes,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	UpdatedAt      int64  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ModerationResponse) Reset() {
+	*x = ModerationResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationResponse) ProtoMessage() {}
+
+func (x *ModerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationResponse.ProtoReflect.Descriptor instead.
+func (*ModerationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ModerationResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ModerationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerationResponse) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *ModerationResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationResponse) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *ModerationResponse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type UserDeletionStatusResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status          string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PendingServices []string               `protobuf:"bytes,3,rep,name=pending_services,json=pendingServices,proto3" json:"pending_services,omitempty"`
+	RequestedAt     int64                  `protobuf:"varint,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	CompletedAt     int64                  `protobuf:"varint,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserDeletionStatusResponse) Reset() {
+	*x = UserDeletionStatusResponse{}
+	mi := &file_v1_grpc_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeletionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeletionStatusResponse) ProtoMessage() {}
+
+func (x *UserDeletionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeletionStatusResponse.ProtoReflect.Descriptor instead.
+func (*UserDeletionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UserDeletionStatusResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDeletionStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserDeletionStatusResponse) GetPendingServices() []string {
+	if x != nil {
+		return x.PendingServices
+	}
+	return nil
+}
+
+func (x *UserDeletionStatusResponse) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *UserDeletionStatusResponse) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+// Types
+type CoinTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceAfter  int64                  `protobuf:"varint,3,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinTransaction) Reset() {
+	*x = CoinTransaction{}
+	mi := &file_v1_grpc_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinTransaction) ProtoMessage() {}
+
+func (x *CoinTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinTransaction.ProtoReflect.Descriptor instead.
+func (*CoinTransaction) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CoinTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CoinTransaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CoinTransaction) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *CoinTransaction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CoinTransaction) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *CoinTransaction) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CoinTransaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Achievement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AchievementId string                 `protobuf:"bytes,1,opt,name=achievement_id,json=achievementId,proto3" json:"achievement_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Metric        string                 `protobuf:"bytes,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	Threshold     int32                  `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Progress      int32                  `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	Reward        int32                  `protobuf:"varint,7,opt,name=reward,proto3" json:"reward,omitempty"`
+	Unlocked      bool                   `protobuf:"varint,8,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	UnlockedAt    int64                  `protobuf:"varint,9,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	mi := &file_v1_grpc_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievement) ProtoMessage() {}
+
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Achievement) GetAchievementId() string {
+	if x != nil {
+		return x.AchievementId
+	}
+	return ""
+}
+
+func (x *Achievement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Achievement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Achievement) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *Achievement) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Achievement) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Achievement) GetReward() int32 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *Achievement) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+func (x *Achievement) GetUnlockedAt() int64 {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return 0
+}
+
+type Friend struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// PENDING_OUTGOING, PENDING_INCOMING or ACCEPTED
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	UpdatedAt     int64  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Friend) Reset() {
+	*x = Friend{}
+	mi := &file_v1_grpc_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Friend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Friend) ProtoMessage() {}
+
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Friend) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Friend) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Friend) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Friend) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type InboxMessage struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MessageId string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// TOURNAMENT_ENDED, REWARD_AVAILABLE, OVERTAKEN or RESERVATION_ROLLED_BACK
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	ReferenceId   string `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	IsRead        bool   `protobuf:"varint,6,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReadAt        int64  `protobuf:"varint,8,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage) Reset() {
+	*x = InboxMessage{}
+	mi := &file_v1_grpc_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage) ProtoMessage() {}
+
+func (x *InboxMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage.ProtoReflect.Descriptor instead.
+func (*InboxMessage) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InboxMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *InboxMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetReadAt() int64 {
+	if x != nil {
+		return x.ReadAt
+	}
+	return 0
+}
+
+type PushPreferences struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MutedTypes      []string               `protobuf:"bytes,2,rep,name=muted_types,json=mutedTypes,proto3" json:"muted_types,omitempty"`
+	QuietHoursStart string                 `protobuf:"bytes,3,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string                 `protobuf:"bytes,4,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`
+	Timezone        string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PushPreferences) Reset() {
+	*x = PushPreferences{}
+	mi := &file_v1_grpc_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPreferences) ProtoMessage() {}
+
+func (x *PushPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPreferences.ProtoReflect.Descriptor instead.
+func (*PushPreferences) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PushPreferences) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PushPreferences) GetMutedTypes() []string {
+	if x != nil {
+		return x.MutedTypes
+	}
+	return nil
+}
+
+func (x *PushPreferences) GetQuietHoursStart() string {
+	if x != nil {
+		return x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *PushPreferences) GetQuietHoursEnd() string {
+	if x != nil {
+		return x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *PushPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type Referral struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RefereeId   string                 `protobuf:"bytes,1,opt,name=referee_id,json=refereeId,proto3" json:"referee_id,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// PENDING or COMPLETED
+	Status           string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ReferrerRewarded bool   `protobuf:"varint,4,opt,name=referrer_rewarded,json=referrerRewarded,proto3" json:"referrer_rewarded,omitempty"`
+	CreatedAt        int64  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt      int64  `protobuf:"varint,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Referral) Reset() {
+	*x = Referral{}
+	mi := &file_v1_grpc_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Referral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Referral) ProtoMessage() {}
+
+func (x *Referral) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Referral.ProtoReflect.Descriptor instead.
+func (*Referral) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Referral) GetRefereeId() string {
+	if x != nil {
+		return x.RefereeId
+	}
+	return ""
+}
+
+func (x *Referral) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Referral) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Referral) GetReferrerRewarded() bool {
+	if x != nil {
+		return x.ReferrerRewarded
+	}
+	return false
+}
+
+func (x *Referral) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Referral) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+type BalanceAdjustment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// GRANT or REVOKE
+	Type            string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Currency        string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount          int32  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	OperatorId      string `protobuf:"bytes,6,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Reason          string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	TicketReference string `protobuf:"bytes,8,opt,name=ticket_reference,json=ticketReference,proto3" json:"ticket_reference,omitempty"`
+	CreatedAt       int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BalanceAdjustment) Reset() {
+	*x = BalanceAdjustment{}
+	mi := &file_v1_grpc_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceAdjustment) ProtoMessage() {}
+
+func (x *BalanceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_grpc_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceAdjustment.ProtoReflect.Descriptor instead.
+func (*BalanceAdjustment) Descriptor() ([]byte, []int) {
+	return file_v1_grpc_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BalanceAdjustment) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BalanceAdjustment) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetTicketReference() string {
+	if x != nil {
+		return x.TicketReference
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+var File_v1_grpc_user_proto protoreflect.FileDescriptor
+
+const file_v1_grpc_user_proto_rawDesc = "" +
+	"\n" +
+	"\x12v1/grpc/user.proto\x12\x04grpc\x1a\x14v1/grpc/common.proto\"u\n" +
+	"\x11CreateUserRequest\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12#\n" +
+	"\rreferral_code\x18\x02 \x01(\tR\freferralCode\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\"-\n" +
+	"\x12GetUserByIdRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"1\n" +
+	"\x14GetUsersByIdsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"Y\n" +
+	"\x15UpdateProgressRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
-	"\x0fprogress_amount\x18\x02 \x01(\x05R\x0eprogressAmount\"r\n" +
+	"\x0fprogress_amount\x18\x02 \x01(\x05R\x0eprogressAmount\"\x8e\x01\n" +
 	"\x1eCollectTournamentRewardRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
 	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\x12\x12\n" +
-	"\x04coin\x18\x03 \x01(\x05R\x04coin\"k\n" +
+	"\x04coin\x18\x03 \x01(\x05R\x04coin\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"f\n" +
+	"\x1aCollectSeasonRewardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12\x12\n" +
+	"\x04coin\x18\x03 \x01(\x05R\x04coin\"r\n" +
+	"\x1bListCoinTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"V\n" +
+	"\x18UpdateDisplayNameRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"I\n" +
+	"\x14UpdateCountryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\"2\n" +
+	"\x17ClaimDailyRewardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"6\n" +
+	"\x1bPurchaseStreakFreezeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"2\n" +
+	"\x17ListAchievementsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"P\n" +
+	"\x18SendFriendRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfriend_id\x18\x02 \x01(\tR\bfriendId\"R\n" +
+	"\x1aAcceptFriendRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfriend_id\x18\x02 \x01(\tR\bfriendId\"K\n" +
+	"\x13RemoveFriendRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfriend_id\x18\x02 \x01(\tR\bfriendId\"E\n" +
+	"\x12ListFriendsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"g\n" +
+	"\x10ListInboxRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"P\n" +
+	"\x14MarkInboxReadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vmessage_ids\x18\x02 \x03(\tR\n" +
+	"messageIds\"5\n" +
+	"\x1aGetInboxUnreadCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"4\n" +
+	"\x19GetPushPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xe2\x01\n" +
+	"\x1cUpdatePushPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vmuted_types\x18\x03 \x03(\tR\n" +
+	"mutedTypes\x12*\n" +
+	"\x11quiet_hours_start\x18\x04 \x01(\tR\x0fquietHoursStart\x12&\n" +
+	"\x0fquiet_hours_end\x18\x05 \x01(\tR\rquietHoursEnd\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\"1\n" +
+	"\x16GetReferralInfoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xe6\x01\n" +
+	"\x14GrantCurrencyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12)\n" +
+	"\x10ticket_reference\x18\x06 \x01(\tR\x0fticketReference\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\"\xe7\x01\n" +
+	"\x15RevokeCurrencyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12)\n" +
+	"\x10ticket_reference\x18\x06 \x01(\tR\x0fticketReference\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\"\x8f\x01\n" +
+	"\x12SuspendUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fsuspended_until\x18\x02 \x01(\x03R\x0esuspendedUntil\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"b\n" +
+	"\x0eBanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"h\n" +
+	"\x14ReinstateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\",\n" +
+	"\x11DeleteUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"7\n" +
+	"\x1cGetUserDeletionStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x87\x01\n" +
 	"\x13ReserveCoinsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12#\n" +
-	"\rtournament_id\x18\x03 \x01(\tR\ftournamentId\"Y\n" +
+	"\rtournament_id\x18\x03 \x01(\tR\ftournamentId\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"Y\n" +
 	"\x19ConfirmReservationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
 	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"Z\n" +
 	"\x1aRollbackReservationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
-	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"-\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\"R\n" +
 	"\x12CreateUserResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"{\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rreferral_code\x18\x02 \x01(\tR\freferralCode\"\xb8\x03\n" +
 	"\x13GetUserByIdResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
 	"\x05level\x18\x03 \x01(\x05R\x05level\x12\x12\n" +
-	"\x04coin\x18\x04 \x01(\x05R\x04coin\"[\n" +
+	"\x04coin\x18\x04 \x01(\x05R\x04coin\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12C\n" +
+	"\bbalances\x18\x06 \x03(\v2'.grpc.GetUserByIdResponse.BalancesEntryR\bbalances\x12\x0e\n" +
+	"\x02xp\x18\a \x01(\x05R\x02xp\x12\x1a\n" +
+	"\bprestige\x18\b \x01(\x05R\bprestige\x12+\n" +
+	"\x11moderation_status\x18\t \x01(\tR\x10moderationStatus\x12'\n" +
+	"\x0fsuspended_until\x18\n" +
+	" \x01(\x03R\x0esuspendedUntil\x12\x18\n" +
+	"\acountry\x18\v \x01(\tR\acountry\x1a;\n" +
+	"\rBalancesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"r\n" +
+	"\x15GetUsersByIdsResponse\x12/\n" +
+	"\x05users\x18\x01 \x03(\v2\x19.grpc.GetUserByIdResponseR\x05users\x12(\n" +
+	"\x10missing_user_ids\x18\x02 \x03(\tR\x0emissingUserIds\"\x87\x01\n" +
 	"\x16UpdateProgressResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x12\n" +
-	"\x04coin\x18\x03 \x01(\x05R\x04coin2\x93\x04\n" +
+	"\x04coin\x18\x03 \x01(\x05R\x04coin\x12\x0e\n" +
+	"\x02xp\x18\x04 \x01(\x05R\x02xp\x12\x1a\n" +
+	"\bprestige\x18\x05 \x01(\x05R\bprestige\"\x81\x01\n" +
+	"\x1cListCoinTransactionsResponse\x129\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x15.grpc.CoinTransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"W\n" +
+	"\x19UpdateDisplayNameResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"J\n" +
+	"\x15UpdateCountryResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\"\xfc\x01\n" +
+	"\x18ClaimDailyRewardResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\tR\x03day\x12\x16\n" +
+	"\x06streak\x18\x03 \x01(\x05R\x06streak\x12\x16\n" +
+	"\x06reward\x18\x04 \x01(\x05R\x06reward\x12!\n" +
+	"\ffreezes_used\x18\x05 \x01(\x05R\vfreezesUsed\x12%\n" +
+	"\x0estreak_freezes\x18\x06 \x01(\x05R\rstreakFreezes\x12\x12\n" +
+	"\x04coin\x18\a \x01(\x05R\x04coin\x12'\n" +
+	"\x0falready_claimed\x18\b \x01(\bR\x0ealreadyClaimed\"r\n" +
+	"\x1cPurchaseStreakFreezeResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0estreak_freezes\x18\x02 \x01(\x05R\rstreakFreezes\x12\x12\n" +
+	"\x04coin\x18\x03 \x01(\x05R\x04coin\"Q\n" +
+	"\x18ListAchievementsResponse\x125\n" +
+	"\fachievements\x18\x01 \x03(\v2\x11.grpc.AchievementR\fachievements\":\n" +
+	"\x12FriendshipResponse\x12$\n" +
+	"\x06friend\x18\x01 \x01(\v2\f.grpc.FriendR\x06friend\"=\n" +
+	"\x13ListFriendsResponse\x12&\n" +
+	"\afriends\x18\x01 \x03(\v2\f.grpc.FriendR\afriends\"\x8e\x01\n" +
+	"\x11ListInboxResponse\x12.\n" +
+	"\bmessages\x18\x01 \x03(\v2\x12.grpc.InboxMessageR\bmessages\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\"R\n" +
+	"\x15MarkInboxReadResponse\x12\x16\n" +
+	"\x06marked\x18\x01 \x01(\x05R\x06marked\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\"=\n" +
+	"\x18InboxUnreadCountResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x05R\vunreadCount\"R\n" +
+	"\x17PushPreferencesResponse\x127\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x15.grpc.PushPreferencesR\vpreferences\"\x8d\x01\n" +
+	"\x17GetReferralInfoResponse\x12#\n" +
+	"\rreferral_code\x18\x01 \x01(\tR\freferralCode\x12\x1f\n" +
+	"\vreferred_by\x18\x02 \x01(\tR\n" +
+	"referredBy\x12,\n" +
+	"\treferrals\x18\x03 \x03(\v2\x0e.grpc.ReferralR\treferrals\"\xa2\x01\n" +
+	"\x19BalanceAdjustmentResponse\x127\n" +
+	"\n" +
+	"adjustment\x18\x01 \x01(\v2\x17.grpc.BalanceAdjustmentR\n" +
+	"adjustment\x12#\n" +
+	"\rbalance_after\x18\x02 \x01(\x05R\fbalanceAfter\x12'\n" +
+	"\x0falready_applied\x18\x03 \x01(\bR\x0ealreadyApplied\"\xc6\x01\n" +
+	"\x12ModerationResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0fsuspended_until\x18\x03 \x01(\x03R\x0esuspendedUntil\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\tR\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\xbe\x01\n" +
+	"\x1aUserDeletionStatusResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12)\n" +
+	"\x10pending_services\x18\x03 \x03(\tR\x0fpendingServices\x12!\n" +
+	"\frequested_at\x18\x04 \x01(\x03R\vrequestedAt\x12!\n" +
+	"\fcompleted_at\x18\x05 \x01(\x03R\vcompletedAt\"\xeb\x01\n" +
+	"\x0fCoinTransaction\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12#\n" +
+	"\rbalance_after\x18\x03 \x01(\x03R\fbalanceAfter\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12!\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\"\x91\x02\n" +
+	"\vAchievement\x12%\n" +
+	"\x0eachievement_id\x18\x01 \x01(\tR\rachievementId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06metric\x18\x04 \x01(\tR\x06metric\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x05R\tthreshold\x12\x1a\n" +
+	"\bprogress\x18\x06 \x01(\x05R\bprogress\x12\x16\n" +
+	"\x06reward\x18\a \x01(\x05R\x06reward\x12\x1a\n" +
+	"\bunlocked\x18\b \x01(\bR\bunlocked\x12\x1f\n" +
+	"\vunlocked_at\x18\t \x01(\x03R\n" +
+	"unlockedAt\"{\n" +
+	"\x06Friend\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\"\xdf\x01\n" +
+	"\fInboxMessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12!\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\x12\x17\n" +
+	"\ais_read\x18\x06 \x01(\bR\x06isRead\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x17\n" +
+	"\aread_at\x18\b \x01(\x03R\x06readAt\"\xbc\x01\n" +
+	"\x0fPushPreferences\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vmuted_types\x18\x02 \x03(\tR\n" +
+	"mutedTypes\x12*\n" +
+	"\x11quiet_hours_start\x18\x03 \x01(\tR\x0fquietHoursStart\x12&\n" +
+	"\x0fquiet_hours_end\x18\x04 \x01(\tR\rquietHoursEnd\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\"\xd3\x01\n" +
+	"\bReferral\x12\x1d\n" +
+	"\n" +
+	"referee_id\x18\x01 \x01(\tR\trefereeId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12+\n" +
+	"\x11referrer_rewarded\x18\x04 \x01(\bR\x10referrerRewarded\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\x06 \x01(\x03R\vcompletedAt\"\x96\x02\n" +
+	"\x11BalanceAdjustment\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x05R\x06amount\x12\x1f\n" +
+	"\voperator_id\x18\x06 \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12)\n" +
+	"\x10ticket_reference\x18\b \x01(\tR\x0fticketReference\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt2\xc7\x13\n" +
 	"\vUserService\x12?\n" +
 	"\n" +
 	"CreateUser\x12\x17.grpc.CreateUserRequest\x1a\x18.grpc.CreateUserResponse\x12>\n" +
-	"\aGetById\x12\x18.grpc.GetUserByIdRequest\x1a\x19.grpc.GetUserByIdResponse\x12K\n" +
+	"\aGetById\x12\x18.grpc.GetUserByIdRequest\x1a\x19.grpc.GetUserByIdResponse\x12H\n" +
+	"\rGetUsersByIds\x12\x1a.grpc.GetUsersByIdsRequest\x1a\x1b.grpc.GetUsersByIdsResponse\x12K\n" +
 	"\x0eUpdateProgress\x12\x1b.grpc.UpdateProgressRequest\x1a\x1c.grpc.UpdateProgressResponse\x12V\n" +
-	"\x17CollectTournamentReward\x12$.grpc.CollectTournamentRewardRequest\x1a\x15.grpc.MessageResponse\x12@\n" +
+	"\x17CollectTournamentReward\x12$.grpc.CollectTournamentRewardRequest\x1a\x15.grpc.MessageResponse\x12N\n" +
+	"\x13CollectSeasonReward\x12 .grpc.CollectSeasonRewardRequest\x1a\x15.grpc.MessageResponse\x12]\n" +
+	"\x14ListCoinTransactions\x12!.grpc.ListCoinTransactionsRequest\x1a\".grpc.ListCoinTransactionsResponse\x12T\n" +
+	"\x11UpdateDisplayName\x12\x1e.grpc.UpdateDisplayNameRequest\x1a\x1f.grpc.UpdateDisplayNameResponse\x12H\n" +
+	"\rUpdateCountry\x12\x1a.grpc.UpdateCountryRequest\x1a\x1b.grpc.UpdateCountryResponse\x12Q\n" +
+	"\x10ClaimDailyReward\x12\x1d.grpc.ClaimDailyRewardRequest\x1a\x1e.grpc.ClaimDailyRewardResponse\x12]\n" +
+	"\x14PurchaseStreakFreeze\x12!.grpc.PurchaseStreakFreezeRequest\x1a\".grpc.PurchaseStreakFreezeResponse\x12Q\n" +
+	"\x10ListAchievements\x12\x1d.grpc.ListAchievementsRequest\x1a\x1e.grpc.ListAchievementsResponse\x12M\n" +
+	"\x11SendFriendRequest\x12\x1e.grpc.SendFriendRequestRequest\x1a\x18.grpc.FriendshipResponse\x12Q\n" +
+	"\x13AcceptFriendRequest\x12 .grpc.AcceptFriendRequestRequest\x1a\x18.grpc.FriendshipResponse\x12@\n" +
+	"\fRemoveFriend\x12\x19.grpc.RemoveFriendRequest\x1a\x15.grpc.MessageResponse\x12B\n" +
+	"\vListFriends\x12\x18.grpc.ListFriendsRequest\x1a\x19.grpc.ListFriendsResponse\x12<\n" +
+	"\tListInbox\x12\x16.grpc.ListInboxRequest\x1a\x17.grpc.ListInboxResponse\x12H\n" +
+	"\rMarkInboxRead\x12\x1a.grpc.MarkInboxReadRequest\x1a\x1b.grpc.MarkInboxReadResponse\x12W\n" +
+	"\x13GetInboxUnreadCount\x12 .grpc.GetInboxUnreadCountRequest\x1a\x1e.grpc.InboxUnreadCountResponse\x12T\n" +
+	"\x12GetPushPreferences\x12\x1f.grpc.GetPushPreferencesRequest\x1a\x1d.grpc.PushPreferencesResponse\x12Z\n" +
+	"\x15UpdatePushPreferences\x12\".grpc.UpdatePushPreferencesRequest\x1a\x1d.grpc.PushPreferencesResponse\x12N\n" +
+	"\x0fGetReferralInfo\x12\x1c.grpc.GetReferralInfoRequest\x1a\x1d.grpc.GetReferralInfoResponse\x12L\n" +
+	"\rGrantCurrency\x12\x1a.grpc.GrantCurrencyRequest\x1a\x1f.grpc.BalanceAdjustmentResponse\x12N\n" +
+	"\x0eRevokeCurrency\x12\x1b.grpc.RevokeCurrencyRequest\x1a\x1f.grpc.BalanceAdjustmentResponse\x12A\n" +
+	"\vSuspendUser\x12\x18.grpc.SuspendUserRequest\x1a\x18.grpc.ModerationResponse\x129\n" +
+	"\aBanUser\x12\x14.grpc.BanUserRequest\x1a\x18.grpc.ModerationResponse\x12E\n" +
+	"\rReinstateUser\x12\x1a.grpc.ReinstateUserRequest\x1a\x18.grpc.ModerationResponse\x12G\n" +
+	"\n" +
+	"DeleteUser\x12\x17.grpc.DeleteUserRequest\x1a .grpc.UserDeletionStatusResponse\x12]\n" +
+	"\x15GetUserDeletionStatus\x12\".grpc.GetUserDeletionStatusRequest\x1a .grpc.UserDeletionStatusResponse\x12@\n" +
 	"\fReserveCoins\x12\x19.grpc.ReserveCoinsRequest\x1a\x15.grpc.MessageResponse\x12L\n" +
 	"\x12ConfirmReservation\x12\x1f.grpc.ConfirmReservationRequest\x1a\x15.grpc.MessageResponse\x12N\n" +
 	"\x13RollbackReservation\x12 .grpc.RollbackReservationRequest\x1a\x15.grpc.MessageResponseB9Z7github.com/burakmert236/goodswipe-common/generated/grpcb\x06proto3"
@@ -618,40 +4043,150 @@ func file_v1_grpc_user_proto_rawDescGZIP() []byte {
 	return file_v1_grpc_user_proto_rawDescData
 }
 
-var file_v1_grpc_user_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_v1_grpc_user_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_v1_grpc_user_proto_goTypes = []any{
 	(*CreateUserRequest)(nil),              // 0: grpc.CreateUserRequest
 	(*GetUserByIdRequest)(nil),             // 1: grpc.GetUserByIdRequest
-	(*UpdateProgressRequest)(nil),          // 2: grpc.UpdateProgressRequest
-	(*CollectTournamentRewardRequest)(nil), // 3: grpc.CollectTournamentRewardRequest
-	(*ReserveCoinsRequest)(nil),            // 4: grpc.ReserveCoinsRequest
-	(*ConfirmReservationRequest)(nil),      // 5: grpc.ConfirmReservationRequest
-	(*RollbackReservationRequest)(nil),     // 6: grpc.RollbackReservationRequest
-	(*CreateUserResponse)(nil),             // 7: grpc.CreateUserResponse
-	(*GetUserByIdResponse)(nil),            // 8: grpc.GetUserByIdResponse
-	(*UpdateProgressResponse)(nil),         // 9: grpc.UpdateProgressResponse
-	(*MessageResponse)(nil),                // 10: grpc.MessageResponse
+	(*GetUsersByIdsRequest)(nil),           // 2: grpc.GetUsersByIdsRequest
+	(*UpdateProgressRequest)(nil),          // 3: grpc.UpdateProgressRequest
+	(*CollectTournamentRewardRequest)(nil), // 4: grpc.CollectTournamentRewardRequest
+	(*CollectSeasonRewardRequest)(nil),     // 5: grpc.CollectSeasonRewardRequest
+	(*ListCoinTransactionsRequest)(nil),    // 6: grpc.ListCoinTransactionsRequest
+	(*UpdateDisplayNameRequest)(nil),       // 7: grpc.UpdateDisplayNameRequest
+	(*UpdateCountryRequest)(nil),           // 8: grpc.UpdateCountryRequest
+	(*ClaimDailyRewardRequest)(nil),        // 9: grpc.ClaimDailyRewardRequest
+	(*PurchaseStreakFreezeRequest)(nil),    // 10: grpc.PurchaseStreakFreezeRequest
+	(*ListAchievementsRequest)(nil),        // 11: grpc.ListAchievementsRequest
+	(*SendFriendRequestRequest)(nil),       // 12: grpc.SendFriendRequestRequest
+	(*AcceptFriendRequestRequest)(nil),     // 13: grpc.AcceptFriendRequestRequest
+	(*RemoveFriendRequest)(nil),            // 14: grpc.RemoveFriendRequest
+	(*ListFriendsRequest)(nil),             // 15: grpc.ListFriendsRequest
+	(*ListInboxRequest)(nil),               // 16: grpc.ListInboxRequest
+	(*MarkInboxReadRequest)(nil),           // 17: grpc.MarkInboxReadRequest
+	(*GetInboxUnreadCountRequest)(nil),     // 18: grpc.GetInboxUnreadCountRequest
+	(*GetPushPreferencesRequest)(nil),      // 19: grpc.GetPushPreferencesRequest
+	(*UpdatePushPreferencesRequest)(nil),   // 20: grpc.UpdatePushPreferencesRequest
+	(*GetReferralInfoRequest)(nil),         // 21: grpc.GetReferralInfoRequest
+	(*GrantCurrencyRequest)(nil),           // 22: grpc.GrantCurrencyRequest
+	(*RevokeCurrencyRequest)(nil),          // 23: grpc.RevokeCurrencyRequest
+	(*SuspendUserRequest)(nil),             // 24: grpc.SuspendUserRequest
+	(*BanUserRequest)(nil),                 // 25: grpc.BanUserRequest
+	(*ReinstateUserRequest)(nil),           // 26: grpc.ReinstateUserRequest
+	(*DeleteUserRequest)(nil),              // 27: grpc.DeleteUserRequest
+	(*GetUserDeletionStatusRequest)(nil),   // 28: grpc.GetUserDeletionStatusRequest
+	(*ReserveCoinsRequest)(nil),            // 29: grpc.ReserveCoinsRequest
+	(*ConfirmReservationRequest)(nil),      // 30: grpc.ConfirmReservationRequest
+	(*RollbackReservationRequest)(nil),     // 31: grpc.RollbackReservationRequest
+	(*CreateUserResponse)(nil),             // 32: grpc.CreateUserResponse
+	(*GetUserByIdResponse)(nil),            // 33: grpc.GetUserByIdResponse
+	(*GetUsersByIdsResponse)(nil),          // 34: grpc.GetUsersByIdsResponse
+	(*UpdateProgressResponse)(nil),         // 35: grpc.UpdateProgressResponse
+	(*ListCoinTransactionsResponse)(nil),   // 36: grpc.ListCoinTransactionsResponse
+	(*UpdateDisplayNameResponse)(nil),      // 37: grpc.UpdateDisplayNameResponse
+	(*UpdateCountryResponse)(nil),          // 38: grpc.UpdateCountryResponse
+	(*ClaimDailyRewardResponse)(nil),       // 39: grpc.ClaimDailyRewardResponse
+	(*PurchaseStreakFreezeResponse)(nil),   // 40: grpc.PurchaseStreakFreezeResponse
+	(*ListAchievementsResponse)(nil),       // 41: grpc.ListAchievementsResponse
+	(*FriendshipResponse)(nil),             // 42: grpc.FriendshipResponse
+	(*ListFriendsResponse)(nil),            // 43: grpc.ListFriendsResponse
+	(*ListInboxResponse)(nil),              // 44: grpc.ListInboxResponse
+	(*MarkInboxReadResponse)(nil),          // 45: grpc.MarkInboxReadResponse
+	(*InboxUnreadCountResponse)(nil),       // 46: grpc.InboxUnreadCountResponse
+	(*PushPreferencesResponse)(nil),        // 47: grpc.PushPreferencesResponse
+	(*GetReferralInfoResponse)(nil),        // 48: grpc.GetReferralInfoResponse
+	(*BalanceAdjustmentResponse)(nil),      // 49: grpc.BalanceAdjustmentResponse
+	(*ModerationResponse)(nil),             // 50: grpc.ModerationResponse
+	(*UserDeletionStatusResponse)(nil),     // 51: grpc.UserDeletionStatusResponse
+	(*CoinTransaction)(nil),                // 52: grpc.CoinTransaction
+	(*Achievement)(nil),                    // 53: grpc.Achievement
+	(*Friend)(nil),                         // 54: grpc.Friend
+	(*InboxMessage)(nil),                   // 55: grpc.InboxMessage
+	(*PushPreferences)(nil),                // 56: grpc.PushPreferences
+	(*Referral)(nil),                       // 57: grpc.Referral
+	(*BalanceAdjustment)(nil),              // 58: grpc.BalanceAdjustment
+	nil,                                    // 59: grpc.GetUserByIdResponse.BalancesEntry
+	(*MessageResponse)(nil),                // 60: grpc.MessageResponse
 }
 var file_v1_grpc_user_proto_depIdxs = []int32{
-	0,  // 0: grpc.UserService.CreateUser:input_type -> grpc.CreateUserRequest
-	1,  // 1: grpc.UserService.GetById:input_type -> grpc.GetUserByIdRequest
-	2,  // 2: grpc.UserService.UpdateProgress:input_type -> grpc.UpdateProgressRequest
-	3,  // 3: grpc.UserService.CollectTournamentReward:input_type -> grpc.CollectTournamentRewardRequest
-	4,  // 4: grpc.UserService.ReserveCoins:input_type -> grpc.ReserveCoinsRequest
-	5,  // 5: grpc.UserService.ConfirmReservation:input_type -> grpc.ConfirmReservationRequest
-	6,  // 6: grpc.UserService.RollbackReservation:input_type -> grpc.RollbackReservationRequest
-	7,  // 7: grpc.UserService.CreateUser:output_type -> grpc.CreateUserResponse
-	8,  // 8: grpc.UserService.GetById:output_type -> grpc.GetUserByIdResponse
-	9,  // 9: grpc.UserService.UpdateProgress:output_type -> grpc.UpdateProgressResponse
-	10, // 10: grpc.UserService.CollectTournamentReward:output_type -> grpc.MessageResponse
-	10, // 11: grpc.UserService.ReserveCoins:output_type -> grpc.MessageResponse
-	10, // 12: grpc.UserService.ConfirmReservation:output_type -> grpc.MessageResponse
-	10, // 13: grpc.UserService.RollbackReservation:output_type -> grpc.MessageResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	59, // 0: grpc.GetUserByIdResponse.balances:type_name -> grpc.GetUserByIdResponse.BalancesEntry
+	33, // 1: grpc.GetUsersByIdsResponse.users:type_name -> grpc.GetUserByIdResponse
+	52, // 2: grpc.ListCoinTransactionsResponse.transactions:type_name -> grpc.CoinTransaction
+	53, // 3: grpc.ListAchievementsResponse.achievements:type_name -> grpc.Achievement
+	54, // 4: grpc.FriendshipResponse.friend:type_name -> grpc.Friend
+	54, // 5: grpc.ListFriendsResponse.friends:type_name -> grpc.Friend
+	55, // 6: grpc.ListInboxResponse.messages:type_name -> grpc.InboxMessage
+	56, // 7: grpc.PushPreferencesResponse.preferences:type_name -> grpc.PushPreferences
+	57, // 8: grpc.GetReferralInfoResponse.referrals:type_name -> grpc.Referral
+	58, // 9: grpc.BalanceAdjustmentResponse.adjustment:type_name -> grpc.BalanceAdjustment
+	0,  // 10: grpc.UserService.CreateUser:input_type -> grpc.CreateUserRequest
+	1,  // 11: grpc.UserService.GetById:input_type -> grpc.GetUserByIdRequest
+	2,  // 12: grpc.UserService.GetUsersByIds:input_type -> grpc.GetUsersByIdsRequest
+	3,  // 13: grpc.UserService.UpdateProgress:input_type -> grpc.UpdateProgressRequest
+	4,  // 14: grpc.UserService.CollectTournamentReward:input_type -> grpc.CollectTournamentRewardRequest
+	5,  // 15: grpc.UserService.CollectSeasonReward:input_type -> grpc.CollectSeasonRewardRequest
+	6,  // 16: grpc.UserService.ListCoinTransactions:input_type -> grpc.ListCoinTransactionsRequest
+	7,  // 17: grpc.UserService.UpdateDisplayName:input_type -> grpc.UpdateDisplayNameRequest
+	8,  // 18: grpc.UserService.UpdateCountry:input_type -> grpc.UpdateCountryRequest
+	9,  // 19: grpc.UserService.ClaimDailyReward:input_type -> grpc.ClaimDailyRewardRequest
+	10, // 20: grpc.UserService.PurchaseStreakFreeze:input_type -> grpc.PurchaseStreakFreezeRequest
+	11, // 21: grpc.UserService.ListAchievements:input_type -> grpc.ListAchievementsRequest
+	12, // 22: grpc.UserService.SendFriendRequest:input_type -> grpc.SendFriendRequestRequest
+	13, // 23: grpc.UserService.AcceptFriendRequest:input_type -> grpc.AcceptFriendRequestRequest
+	14, // 24: grpc.UserService.RemoveFriend:input_type -> grpc.RemoveFriendRequest
+	15, // 25: grpc.UserService.ListFriends:input_type -> grpc.ListFriendsRequest
+	16, // 26: grpc.UserService.ListInbox:input_type -> grpc.ListInboxRequest
+	17, // 27: grpc.UserService.MarkInboxRead:input_type -> grpc.MarkInboxReadRequest
+	18, // 28: grpc.UserService.GetInboxUnreadCount:input_type -> grpc.GetInboxUnreadCountRequest
+	19, // 29: grpc.UserService.GetPushPreferences:input_type -> grpc.GetPushPreferencesRequest
+	20, // 30: grpc.UserService.UpdatePushPreferences:input_type -> grpc.UpdatePushPreferencesRequest
+	21, // 31: grpc.UserService.GetReferralInfo:input_type -> grpc.GetReferralInfoRequest
+	22, // 32: grpc.UserService.GrantCurrency:input_type -> grpc.GrantCurrencyRequest
+	23, // 33: grpc.UserService.RevokeCurrency:input_type -> grpc.RevokeCurrencyRequest
+	24, // 34: grpc.UserService.SuspendUser:input_type -> grpc.SuspendUserRequest
+	25, // 35: grpc.UserService.BanUser:input_type -> grpc.BanUserRequest
+	26, // 36: grpc.UserService.ReinstateUser:input_type -> grpc.ReinstateUserRequest
+	27, // 37: grpc.UserService.DeleteUser:input_type -> grpc.DeleteUserRequest
+	28, // 38: grpc.UserService.GetUserDeletionStatus:input_type -> grpc.GetUserDeletionStatusRequest
+	29, // 39: grpc.UserService.ReserveCoins:input_type -> grpc.ReserveCoinsRequest
+	30, // 40: grpc.UserService.ConfirmReservation:input_type -> grpc.ConfirmReservationRequest
+	31, // 41: grpc.UserService.RollbackReservation:input_type -> grpc.RollbackReservationRequest
+	32, // 42: grpc.UserService.CreateUser:output_type -> grpc.CreateUserResponse
+	33, // 43: grpc.UserService.GetById:output_type -> grpc.GetUserByIdResponse
+	34, // 44: grpc.UserService.GetUsersByIds:output_type -> grpc.GetUsersByIdsResponse
+	35, // 45: grpc.UserService.UpdateProgress:output_type -> grpc.UpdateProgressResponse
+	60, // 46: grpc.UserService.CollectTournamentReward:output_type -> grpc.MessageResponse
+	60, // 47: grpc.UserService.CollectSeasonReward:output_type -> grpc.MessageResponse
+	36, // 48: grpc.UserService.ListCoinTransactions:output_type -> grpc.ListCoinTransactionsResponse
+	37, // 49: grpc.UserService.UpdateDisplayName:output_type -> grpc.UpdateDisplayNameResponse
+	38, // 50: grpc.UserService.UpdateCountry:output_type -> grpc.UpdateCountryResponse
+	39, // 51: grpc.UserService.ClaimDailyReward:output_type -> grpc.ClaimDailyRewardResponse
+	40, // 52: grpc.UserService.PurchaseStreakFreeze:output_type -> grpc.PurchaseStreakFreezeResponse
+	41, // 53: grpc.UserService.ListAchievements:output_type -> grpc.ListAchievementsResponse
+	42, // 54: grpc.UserService.SendFriendRequest:output_type -> grpc.FriendshipResponse
+	42, // 55: grpc.UserService.AcceptFriendRequest:output_type -> grpc.FriendshipResponse
+	60, // 56: grpc.UserService.RemoveFriend:output_type -> grpc.MessageResponse
+	43, // 57: grpc.UserService.ListFriends:output_type -> grpc.ListFriendsResponse
+	44, // 58: grpc.UserService.ListInbox:output_type -> grpc.ListInboxResponse
+	45, // 59: grpc.UserService.MarkInboxRead:output_type -> grpc.MarkInboxReadResponse
+	46, // 60: grpc.UserService.GetInboxUnreadCount:output_type -> grpc.InboxUnreadCountResponse
+	47, // 61: grpc.UserService.GetPushPreferences:output_type -> grpc.PushPreferencesResponse
+	47, // 62: grpc.UserService.UpdatePushPreferences:output_type -> grpc.PushPreferencesResponse
+	48, // 63: grpc.UserService.GetReferralInfo:output_type -> grpc.GetReferralInfoResponse
+	49, // 64: grpc.UserService.GrantCurrency:output_type -> grpc.BalanceAdjustmentResponse
+	49, // 65: grpc.UserService.RevokeCurrency:output_type -> grpc.BalanceAdjustmentResponse
+	50, // 66: grpc.UserService.SuspendUser:output_type -> grpc.ModerationResponse
+	50, // 67: grpc.UserService.BanUser:output_type -> grpc.ModerationResponse
+	50, // 68: grpc.UserService.ReinstateUser:output_type -> grpc.ModerationResponse
+	51, // 69: grpc.UserService.DeleteUser:output_type -> grpc.UserDeletionStatusResponse
+	51, // 70: grpc.UserService.GetUserDeletionStatus:output_type -> grpc.UserDeletionStatusResponse
+	60, // 71: grpc.UserService.ReserveCoins:output_type -> grpc.MessageResponse
+	60, // 72: grpc.UserService.ConfirmReservation:output_type -> grpc.MessageResponse
+	60, // 73: grpc.UserService.RollbackReservation:output_type -> grpc.MessageResponse
+	42, // [42:74] is the sub-list for method output_type
+	10, // [10:42] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_v1_grpc_user_proto_init() }
@@ -666,7 +4201,7 @@ func file_v1_grpc_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_grpc_user_proto_rawDesc), len(file_v1_grpc_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,8 +21,33 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	UserService_CreateUser_FullMethodName              = "/grpc.UserService/CreateUser"
 	UserService_GetById_FullMethodName                 = "/grpc.UserService/GetById"
+	UserService_GetUsersByIds_FullMethodName           = "/grpc.UserService/GetUsersByIds"
 	UserService_UpdateProgress_FullMethodName          = "/grpc.UserService/UpdateProgress"
 	UserService_CollectTournamentReward_FullMethodName = "/grpc.UserService/CollectTournamentReward"
+	UserService_CollectSeasonReward_FullMethodName     = "/grpc.UserService/CollectSeasonReward"
+	UserService_ListCoinTransactions_FullMethodName    = "/grpc.UserService/ListCoinTransactions"
+	UserService_UpdateDisplayName_FullMethodName       = "/grpc.UserService/UpdateDisplayName"
+	UserService_UpdateCountry_FullMethodName           = "/grpc.UserService/UpdateCountry"
+	UserService_ClaimDailyReward_FullMethodName        = "/grpc.UserService/ClaimDailyReward"
+	UserService_PurchaseStreakFreeze_FullMethodName    = "/grpc.UserService/PurchaseStreakFreeze"
+	UserService_ListAchievements_FullMethodName        = "/grpc.UserService/ListAchievements"
+	UserService_SendFriendRequest_FullMethodName       = "/grpc.UserService/SendFriendRequest"
+	UserService_AcceptFriendRequest_FullMethodName     = "/grpc.UserService/AcceptFriendRequest"
+	UserService_RemoveFriend_FullMethodName            = "/grpc.UserService/RemoveFriend"
+	UserService_ListFriends_FullMethodName             = "/grpc.UserService/ListFriends"
+	UserService_ListInbox_FullMethodName               = "/grpc.UserService/ListInbox"
+	UserService_MarkInboxRead_FullMethodName           = "/grpc.UserService/MarkInboxRead"
+	UserService_GetInboxUnreadCount_FullMethodName     = "/grpc.UserService/GetInboxUnreadCount"
+	UserService_GetPushPreferences_FullMethodName      = "/grpc.UserService/GetPushPreferences"
+	UserService_UpdatePushPreferences_FullMethodName   = "/grpc.UserService/UpdatePushPreferences"
+	UserService_GetReferralInfo_FullMethodName         = "/grpc.UserService/GetReferralInfo"
+	UserService_GrantCurrency_FullMethodName           = "/grpc.UserService/GrantCurrency"
+	UserService_RevokeCurrency_FullMethodName          = "/grpc.UserService/RevokeCurrency"
+	UserService_SuspendUser_FullMethodName             = "/grpc.UserService/SuspendUser"
+	UserService_BanUser_FullMethodName                 = "/grpc.UserService/BanUser"
+	UserService_ReinstateUser_FullMethodName           = "/grpc.UserService/ReinstateUser"
+	UserService_DeleteUser_FullMethodName              = "/grpc.UserService/DeleteUser"
+	UserService_GetUserDeletionStatus_FullMethodName   = "/grpc.UserService/GetUserDeletionStatus"
 	UserService_ReserveCoins_FullMethodName            = "/grpc.UserService/ReserveCoins"
 	UserService_ConfirmReservation_FullMethodName      = "/grpc.UserService/ConfirmReservation"
 	UserService_RollbackReservation_FullMethodName     = "/grpc.UserService/RollbackReservation"
@@ -36,8 +61,33 @@ const (
 type UserServiceClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	GetById(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*GetUserByIdResponse, error)
+	GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error)
 	UpdateProgress(ctx context.Context, in *UpdateProgressRequest, opts ...grpc.CallOption) (*UpdateProgressResponse, error)
 	CollectTournamentReward(ctx context.Context, in *CollectTournamentRewardRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	CollectSeasonReward(ctx context.Context, in *CollectSeasonRewardRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	ListCoinTransactions(ctx context.Context, in *ListCoinTransactionsRequest, opts ...grpc.CallOption) (*ListCoinTransactionsResponse, error)
+	UpdateDisplayName(ctx context.Context, in *UpdateDisplayNameRequest, opts ...grpc.CallOption) (*UpdateDisplayNameResponse, error)
+	UpdateCountry(ctx context.Context, in *UpdateCountryRequest, opts ...grpc.CallOption) (*UpdateCountryResponse, error)
+	ClaimDailyReward(ctx context.Context, in *ClaimDailyRewardRequest, opts ...grpc.CallOption) (*ClaimDailyRewardResponse, error)
+	PurchaseStreakFreeze(ctx context.Context, in *PurchaseStreakFreezeRequest, opts ...grpc.CallOption) (*PurchaseStreakFreezeResponse, error)
+	ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error)
+	SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*FriendshipResponse, error)
+	AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestRequest, opts ...grpc.CallOption) (*FriendshipResponse, error)
+	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	ListFriends(ctx context.Context, in *ListFriendsRequest, opts ...grpc.CallOption) (*ListFriendsResponse, error)
+	ListInbox(ctx context.Context, in *ListInboxRequest, opts ...grpc.CallOption) (*ListInboxResponse, error)
+	MarkInboxRead(ctx context.Context, in *MarkInboxReadRequest, opts ...grpc.CallOption) (*MarkInboxReadResponse, error)
+	GetInboxUnreadCount(ctx context.Context, in *GetInboxUnreadCountRequest, opts ...grpc.CallOption) (*InboxUnreadCountResponse, error)
+	GetPushPreferences(ctx context.Context, in *GetPushPreferencesRequest, opts ...grpc.CallOption) (*PushPreferencesResponse, error)
+	UpdatePushPreferences(ctx context.Context, in *UpdatePushPreferencesRequest, opts ...grpc.CallOption) (*PushPreferencesResponse, error)
+	GetReferralInfo(ctx context.Context, in *GetReferralInfoRequest, opts ...grpc.CallOption) (*GetReferralInfoResponse, error)
+	GrantCurrency(ctx context.Context, in *GrantCurrencyRequest, opts ...grpc.CallOption) (*BalanceAdjustmentResponse, error)
+	RevokeCurrency(ctx context.Context, in *RevokeCurrencyRequest, opts ...grpc.CallOption) (*BalanceAdjustmentResponse, error)
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error)
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error)
+	ReinstateUser(ctx context.Context, in *ReinstateUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*UserDeletionStatusResponse, error)
+	GetUserDeletionStatus(ctx context.Context, in *GetUserDeletionStatusRequest, opts ...grpc.CallOption) (*UserDeletionStatusResponse, error)
 	// Reservation methods for tournament entry
 	ReserveCoins(ctx context.Context, in *ReserveCoinsRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*MessageResponse, error)
@@ -72,6 +122,16 @@ func (c *userServiceClient) GetById(ctx context.Context, in *GetUserByIdRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByIdsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUsersByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UpdateProgress(ctx context.Context, in *UpdateProgressRequest, opts ...grpc.CallOption) (*UpdateProgressResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateProgressResponse)
@@ -92,6 +152,246 @@ func (c *userServiceClient) CollectTournamentReward(ctx context.Context, in *Col
 	return out, nil
 }
 
+func (c *userServiceClient) CollectSeasonReward(ctx context.Context, in *CollectSeasonRewardRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, UserService_CollectSeasonReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListCoinTransactions(ctx context.Context, in *ListCoinTransactionsRequest, opts ...grpc.CallOption) (*ListCoinTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoinTransactionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListCoinTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateDisplayName(ctx context.Context, in *UpdateDisplayNameRequest, opts ...grpc.CallOption) (*UpdateDisplayNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDisplayNameResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateDisplayName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateCountry(ctx context.Context, in *UpdateCountryRequest, opts ...grpc.CallOption) (*UpdateCountryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCountryResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateCountry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ClaimDailyReward(ctx context.Context, in *ClaimDailyRewardRequest, opts ...grpc.CallOption) (*ClaimDailyRewardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimDailyRewardResponse)
+	err := c.cc.Invoke(ctx, UserService_ClaimDailyReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) PurchaseStreakFreeze(ctx context.Context, in *PurchaseStreakFreezeRequest, opts ...grpc.CallOption) (*PurchaseStreakFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseStreakFreezeResponse)
+	err := c.cc.Invoke(ctx, UserService_PurchaseStreakFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAchievementsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAchievements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*FriendshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendshipResponse)
+	err := c.cc.Invoke(ctx, UserService_SendFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestRequest, opts ...grpc.CallOption) (*FriendshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendshipResponse)
+	err := c.cc.Invoke(ctx, UserService_AcceptFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListFriends(ctx context.Context, in *ListFriendsRequest, opts ...grpc.CallOption) (*ListFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFriendsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListInbox(ctx context.Context, in *ListInboxRequest, opts ...grpc.CallOption) (*ListInboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInboxResponse)
+	err := c.cc.Invoke(ctx, UserService_ListInbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) MarkInboxRead(ctx context.Context, in *MarkInboxReadRequest, opts ...grpc.CallOption) (*MarkInboxReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkInboxReadResponse)
+	err := c.cc.Invoke(ctx, UserService_MarkInboxRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetInboxUnreadCount(ctx context.Context, in *GetInboxUnreadCountRequest, opts ...grpc.CallOption) (*InboxUnreadCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboxUnreadCountResponse)
+	err := c.cc.Invoke(ctx, UserService_GetInboxUnreadCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPushPreferences(ctx context.Context, in *GetPushPreferencesRequest, opts ...grpc.CallOption) (*PushPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPushPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdatePushPreferences(ctx context.Context, in *UpdatePushPreferencesRequest, opts ...grpc.CallOption) (*PushPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdatePushPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetReferralInfo(ctx context.Context, in *GetReferralInfoRequest, opts ...grpc.CallOption) (*GetReferralInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReferralInfoResponse)
+	err := c.cc.Invoke(ctx, UserService_GetReferralInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GrantCurrency(ctx context.Context, in *GrantCurrencyRequest, opts ...grpc.CallOption) (*BalanceAdjustmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceAdjustmentResponse)
+	err := c.cc.Invoke(ctx, UserService_GrantCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeCurrency(ctx context.Context, in *RevokeCurrencyRequest, opts ...grpc.CallOption) (*BalanceAdjustmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceAdjustmentResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, UserService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, UserService_BanUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReinstateUser(ctx context.Context, in *ReinstateUserRequest, opts ...grpc.CallOption) (*ModerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationResponse)
+	err := c.cc.Invoke(ctx, UserService_ReinstateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*UserDeletionStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDeletionStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserDeletionStatus(ctx context.Context, in *GetUserDeletionStatusRequest, opts ...grpc.CallOption) (*UserDeletionStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDeletionStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserDeletionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ReserveCoins(ctx context.Context, in *ReserveCoinsRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MessageResponse)
@@ -130,8 +430,33 @@ func (c *userServiceClient) RollbackReservation(ctx context.Context, in *Rollbac
 type UserServiceServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	GetById(context.Context, *GetUserByIdRequest) (*GetUserByIdResponse, error)
+	GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error)
 	UpdateProgress(context.Context, *UpdateProgressRequest) (*UpdateProgressResponse, error)
 	CollectTournamentReward(context.Context, *CollectTournamentRewardRequest) (*MessageResponse, error)
+	CollectSeasonReward(context.Context, *CollectSeasonRewardRequest) (*MessageResponse, error)
+	ListCoinTransactions(context.Context, *ListCoinTransactionsRequest) (*ListCoinTransactionsResponse, error)
+	UpdateDisplayName(context.Context, *UpdateDisplayNameRequest) (*UpdateDisplayNameResponse, error)
+	UpdateCountry(context.Context, *UpdateCountryRequest) (*UpdateCountryResponse, error)
+	ClaimDailyReward(context.Context, *ClaimDailyRewardRequest) (*ClaimDailyRewardResponse, error)
+	PurchaseStreakFreeze(context.Context, *PurchaseStreakFreezeRequest) (*PurchaseStreakFreezeResponse, error)
+	ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error)
+	SendFriendRequest(context.Context, *SendFriendRequestRequest) (*FriendshipResponse, error)
+	AcceptFriendRequest(context.Context, *AcceptFriendRequestRequest) (*FriendshipResponse, error)
+	RemoveFriend(context.Context, *RemoveFriendRequest) (*MessageResponse, error)
+	ListFriends(context.Context, *ListFriendsRequest) (*ListFriendsResponse, error)
+	ListInbox(context.Context, *ListInboxRequest) (*ListInboxResponse, error)
+	MarkInboxRead(context.Context, *MarkInboxReadRequest) (*MarkInboxReadResponse, error)
+	GetInboxUnreadCount(context.Context, *GetInboxUnreadCountRequest) (*InboxUnreadCountResponse, error)
+	GetPushPreferences(context.Context, *GetPushPreferencesRequest) (*PushPreferencesResponse, error)
+	UpdatePushPreferences(context.Context, *UpdatePushPreferencesRequest) (*PushPreferencesResponse, error)
+	GetReferralInfo(context.Context, *GetReferralInfoRequest) (*GetReferralInfoResponse, error)
+	GrantCurrency(context.Context, *GrantCurrencyRequest) (*BalanceAdjustmentResponse, error)
+	RevokeCurrency(context.Context, *RevokeCurrencyRequest) (*BalanceAdjustmentResponse, error)
+	SuspendUser(context.Context, *SuspendUserRequest) (*ModerationResponse, error)
+	BanUser(context.Context, *BanUserRequest) (*ModerationResponse, error)
+	ReinstateUser(context.Context, *ReinstateUserRequest) (*ModerationResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*UserDeletionStatusResponse, error)
+	GetUserDeletionStatus(context.Context, *GetUserDeletionStatusRequest) (*UserDeletionStatusResponse, error)
 	// Reservation methods for tournament entry
 	ReserveCoins(context.Context, *ReserveCoinsRequest) (*MessageResponse, error)
 	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*MessageResponse, error)
@@ -152,12 +477,87 @@ func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserReq
 func (UnimplementedUserServiceServer) GetById(context.Context, *GetUserByIdRequest) (*GetUserByIdResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetById not implemented")
 }
+func (UnimplementedUserServiceServer) GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsersByIds not implemented")
+}
 func (UnimplementedUserServiceServer) UpdateProgress(context.Context, *UpdateProgressRequest) (*UpdateProgressResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProgress not implemented")
 }
 func (UnimplementedUserServiceServer) CollectTournamentReward(context.Context, *CollectTournamentRewardRequest) (*MessageResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CollectTournamentReward not implemented")
 }
+func (UnimplementedUserServiceServer) CollectSeasonReward(context.Context, *CollectSeasonRewardRequest) (*MessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollectSeasonReward not implemented")
+}
+func (UnimplementedUserServiceServer) ListCoinTransactions(context.Context, *ListCoinTransactionsRequest) (*ListCoinTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCoinTransactions not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateDisplayName(context.Context, *UpdateDisplayNameRequest) (*UpdateDisplayNameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateDisplayName not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateCountry(context.Context, *UpdateCountryRequest) (*UpdateCountryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCountry not implemented")
+}
+func (UnimplementedUserServiceServer) ClaimDailyReward(context.Context, *ClaimDailyRewardRequest) (*ClaimDailyRewardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimDailyReward not implemented")
+}
+func (UnimplementedUserServiceServer) PurchaseStreakFreeze(context.Context, *PurchaseStreakFreezeRequest) (*PurchaseStreakFreezeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurchaseStreakFreeze not implemented")
+}
+func (UnimplementedUserServiceServer) ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAchievements not implemented")
+}
+func (UnimplementedUserServiceServer) SendFriendRequest(context.Context, *SendFriendRequestRequest) (*FriendshipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptFriendRequest(context.Context, *AcceptFriendRequestRequest) (*FriendshipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveFriend(context.Context, *RemoveFriendRequest) (*MessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFriend not implemented")
+}
+func (UnimplementedUserServiceServer) ListFriends(context.Context, *ListFriendsRequest) (*ListFriendsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFriends not implemented")
+}
+func (UnimplementedUserServiceServer) ListInbox(context.Context, *ListInboxRequest) (*ListInboxResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInbox not implemented")
+}
+func (UnimplementedUserServiceServer) MarkInboxRead(context.Context, *MarkInboxReadRequest) (*MarkInboxReadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkInboxRead not implemented")
+}
+func (UnimplementedUserServiceServer) GetInboxUnreadCount(context.Context, *GetInboxUnreadCountRequest) (*InboxUnreadCountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInboxUnreadCount not implemented")
+}
+func (UnimplementedUserServiceServer) GetPushPreferences(context.Context, *GetPushPreferencesRequest) (*PushPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPushPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePushPreferences(context.Context, *UpdatePushPreferencesRequest) (*PushPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePushPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) GetReferralInfo(context.Context, *GetReferralInfoRequest) (*GetReferralInfoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReferralInfo not implemented")
+}
+func (UnimplementedUserServiceServer) GrantCurrency(context.Context, *GrantCurrencyRequest) (*BalanceAdjustmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantCurrency not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeCurrency(context.Context, *RevokeCurrencyRequest) (*BalanceAdjustmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeCurrency not implemented")
+}
+func (UnimplementedUserServiceServer) SuspendUser(context.Context, *SuspendUserRequest) (*ModerationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedUserServiceServer) BanUser(context.Context, *BanUserRequest) (*ModerationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedUserServiceServer) ReinstateUser(context.Context, *ReinstateUserRequest) (*ModerationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReinstateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*UserDeletionStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserDeletionStatus(context.Context, *GetUserDeletionStatusRequest) (*UserDeletionStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserDeletionStatus not implemented")
+}
 func (UnimplementedUserServiceServer) ReserveCoins(context.Context, *ReserveCoinsRequest) (*MessageResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReserveCoins not implemented")
 }
@@ -224,6 +624,24 @@ func _UserService_GetById_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUsersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUsersByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersByIds(ctx, req.(*GetUsersByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UpdateProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProgressRequest)
 	if err := dec(in); err != nil {
@@ -260,82 +678,614 @@ func _UserService_CollectTournamentReward_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ReserveCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReserveCoinsRequest)
+func _UserService_CollectSeasonReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectSeasonRewardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ReserveCoins(ctx, in)
+		return srv.(UserServiceServer).CollectSeasonReward(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ReserveCoins_FullMethodName,
+		FullMethod: UserService_CollectSeasonReward_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ReserveCoins(ctx, req.(*ReserveCoinsRequest))
+		return srv.(UserServiceServer).CollectSeasonReward(ctx, req.(*CollectSeasonRewardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfirmReservationRequest)
+func _UserService_ListCoinTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoinTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ConfirmReservation(ctx, in)
+		return srv.(UserServiceServer).ListCoinTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ConfirmReservation_FullMethodName,
+		FullMethod: UserService_ListCoinTransactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ConfirmReservation(ctx, req.(*ConfirmReservationRequest))
+		return srv.(UserServiceServer).ListCoinTransactions(ctx, req.(*ListCoinTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_RollbackReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RollbackReservationRequest)
+func _UserService_UpdateDisplayName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDisplayNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).RollbackReservation(ctx, in)
+		return srv.(UserServiceServer).UpdateDisplayName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_RollbackReservation_FullMethodName,
+		FullMethod: UserService_UpdateDisplayName_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).RollbackReservation(ctx, req.(*RollbackReservationRequest))
+		return srv.(UserServiceServer).UpdateDisplayName(ctx, req.(*UpdateDisplayNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UserService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "grpc.UserService",
-	HandlerType: (*UserServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateUser",
-			Handler:    _UserService_CreateUser_Handler,
-		},
-		{
-			MethodName: "GetById",
-			Handler:    _UserService_GetById_Handler,
-		},
-		{
-			MethodName: "UpdateProgress",
-			Handler:    _UserService_UpdateProgress_Handler,
-		},
-		{
-			MethodName: "CollectTournamentReward",
-			Handler:    _UserService_CollectTournamentReward_Handler,
+func _UserService_UpdateCountry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCountryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateCountry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateCountry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateCountry(ctx, req.(*UpdateCountryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ClaimDailyReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimDailyRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ClaimDailyReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ClaimDailyReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ClaimDailyReward(ctx, req.(*ClaimDailyRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_PurchaseStreakFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseStreakFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).PurchaseStreakFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_PurchaseStreakFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).PurchaseStreakFreeze(ctx, req.(*PurchaseStreakFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAchievements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAchievements(ctx, req.(*ListAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SendFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SendFriendRequest(ctx, req.(*SendFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptFriendRequest(ctx, req.(*AcceptFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveFriend(ctx, req.(*RemoveFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListFriends(ctx, req.(*ListFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListInbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListInbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListInbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListInbox(ctx, req.(*ListInboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_MarkInboxRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkInboxReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).MarkInboxRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_MarkInboxRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MarkInboxRead(ctx, req.(*MarkInboxReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetInboxUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInboxUnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetInboxUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetInboxUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetInboxUnreadCount(ctx, req.(*GetInboxUnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPushPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPushPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPushPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPushPreferences(ctx, req.(*GetPushPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePushPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePushPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePushPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePushPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePushPreferences(ctx, req.(*UpdatePushPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetReferralInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReferralInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetReferralInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetReferralInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetReferralInfo(ctx, req.(*GetReferralInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GrantCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GrantCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GrantCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GrantCurrency(ctx, req.(*GrantCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeCurrency(ctx, req.(*RevokeCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReinstateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReinstateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReinstateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReinstateUser(ctx, req.(*ReinstateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserDeletionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDeletionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserDeletionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserDeletionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserDeletionStatus(ctx, req.(*GetUserDeletionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReserveCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReserveCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReserveCoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReserveCoins(ctx, req.(*ReserveCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmReservation(ctx, req.(*ConfirmReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RollbackReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RollbackReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RollbackReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RollbackReservation(ctx, req.(*RollbackReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetById",
+			Handler:    _UserService_GetById_Handler,
+		},
+		{
+			MethodName: "GetUsersByIds",
+			Handler:    _UserService_GetUsersByIds_Handler,
+		},
+		{
+			MethodName: "UpdateProgress",
+			Handler:    _UserService_UpdateProgress_Handler,
+		},
+		{
+			MethodName: "CollectTournamentReward",
+			Handler:    _UserService_CollectTournamentReward_Handler,
+		},
+		{
+			MethodName: "CollectSeasonReward",
+			Handler:    _UserService_CollectSeasonReward_Handler,
+		},
+		{
+			MethodName: "ListCoinTransactions",
+			Handler:    _UserService_ListCoinTransactions_Handler,
+		},
+		{
+			MethodName: "UpdateDisplayName",
+			Handler:    _UserService_UpdateDisplayName_Handler,
+		},
+		{
+			MethodName: "UpdateCountry",
+			Handler:    _UserService_UpdateCountry_Handler,
+		},
+		{
+			MethodName: "ClaimDailyReward",
+			Handler:    _UserService_ClaimDailyReward_Handler,
+		},
+		{
+			MethodName: "PurchaseStreakFreeze",
+			Handler:    _UserService_PurchaseStreakFreeze_Handler,
+		},
+		{
+			MethodName: "ListAchievements",
+			Handler:    _UserService_ListAchievements_Handler,
+		},
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _UserService_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "AcceptFriendRequest",
+			Handler:    _UserService_AcceptFriendRequest_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _UserService_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "ListFriends",
+			Handler:    _UserService_ListFriends_Handler,
+		},
+		{
+			MethodName: "ListInbox",
+			Handler:    _UserService_ListInbox_Handler,
+		},
+		{
+			MethodName: "MarkInboxRead",
+			Handler:    _UserService_MarkInboxRead_Handler,
+		},
+		{
+			MethodName: "GetInboxUnreadCount",
+			Handler:    _UserService_GetInboxUnreadCount_Handler,
+		},
+		{
+			MethodName: "GetPushPreferences",
+			Handler:    _UserService_GetPushPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePushPreferences",
+			Handler:    _UserService_UpdatePushPreferences_Handler,
+		},
+		{
+			MethodName: "GetReferralInfo",
+			Handler:    _UserService_GetReferralInfo_Handler,
+		},
+		{
+			MethodName: "GrantCurrency",
+			Handler:    _UserService_GrantCurrency_Handler,
+		},
+		{
+			MethodName: "RevokeCurrency",
+			Handler:    _UserService_RevokeCurrency_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _UserService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _UserService_BanUser_Handler,
+		},
+		{
+			MethodName: "ReinstateUser",
+			Handler:    _UserService_ReinstateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "GetUserDeletionStatus",
+			Handler:    _UserService_GetUserDeletionStatus_Handler,
 		},
 		{
 			MethodName: "ReserveCoins",
@@ -0,0 +1,207 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/burakmert236/goodswipe-common/auth"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+const (
+	IdempotencyKeyHeader = "idempotency-key"
+	maxKeyLength         = 128
+	defaultTTL           = 24 * time.Hour
+	// An in progress record older than the lock is considered abandoned
+	lockDuration = 30 * time.Second
+)
+
+// UnaryServerInterceptor makes the given methods idempotent for requests sent
+// with an idempotency-key header. Successful responses are stored and replayed
+// for repeats of the same request, reusing a key for a different request is
+// rejected. Keys are scoped to the method and the authenticated caller, so the
+// interceptor has to run after the auth interceptor. Without a caller the
+// requested user id scopes the key.
+func UnaryServerInterceptor(store Store, log *logger.Logger, ttl time.Duration, methods ...string) grpc.UnaryServerInterceptor {
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	enabled := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		enabled[method] = true
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !enabled[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		key, err := idempotencyKey(ctx)
+		if err != nil {
+			return nil, apperrors.ToGRPCError(err)
+		}
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		requestHash, err := hashRequest(req)
+		if err != nil {
+			return nil, apperrors.ToGRPCError(err)
+		}
+
+		now := time.Now().UTC()
+		caller := callerOf(ctx, req)
+		record := &models.IdempotencyRecord{
+			Method:      info.FullMethod,
+			Caller:      caller,
+			Key:         key,
+			Status:      models.IdempotencyStatusInProgress,
+			RequestHash: requestHash,
+			LockedUntil: now.Add(lockDuration).Unix(),
+			ExpiresAt:   now.Add(ttl).Unix(),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			PK:          models.IdempotencyPK(info.FullMethod, caller, key),
+			SK:          models.IdempotencySK(),
+		}
+
+		existing, err := store.Claim(ctx, record)
+		if err != nil {
+			return nil, apperrors.ToGRPCError(err)
+		}
+
+		if existing != nil {
+			resp, err := replay(existing, requestHash)
+			if err != nil {
+				return nil, apperrors.ToGRPCError(err)
+			}
+			return resp, nil
+		}
+
+		resp, handlerErr := handler(ctx, req)
+		if handlerErr != nil {
+			// Failed requests are not stored so the client can retry them with the same key
+			if err := store.Release(context.WithoutCancel(ctx), record.PK); err != nil {
+				log.Error("Failed to release idempotency key, retries wait for the lock to expire",
+					"error", err,
+					"method", info.FullMethod,
+				)
+			}
+			return nil, handlerErr
+		}
+
+		// The handler committed, an unstored response must not fail the request.
+		// A retry after the lock expired runs the request again.
+		response, err := marshalResponse(resp)
+		if err == nil {
+			err = store.Complete(context.WithoutCancel(ctx), record.PK, response)
+		}
+		if err != nil {
+			log.Error("Failed to store idempotent response",
+				"error", err,
+				"method", info.FullMethod,
+			)
+		}
+
+		return resp, nil
+	}
+}
+
+func replay(record *models.IdempotencyRecord, requestHash string) (any, *apperrors.AppError) {
+	if record.RequestHash != requestHash {
+		return nil, apperrors.New(apperrors.CodePreconditionFailed, "idempotency key was already used for a different request")
+	}
+
+	if record.Status != models.IdempotencyStatusCompleted {
+		return nil, apperrors.New(apperrors.CodeConflict, "request with this idempotency key is still in progress")
+	}
+
+	var response anypb.Any
+	if err := proto.Unmarshal(record.Response, &response); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal stored response")
+	}
+
+	message, err := response.UnmarshalNew()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal stored response")
+	}
+
+	return message, nil
+}
+
+func idempotencyKey(ctx context.Context) (string, *apperrors.AppError) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 || values[0] == "" {
+		return "", nil
+	}
+
+	if len(values[0]) > maxKeyLength {
+		return "", apperrors.New(apperrors.CodeInvalidInput, "idempotency key is too long")
+	}
+
+	return values[0], nil
+}
+
+func callerOf(ctx context.Context, req any) string {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	switch {
+	case !ok:
+		if userRequest, isUserRequest := req.(interface{ GetUserId() string }); isUserRequest && userRequest.GetUserId() != "" {
+			return "anonymous:" + userRequest.GetUserId()
+		}
+		return "anonymous"
+	case principal.Role == auth.RoleService:
+		return principal.Service
+	default:
+		return principal.Subject
+	}
+}
+
+func hashRequest(req any) (string, *apperrors.AppError) {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return "", apperrors.New(apperrors.CodeInternalServer, "idempotent request is not a proto message")
+	}
+
+	content, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal request")
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func marshalResponse(resp any) ([]byte, *apperrors.AppError) {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return nil, apperrors.New(apperrors.CodeInternalServer, "idempotent response is not a proto message")
+	}
+
+	response, err := anypb.New(message)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal response")
+	}
+
+	content, err := proto.Marshal(response)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal response")
+	}
+
+	return content, nil
+}
@@ -0,0 +1,248 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	protogrpc "github.com/burakmert236/goodswipe-common/generated/v1/grpc"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+const testMethod = protogrpc.UserService_UpdateProgress_FullMethodName
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	first := &protogrpc.UpdateProgressRequest{UserId: "user-1", ProgressAmount: 100}
+	other := &protogrpc.UpdateProgressRequest{UserId: "user-1", ProgressAmount: 200}
+
+	type call struct {
+		key        string
+		request    proto.Message
+		handlerErr error
+		wantCode   codes.Code
+		wantXp     int32
+	}
+
+	tests := []struct {
+		name      string
+		calls     []call
+		wantRuns  int
+		wantStore int
+	}{
+		{
+			name: "repeat is replayed",
+			calls: []call{
+				{key: "key-1", request: first, wantXp: 100},
+				{key: "key-1", request: first, wantXp: 100},
+			},
+			wantRuns:  1,
+			wantStore: 1,
+		},
+		{
+			name: "key reused for another request",
+			calls: []call{
+				{key: "key-1", request: first, wantXp: 100},
+				{key: "key-1", request: other, wantCode: codes.FailedPrecondition},
+			},
+			wantRuns:  1,
+			wantStore: 1,
+		},
+		{
+			name: "failed request releases the key",
+			calls: []call{
+				{key: "key-1", request: first, handlerErr: status.Error(codes.Unavailable, "down"), wantCode: codes.Unavailable},
+				{key: "key-1", request: first, wantXp: 100},
+				{key: "key-1", request: first, wantXp: 100},
+			},
+			wantRuns:  2,
+			wantStore: 1,
+		},
+		{
+			name: "different keys run separately",
+			calls: []call{
+				{key: "key-1", request: first, wantXp: 100},
+				{key: "key-2", request: first, wantXp: 100},
+			},
+			wantRuns:  2,
+			wantStore: 2,
+		},
+		{
+			name: "requests without a key always run",
+			calls: []call{
+				{request: first, wantXp: 100},
+				{request: first, wantXp: 100},
+			},
+			wantRuns:  2,
+			wantStore: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore()
+			interceptor := UnaryServerInterceptor(store, logger.Development("idempotency-test"), time.Hour, testMethod)
+
+			runs := 0
+			for i, c := range tt.calls {
+				handler := func(ctx context.Context, req any) (any, error) {
+					runs++
+					if c.handlerErr != nil {
+						return nil, c.handlerErr
+					}
+					request := req.(*protogrpc.UpdateProgressRequest)
+					return &protogrpc.UpdateProgressResponse{UserId: request.UserId, Xp: request.ProgressAmount}, nil
+				}
+
+				resp, err := interceptor(contextWithKey(c.key), c.request, &grpc.UnaryServerInfo{FullMethod: testMethod}, handler)
+
+				if got := status.Code(err); got != c.wantCode {
+					t.Fatalf("call %d: code = %v, want %v (%v)", i, got, c.wantCode, err)
+				}
+				if err != nil {
+					continue
+				}
+				if xp := resp.(*protogrpc.UpdateProgressResponse).Xp; xp != c.wantXp {
+					t.Errorf("call %d: xp = %d, want %d", i, xp, c.wantXp)
+				}
+			}
+
+			if runs != tt.wantRuns {
+				t.Errorf("handler ran %d times, want %d", runs, tt.wantRuns)
+			}
+			if len(store.records) != tt.wantStore {
+				t.Errorf("%d records stored, want %d", len(store.records), tt.wantStore)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptorRejectsRepeatInProgress(t *testing.T) {
+	store := newMemoryStore()
+	interceptor := UnaryServerInterceptor(store, logger.Development("idempotency-test"), time.Hour, testMethod)
+	request := &protogrpc.UpdateProgressRequest{UserId: "user-1", ProgressAmount: 100}
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+
+	var repeatErr error
+	handler := func(ctx context.Context, req any) (any, error) {
+		_, repeatErr = interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			t.Fatal("repeat ran while the first request was in progress")
+			return nil, nil
+		})
+		return &protogrpc.UpdateProgressResponse{UserId: "user-1"}, nil
+	}
+
+	if _, err := interceptor(contextWithKey("key-1"), request, info, handler); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+
+	if got := status.Code(repeatErr); got != codes.Aborted {
+		t.Errorf("repeat code = %v, want %v", got, codes.Aborted)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	response, err := marshalResponse(&protogrpc.UpdateProgressResponse{UserId: "user-1", Level: 3})
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		record   models.IdempotencyRecord
+		hash     string
+		wantCode string
+	}{
+		{
+			name:   "completed",
+			record: models.IdempotencyRecord{Status: models.IdempotencyStatusCompleted, RequestHash: "hash", Response: response},
+			hash:   "hash",
+		},
+		{
+			name:     "hash mismatch",
+			record:   models.IdempotencyRecord{Status: models.IdempotencyStatusCompleted, RequestHash: "hash", Response: response},
+			hash:     "other",
+			wantCode: apperrors.CodePreconditionFailed,
+		},
+		{
+			name:     "in progress",
+			record:   models.IdempotencyRecord{Status: models.IdempotencyStatusInProgress, RequestHash: "hash"},
+			hash:     "hash",
+			wantCode: apperrors.CodeConflict,
+		},
+		{
+			name:     "hash mismatch in progress",
+			record:   models.IdempotencyRecord{Status: models.IdempotencyStatusInProgress, RequestHash: "hash"},
+			hash:     "other",
+			wantCode: apperrors.CodePreconditionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := replay(&tt.record, tt.hash)
+
+			if tt.wantCode != "" {
+				if err == nil || err.Code != tt.wantCode {
+					t.Fatalf("replay error = %v, want code %s", err, tt.wantCode)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("replay: %v", err)
+			}
+			want := &protogrpc.UpdateProgressResponse{UserId: "user-1", Level: 3}
+			if !proto.Equal(resp.(proto.Message), want) {
+				t.Errorf("replay = %v, want %v", resp, want)
+			}
+		})
+	}
+}
+
+func contextWithKey(key string) context.Context {
+	if key == "" {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, key))
+}
+
+// memoryStore keeps records in memory with the claim and release rules of the
+// DynamoDB store
+type memoryStore struct {
+	records map[string]*models.IdempotencyRecord
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: make(map[string]*models.IdempotencyRecord)}
+}
+
+func (s *memoryStore) Claim(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, *apperrors.AppError) {
+	if existing, ok := s.records[record.PK]; ok {
+		copied := *existing
+		return &copied, nil
+	}
+	copied := *record
+	s.records[record.PK] = &copied
+	return nil, nil
+}
+
+func (s *memoryStore) Complete(ctx context.Context, pk string, response []byte) *apperrors.AppError {
+	s.records[pk].Status = models.IdempotencyStatusCompleted
+	s.records[pk].Response = response
+	return nil
+}
+
+func (s *memoryStore) Release(ctx context.Context, pk string) *apperrors.AppError {
+	if record, ok := s.records[pk]; ok && record.Status == models.IdempotencyStatusInProgress {
+		delete(s.records, pk)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// A claim races a record expiring or being released at most this many times
+const claimMaxAttempts = 3
+
+// Store persists idempotency records
+type Store interface {
+	Claim(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, *apperrors.AppError)
+	Complete(ctx context.Context, pk string, response []byte) *apperrors.AppError
+	Release(ctx context.Context, pk string) *apperrors.AppError
+}
+
+// store keeps the records in the shared DynamoDB table
+type store struct {
+	db *database.DynamoDBClient
+}
+
+func NewStore(db *database.DynamoDBClient) Store {
+	return &store{db: db}
+}
+
+// Claim writes an in progress record unless the key is already taken by a live
+// record. A stale record, expired or abandoned in progress, is taken over.
+// Returns the existing record when the key is taken.
+func (s *store) Claim(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, *apperrors.AppError) {
+	for attempt := 0; attempt < claimMaxAttempts; attempt++ {
+		existing, claimed, err := s.claim(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+		if claimed || existing != nil {
+			return existing, nil
+		}
+	}
+
+	return nil, apperrors.New(apperrors.CodeConflict, "idempotency key is contended, try again")
+}
+
+// claim makes a single attempt. Neither a claim nor an existing record means the
+// record expired or was released between the put and the read.
+func (s *store) claim(
+	ctx context.Context,
+	record *models.IdempotencyRecord,
+) (*models.IdempotencyRecord, bool, *apperrors.AppError) {
+	item, err := attributevalue.MarshalMap(record)
+	if err != nil {
+		return nil, false, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal idempotency record")
+	}
+
+	now := strconv.FormatInt(time.Now().UTC().Unix(), 10)
+
+	_, err = s.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(s.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK) OR expires_at < :now OR (#status = :inProgress AND locked_until < :now)"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":now":        &types.AttributeValueMemberN{Value: now},
+			":inProgress": &types.AttributeValueMemberS{Value: string(models.IdempotencyStatusInProgress)},
+		},
+	})
+
+	if err == nil {
+		return nil, true, nil
+	}
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if !errors.As(err, &conditionErr) {
+		return nil, false, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to claim idempotency key")
+	}
+
+	existing, appErr := s.get(ctx, record.PK)
+	if appErr != nil {
+		return nil, false, appErr
+	}
+
+	return existing, false, nil
+}
+
+// Complete stores the response of a claimed key
+func (s *store) Complete(ctx context.Context, pk string, response []byte) *apperrors.AppError {
+	_, err := s.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.db.Table()),
+		Key:       recordKey(pk),
+		UpdateExpression: aws.String(
+			"SET #status = :completed, response = :response, updated_at = :updatedAt",
+		),
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":completed": &types.AttributeValueMemberS{Value: string(models.IdempotencyStatusCompleted)},
+			":response":  &types.AttributeValueMemberB{Value: response},
+			":updatedAt": &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339Nano)},
+		},
+	})
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to complete idempotency record")
+	}
+
+	return nil
+}
+
+// Release deletes an in progress record so the request can be retried
+func (s *store) Release(ctx context.Context, pk string) *apperrors.AppError {
+	_, err := s.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:           aws.String(s.db.Table()),
+		Key:                 recordKey(pk),
+		ConditionExpression: aws.String("#status = :inProgress"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":inProgress": &types.AttributeValueMemberS{Value: string(models.IdempotencyStatusInProgress)},
+		},
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if err != nil && !errors.As(err, &conditionErr) {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to release idempotency record")
+	}
+
+	return nil
+}
+
+// get returns nil for missing records and records past their TTL, DynamoDB
+// removes expired items lazily
+func (s *store) get(ctx context.Context, pk string) (*models.IdempotencyRecord, *apperrors.AppError) {
+	result, err := s.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(s.db.Table()),
+		Key:            recordKey(pk),
+		ConsistentRead: aws.Bool(true),
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get idempotency record")
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var record models.IdempotencyRecord
+	if err := attributevalue.UnmarshalMap(result.Item, &record); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal idempotency record")
+	}
+
+	if record.ExpiresAt < time.Now().UTC().Unix() {
+		return nil, nil
+	}
+
+	return &record, nil
+}
+
+func recordKey(pk string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"PK": &types.AttributeValueMemberS{Value: pk},
+		"SK": &types.AttributeValueMemberS{Value: models.IdempotencySK()},
+	}
+}
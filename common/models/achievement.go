@@ -0,0 +1,95 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// AchievementMetric is a user statistic achievements are defined on
+type AchievementMetric string
+
+const (
+	AchievementMetricLevel              AchievementMetric = "LEVEL"
+	AchievementMetricPrestige           AchievementMetric = "PRESTIGE"
+	AchievementMetricTournamentsEntered AchievementMetric = "TOURNAMENTS_ENTERED"
+	AchievementMetricTournamentWins     AchievementMetric = "TOURNAMENT_WINS"
+	AchievementMetricTournamentTopThree AchievementMetric = "TOURNAMENT_TOP_THREE"
+)
+
+func (m AchievementMetric) IsValid() bool {
+	switch m {
+	case AchievementMetricLevel,
+		AchievementMetricPrestige,
+		AchievementMetricTournamentsEntered,
+		AchievementMetricTournamentWins,
+		AchievementMetricTournamentTopThree:
+		return true
+	}
+	return false
+}
+
+// Achievement is an achievement unlocked by a user
+type Achievement struct {
+	UserId        string    `dynamodbav:"user_id"`
+	AchievementId string    `dynamodbav:"achievement_id"`
+	Reward        int       `dynamodbav:"reward"`
+	UnlockedAt    time.Time `dynamodbav:"unlocked_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// AchievementStats holds the highest values and counters achievements are
+// evaluated on. Counters are attributes named after the metric so single
+// metrics can be incremented in place.
+type AchievementStats struct {
+	UserId             string    `dynamodbav:"user_id"`
+	Level              int       `dynamodbav:"LEVEL"`
+	Prestige           int       `dynamodbav:"PRESTIGE"`
+	TournamentsEntered int       `dynamodbav:"TOURNAMENTS_ENTERED"`
+	TournamentWins     int       `dynamodbav:"TOURNAMENT_WINS"`
+	TournamentTopThree int       `dynamodbav:"TOURNAMENT_TOP_THREE"`
+	UpdatedAt          time.Time `dynamodbav:"updated_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+func (s *AchievementStats) Value(metric AchievementMetric) int {
+	switch metric {
+	case AchievementMetricLevel:
+		return s.Level
+	case AchievementMetricPrestige:
+		return s.Prestige
+	case AchievementMetricTournamentsEntered:
+		return s.TournamentsEntered
+	case AchievementMetricTournamentWins:
+		return s.TournamentWins
+	case AchievementMetricTournamentTopThree:
+		return s.TournamentTopThree
+	}
+	return 0
+}
+
+// Key handlers
+func AchievementsPK(userId string) string {
+	return fmt.Sprintf("ACHIEVEMENTS#%s", userId)
+}
+
+func AchievementSK(achievementId string) string {
+	return fmt.Sprintf("UNLOCKED#%s", achievementId)
+}
+
+func AchievementStatsSK() string {
+	return "STATS"
+}
+
+// AchievementEventSK marks an event as counted towards the stats
+func AchievementEventSK(eventKey string) string {
+	return fmt.Sprintf("EVENT#%s", eventKey)
+}
+
+// AchievementClaimSK is the reward claim sort key of an achievement reward
+func AchievementClaimSK(achievementId string) string {
+	return fmt.Sprintf("ACHIEVEMENT#%s", achievementId)
+}
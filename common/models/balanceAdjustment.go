@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type BalanceAdjustmentType string
+
+const (
+	BalanceAdjustmentTypeGrant  BalanceAdjustmentType = "GRANT"
+	BalanceAdjustmentTypeRevoke BalanceAdjustmentType = "REVOKE"
+)
+
+// BalanceAdjustment is the audit record of a balance change made by support
+// staff. Amount is always positive, Type tells the direction. Records outlive
+// the user, UserDeletedAt is set once the free text reason was redacted.
+type BalanceAdjustment struct {
+	UserId          string                `dynamodbav:"user_id"`
+	RequestId       string                `dynamodbav:"request_id"`
+	Type            BalanceAdjustmentType `dynamodbav:"type"`
+	Currency        Currency              `dynamodbav:"currency"`
+	Amount          int                   `dynamodbav:"amount"`
+	OperatorId      string                `dynamodbav:"operator_id"`
+	Reason          string                `dynamodbav:"reason"`
+	TicketReference string                `dynamodbav:"ticket_reference"`
+	UserDeletedAt   *time.Time            `dynamodbav:"user_deleted_at,omitempty"`
+	CreatedAt       time.Time             `dynamodbav:"created_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// RedactedReason replaces the reason of adjustments of deleted users
+const RedactedReason = "[redacted after user deletion]"
+
+// Delta is the signed balance change of the adjustment
+func (a *BalanceAdjustment) Delta() int {
+	if a.Type == BalanceAdjustmentTypeRevoke {
+		return -a.Amount
+	}
+	return a.Amount
+}
+
+// Matches reports whether a repeated request asks for the same adjustment
+func (a *BalanceAdjustment) Matches(other *BalanceAdjustment) bool {
+	return a.Type == other.Type && a.Currency == other.Currency && a.Amount == other.Amount
+}
+
+// Key handlers
+func BalanceAdjustmentPK(userId string) string {
+	return fmt.Sprintf("ADJUSTMENT#%s", userId)
+}
+
+func BalanceAdjustmentSK(requestId string) string {
+	return fmt.Sprintf("REQUEST#%s", requestId)
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+const botUserIdPrefix = "bot-"
+
+// Key handlers
+func BotUserId(id string) string {
+	return fmt.Sprintf("%s%s", botUserIdPrefix, id)
+}
+
+func IsBotUserId(userId string) bool {
+	return strings.HasPrefix(userId, botUserIdPrefix)
+}
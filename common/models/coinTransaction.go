@@ -0,0 +1,50 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type CoinTransactionReason string
+
+const (
+	CoinReasonInitialGrant          CoinTransactionReason = "INITIAL_GRANT"
+	CoinReasonLevelUpReward         CoinTransactionReason = "LEVEL_UP_REWARD"
+	CoinReasonTournamentEntryFee    CoinTransactionReason = "TOURNAMENT_ENTRY_FEE"
+	CoinReasonTournamentEntryRefund CoinTransactionReason = "TOURNAMENT_ENTRY_REFUND"
+	CoinReasonTournamentReward      CoinTransactionReason = "TOURNAMENT_REWARD"
+	CoinReasonSeasonReward          CoinTransactionReason = "SEASON_REWARD"
+	CoinReasonDailyReward           CoinTransactionReason = "DAILY_REWARD"
+	CoinReasonStreakFreezePurchase  CoinTransactionReason = "STREAK_FREEZE_PURCHASE"
+	CoinReasonAchievementReward     CoinTransactionReason = "ACHIEVEMENT_REWARD"
+	CoinReasonReferralReward        CoinTransactionReason = "REFERRAL_REWARD"
+	CoinReasonAdminGrant            CoinTransactionReason = "ADMIN_GRANT"
+	CoinReasonAdminRevoke           CoinTransactionReason = "ADMIN_REVOKE"
+)
+
+// CoinTransaction is an append-only ledger entry written with every balance change
+type CoinTransaction struct {
+	TransactionId string                `dynamodbav:"transaction_id"`
+	UserId        string                `dynamodbav:"user_id"`
+	Currency      Currency              `dynamodbav:"currency"`
+	Amount        int                   `dynamodbav:"amount"`
+	BalanceAfter  int                   `dynamodbav:"balance_after"`
+	Reason        CoinTransactionReason `dynamodbav:"reason"`
+	ReferenceId   string                `dynamodbav:"reference_id"`
+	CreatedAt     time.Time             `dynamodbav:"created_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// Fixed width so ledger sort keys order chronologically
+const coinTransactionTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// Key handlers
+func CoinLedgerPK(userId string) string {
+	return fmt.Sprintf("COINLEDGER#%s", userId)
+}
+
+func CoinTransactionSK(createdAt time.Time, transactionId string) string {
+	return fmt.Sprintf("TX#%s#%s", createdAt.UTC().Format(coinTransactionTimeLayout), transactionId)
+}
@@ -0,0 +1,28 @@
+package models
+
+type Currency string
+
+const (
+	CurrencyCoin       Currency = "COIN"
+	CurrencyGem        Currency = "GEM"
+	CurrencyEventToken Currency = "EVENT_TOKEN"
+)
+
+var supportedCurrencies = map[Currency]bool{
+	CurrencyCoin:       true,
+	CurrencyGem:        true,
+	CurrencyEventToken: true,
+}
+
+func (c Currency) IsValid() bool {
+	return supportedCurrencies[c]
+}
+
+// CurrencyOrDefault treats an empty currency as coin, records written before
+// the wallet was introduced carry no currency at all
+func CurrencyOrDefault(currency string) Currency {
+	if currency == "" {
+		return CurrencyCoin
+	}
+	return Currency(currency)
+}
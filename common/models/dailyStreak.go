@@ -0,0 +1,30 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// DailyStreak tracks the consecutive days a user claimed the daily reward.
+// LastClaimDay is the calendar day (YYYY-MM-DD) of the last claim in the
+// configured timezone, Freezes are purchased streak freezes not used yet.
+type DailyStreak struct {
+	UserId       string    `dynamodbav:"user_id"`
+	Streak       int       `dynamodbav:"streak"`
+	LastClaimDay string    `dynamodbav:"last_claim_day"`
+	Freezes      int       `dynamodbav:"freezes"`
+	UpdatedAt    time.Time `dynamodbav:"updated_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// Key handlers
+func DailyStreakPK(userId string) string {
+	return fmt.Sprintf("DAILYSTREAK#%s", userId)
+}
+
+// DailyRewardClaimSK is the reward claim sort key of a daily reward
+func DailyRewardClaimSK(day string) string {
+	return fmt.Sprintf("DAILY#%s", day)
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DisplayNameClaim reserves a normalized display name for a single user
+type DisplayNameClaim struct {
+	DisplayName string    `dynamodbav:"display_name"`
+	UserId      string    `dynamodbav:"user_id"`
+	CreatedAt   time.Time `dynamodbav:"created_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// NormalizeDisplayName is the form display names are compared by
+func NormalizeDisplayName(displayName string) string {
+	return strings.ToLower(strings.TrimSpace(displayName))
+}
+
+// Key handlers
+func DisplayNamePK(displayName string) string {
+	return fmt.Sprintf("DISPLAYNAME#%s", NormalizeDisplayName(displayName))
+}
@@ -0,0 +1,22 @@
+package models
+
+type EligibilityRuleType string
+
+const (
+	EligibilityMinLevel          EligibilityRuleType = "MIN_LEVEL"
+	EligibilityMaxLevel          EligibilityRuleType = "MAX_LEVEL"
+	EligibilityMinAccountAgeDays EligibilityRuleType = "MIN_ACCOUNT_AGE_DAYS"
+	EligibilityRegionIn          EligibilityRuleType = "REGION_IN"
+	EligibilityMinCoin           EligibilityRuleType = "MIN_COIN"
+	EligibilityMinParticipations EligibilityRuleType = "MIN_PARTICIPATIONS"
+	EligibilityMaxParticipations EligibilityRuleType = "MAX_PARTICIPATIONS"
+	EligibilityNotBanned         EligibilityRuleType = "NOT_BANNED"
+)
+
+// EligibilityRule is a single entry predicate of a tournament. Numeric rules
+// read Value, set based rules such as REGION_IN read Values.
+type EligibilityRule struct {
+	Type   EligibilityRuleType `dynamodbav:"type"`
+	Value  int                 `dynamodbav:"value,omitempty"`
+	Values []string            `dynamodbav:"values,omitempty"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// FriendshipStatus is the state of a friendship seen from one side
+type FriendshipStatus string
+
+const (
+	FriendshipStatusPendingOutgoing FriendshipStatus = "PENDING_OUTGOING"
+	FriendshipStatusPendingIncoming FriendshipStatus = "PENDING_INCOMING"
+	FriendshipStatusAccepted        FriendshipStatus = "ACCEPTED"
+)
+
+func (s FriendshipStatus) IsValid() bool {
+	switch s {
+	case FriendshipStatusPendingOutgoing, FriendshipStatusPendingIncoming, FriendshipStatusAccepted:
+		return true
+	}
+	return false
+}
+
+// Friendship is one edge of the friends graph, every friendship is stored
+// twice so each user can list their side with a single query
+type Friendship struct {
+	UserId    string           `dynamodbav:"user_id"`
+	FriendId  string           `dynamodbav:"friend_id"`
+	Status    FriendshipStatus `dynamodbav:"status"`
+	CreatedAt time.Time        `dynamodbav:"created_at"`
+	UpdatedAt time.Time        `dynamodbav:"updated_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// Key handlers
+func FriendsPK(userId string) string {
+	return fmt.Sprintf("FRIENDS#%s", userId)
+}
+
+func FriendSK(friendId string) string {
+	return fmt.Sprintf("FRIEND#%s", friendId)
+}
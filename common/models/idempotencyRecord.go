@@ -0,0 +1,41 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type IdempotencyStatus string
+
+const (
+	IdempotencyStatusInProgress IdempotencyStatus = "IN_PROGRESS"
+	IdempotencyStatusCompleted  IdempotencyStatus = "COMPLETED"
+)
+
+// IdempotencyRecord stores the outcome of a request sent with an idempotency
+// key. Response is the serialized response, ExpiresAt the DynamoDB TTL in epoch
+// seconds.
+type IdempotencyRecord struct {
+	Method      string            `dynamodbav:"method"`
+	Caller      string            `dynamodbav:"caller"`
+	Key         string            `dynamodbav:"idempotency_key"`
+	Status      IdempotencyStatus `dynamodbav:"status"`
+	RequestHash string            `dynamodbav:"request_hash"`
+	Response    []byte            `dynamodbav:"response,omitempty"`
+	LockedUntil int64             `dynamodbav:"locked_until"`
+	ExpiresAt   int64             `dynamodbav:"expires_at"`
+	CreatedAt   time.Time         `dynamodbav:"created_at"`
+	UpdatedAt   time.Time         `dynamodbav:"updated_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// Key handlers
+func IdempotencyPK(method, caller, key string) string {
+	return fmt.Sprintf("IDEMPOTENCY#%s#%s#%s", method, caller, key)
+}
+
+func IdempotencySK() string {
+	return "RECORD"
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// InboxMessageType decides the template an inbox message is rendered from
+type InboxMessageType string
+
+const (
+	InboxMessageTypeTournamentEnded       InboxMessageType = "TOURNAMENT_ENDED"
+	InboxMessageTypeRewardAvailable       InboxMessageType = "REWARD_AVAILABLE"
+	InboxMessageTypeOvertaken             InboxMessageType = "OVERTAKEN"
+	InboxMessageTypeReservationRolledBack InboxMessageType = "RESERVATION_ROLLED_BACK"
+)
+
+func (t InboxMessageType) IsValid() bool {
+	switch t {
+	case InboxMessageTypeTournamentEnded,
+		InboxMessageTypeRewardAvailable,
+		InboxMessageTypeOvertaken,
+		InboxMessageTypeReservationRolledBack:
+		return true
+	}
+	return false
+}
+
+// InboxMessage is a rendered notification of a user. ExpiresAt is the DynamoDB
+// TTL in epoch seconds.
+type InboxMessage struct {
+	UserId      string           `dynamodbav:"user_id"`
+	MessageId   string           `dynamodbav:"message_id"`
+	Type        InboxMessageType `dynamodbav:"type"`
+	Title       string           `dynamodbav:"title"`
+	Body        string           `dynamodbav:"body"`
+	ReferenceId string           `dynamodbav:"reference_id,omitempty"`
+	IsRead      bool             `dynamodbav:"is_read"`
+	CreatedAt   time.Time        `dynamodbav:"created_at"`
+	ReadAt      *time.Time       `dynamodbav:"read_at,omitempty"`
+	ExpiresAt   int64            `dynamodbav:"expires_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// InboxMessageId orders messages by the time of the event, the key of the event
+// keeps messages of the same second apart
+func InboxMessageId(occurredAt time.Time, eventKey string) string {
+	return fmt.Sprintf("%020d#%s", occurredAt.UTC().Unix(), eventKey)
+}
+
+// Key handlers
+func InboxPK(userId string) string {
+	return fmt.Sprintf("INBOX#%s", userId)
+}
+
+func InboxMessageSK(messageId string) string {
+	return fmt.Sprintf("MSG#%s", messageId)
+}
+
+// InboxEventSK claims an event for the inbox, a republished event may carry a
+// new time but keeps its key
+func InboxEventSK(eventKey string) string {
+	return fmt.Sprintf("EVENT#%s", eventKey)
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Migration marks a one-off data migration as done, so it is not run again on
+// the next start
+type Migration struct {
+	Name        string    `dynamodbav:"name"`
+	CompletedAt time.Time `dynamodbav:"completed_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// Key handlers
+func MigrationPK(name string) string {
+	return fmt.Sprintf("MIGRATION#%s", name)
+}
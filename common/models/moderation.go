@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// ModerationStatus restricts what a user may do. Suspended users are blocked
+// until their suspension ends, banned users for good and they are hidden from
+// leaderboards.
+type ModerationStatus string
+
+const (
+	ModerationStatusActive    ModerationStatus = "ACTIVE"
+	ModerationStatusSuspended ModerationStatus = "SUSPENDED"
+	ModerationStatusBanned    ModerationStatus = "BANNED"
+)
+
+// Moderation is the moderation state of a user together with who set it and why
+type Moderation struct {
+	Status         ModerationStatus `dynamodbav:"status"`
+	SuspendedUntil *time.Time       `dynamodbav:"suspended_until,omitempty"`
+	Reason         string           `dynamodbav:"reason"`
+	OperatorId     string           `dynamodbav:"operator_id"`
+	UpdatedAt      time.Time        `dynamodbav:"updated_at"`
+}
+
+// EffectiveStatus is the status at the given time, an ended suspension is active again
+func (m *Moderation) EffectiveStatus(now time.Time) ModerationStatus {
+	if m == nil || m.Status == "" {
+		return ModerationStatusActive
+	}
+
+	if m.Status == ModerationStatusSuspended && (m.SuspendedUntil == nil || !now.Before(*m.SuspendedUntil)) {
+		return ModerationStatusActive
+	}
+
+	return m.Status
+}
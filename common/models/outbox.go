@@ -0,0 +1,29 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// OutboxEvent is a domain event written in the same transaction as the change
+// it announces, so a committed change always has its event. The relay publishes
+// and deletes it, EventId lets the stream drop an event that is published twice.
+type OutboxEvent struct {
+	EventId   string    `dynamodbav:"event_id"`
+	Subject   string    `dynamodbav:"subject"`
+	Payload   []byte    `dynamodbav:"payload"`
+	CreatedAt time.Time `dynamodbav:"created_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// Key handlers
+func OutboxPK() string {
+	return "OUTBOX"
+}
+
+// OutboxSK sorts pending events by the time they were written
+func OutboxSK(createdAt time.Time, eventId string) string {
+	return fmt.Sprintf("EVENT#%020d#%s", createdAt.UTC().UnixNano(), eventId)
+}
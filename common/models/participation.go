@@ -21,11 +21,16 @@ type Participation struct {
 	RewardClaimStatus RewardClaimStatus `dynamodbav:"reward_claim_status"`
 	EndsAt            time.Time         `dynamodbav:"ends_at"`
 	RewardingMap      map[string]int    `dynamodbav:"rewarding_map"`
+	RewardCurrency    Currency          `dynamodbav:"reward_currency,omitempty"`
+	IsBot             bool              `dynamodbav:"is_bot"`
 	CreatedAt         time.Time         `dynamodbav:"created_at"`
 	UpdatedAt         time.Time         `dynamodbav:"updated_at"`
 
 	PK string `dynamodbav:"PK"`
 	SK string `dynamodbav:"SK"`
+
+	GSI1PK string `dynamodbav:"GSI1PK,omitempty"`
+	GSI1SK string `dynamodbav:"GSI1SK,omitempty"`
 }
 
 func UserGSI1PK(userId string) string {
@@ -35,3 +40,11 @@ func UserGSI1PK(userId string) string {
 func TournamentJoinedGSI1SK(tournamentId, joinedAt string) string {
 	return fmt.Sprintf("TOURNAMENT#%s#JOINED#%s", tournamentId, joinedAt)
 }
+
+func BotParticipationGSI1PK(tournamentId string) string {
+	return fmt.Sprintf("BOT#TOURNAMENT#%s", tournamentId)
+}
+
+func BotParticipationGSI1SK(groupId, userId string) string {
+	return fmt.Sprintf("GROUP#%s#USER#%s", groupId, userId)
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// PushPreferences decide which inbox messages of a user are also pushed to the
+// device. Quiet hours are "HH:MM" in the user's timezone, an end before the
+// start spans midnight.
+type PushPreferences struct {
+	UserId          string             `dynamodbav:"user_id"`
+	Enabled         bool               `dynamodbav:"enabled"`
+	MutedTypes      []InboxMessageType `dynamodbav:"muted_types,omitempty"`
+	QuietHoursStart string             `dynamodbav:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string             `dynamodbav:"quiet_hours_end,omitempty"`
+	Timezone        string             `dynamodbav:"timezone,omitempty"`
+	UpdatedAt       time.Time          `dynamodbav:"updated_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// DefaultPushPreferences apply to users who never changed their preferences
+func DefaultPushPreferences(userId string) *PushPreferences {
+	return &PushPreferences{
+		UserId:  userId,
+		Enabled: true,
+	}
+}
+
+func (p *PushPreferences) IsMuted(messageType InboxMessageType) bool {
+	for _, muted := range p.MutedTypes {
+		if muted == messageType {
+			return true
+		}
+	}
+	return false
+}
+
+// PushDeliveryStatus is the outcome of pushing a batch of messages to a user
+type PushDeliveryStatus string
+
+const (
+	PushDeliveryStatusSent        PushDeliveryStatus = "SENT"
+	PushDeliveryStatusFailed      PushDeliveryStatus = "FAILED"
+	PushDeliveryStatusQuietHours  PushDeliveryStatus = "QUIET_HOURS"
+	PushDeliveryStatusRateLimited PushDeliveryStatus = "RATE_LIMITED"
+)
+
+// PushDelivery records one push of a batch of inbox messages, including the
+// attempts it took and the last error. ExpiresAt is the DynamoDB TTL in epoch seconds.
+type PushDelivery struct {
+	UserId     string             `dynamodbav:"user_id"`
+	DeliveryId string             `dynamodbav:"delivery_id"`
+	Provider   string             `dynamodbav:"provider"`
+	Status     PushDeliveryStatus `dynamodbav:"status"`
+	MessageIds []string           `dynamodbav:"message_ids"`
+	Attempts   int                `dynamodbav:"attempts"`
+	Error      string             `dynamodbav:"error,omitempty"`
+	CreatedAt  time.Time          `dynamodbav:"created_at"`
+	ExpiresAt  int64              `dynamodbav:"expires_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// Key handlers
+func PushPreferencesPK(userId string) string {
+	return fmt.Sprintf("PUSHPREFS#%s", userId)
+}
+
+func PushDeliveryPK(userId string) string {
+	return fmt.Sprintf("PUSHDELIVERY#%s", userId)
+}
+
+func PushDeliverySK(createdAt time.Time, deliveryId string) string {
+	return fmt.Sprintf("ATTEMPT#%020d#%s", createdAt.UTC().UnixNano(), deliveryId)
+}
+
+// PushRateSK counts the pushes of a user within a rate limit window
+func PushRateSK(window string) string {
+	return fmt.Sprintf("RATE#%s", window)
+}
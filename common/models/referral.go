@@ -0,0 +1,78 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReferralMilestone is what a referee has to reach before both sides are rewarded
+type ReferralMilestone string
+
+const (
+	ReferralMilestoneTournamentEntered ReferralMilestone = "TOURNAMENT_ENTERED"
+	ReferralMilestoneLevel             ReferralMilestone = "LEVEL"
+)
+
+func (m ReferralMilestone) IsValid() bool {
+	return m == ReferralMilestoneTournamentEntered || m == ReferralMilestoneLevel
+}
+
+type ReferralStatus string
+
+const (
+	ReferralStatusPending   ReferralStatus = "PENDING"
+	ReferralStatusCompleted ReferralStatus = "COMPLETED"
+)
+
+// ReferralCode claims a code for its owner, one item per code keeps codes unique
+type ReferralCode struct {
+	Code      string    `dynamodbav:"code"`
+	UserId    string    `dynamodbav:"user_id"`
+	CreatedAt time.Time `dynamodbav:"created_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// Referral links a referee to the referrer whose code they signed up with.
+// ReferrerRewarded is false for referrals completed after the referrer reached
+// the reward limit, the referee is rewarded either way.
+type Referral struct {
+	ReferrerId       string         `dynamodbav:"referrer_id"`
+	RefereeId        string         `dynamodbav:"referee_id"`
+	Status           ReferralStatus `dynamodbav:"status"`
+	ReferrerRewarded bool           `dynamodbav:"referrer_rewarded"`
+	CreatedAt        time.Time      `dynamodbav:"created_at"`
+	CompletedAt      *time.Time     `dynamodbav:"completed_at,omitempty"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+// Key handlers
+func ReferralCodePK(code string) string {
+	return fmt.Sprintf("REFERRALCODE#%s", code)
+}
+
+func ReferralsPK(referrerId string) string {
+	return fmt.Sprintf("REFERRALS#%s", referrerId)
+}
+
+func RefereeSK(refereeId string) string {
+	return fmt.Sprintf("REFEREE#%s", refereeId)
+}
+
+// ReferralStatsSK counts the rewarded referrals of a referrer
+func ReferralStatsSK() string {
+	return "STATS"
+}
+
+// ReferralDaySK counts the sign ups with a referrer's code on a day
+func ReferralDaySK(day string) string {
+	return fmt.Sprintf("DAY#%s", day)
+}
+
+// ReferralClaimSK is the reward claim sort key of a referral, both sides use the referee id
+func ReferralClaimSK(refereeId string) string {
+	return fmt.Sprintf("REFERRAL#%s", refereeId)
+}
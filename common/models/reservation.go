@@ -16,6 +16,7 @@ const (
 type Reservation struct {
 	UserId       string            `dynamodbav:"user_id"`
 	TournamentId string            `dynamodbav:"tournament_id"`
+	Currency     Currency          `dynamodbav:"currency"`
 	Amount       int64             `dynamodbav:"amount"`
 	Status       ReservationStatus `dynamodbav:"status"`
 	Purpose      string            `dynamodbav:"purpose"`
@@ -6,10 +6,14 @@ import (
 )
 
 type RewardClaim struct {
-	UserId       string    `dynamodbav:"user_id"`
-	TournamentId string    `dynamodbav:"tournament_id"`
-	CreatedAt    time.Time `dynamodbav:"created_at"`
-	UpdatedAt    time.Time `dynamodbav:"updated_at"`
+	UserId        string    `dynamodbav:"user_id"`
+	TournamentId  string    `dynamodbav:"tournament_id,omitempty"`
+	SeasonId      string    `dynamodbav:"season_id,omitempty"`
+	Day           string    `dynamodbav:"day,omitempty"`
+	AchievementId string    `dynamodbav:"achievement_id,omitempty"`
+	RefereeId     string    `dynamodbav:"referee_id,omitempty"`
+	CreatedAt     time.Time `dynamodbav:"created_at"`
+	UpdatedAt     time.Time `dynamodbav:"updated_at"`
 
 	PK string `dynamodbav:"PK"`
 	SK string `dynamodbav:"SK"`
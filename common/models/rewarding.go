@@ -0,0 +1,60 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+)
+
+// RankReward resolves a 1-based ranking against a rewarding map whose keys are
+// either single ranks ("1") or inclusive ranges ("4-10")
+func RankReward(ranking int, rewardingMap map[string]int) int {
+	rankStr := strconv.Itoa(ranking)
+	if reward, exists := rewardingMap[rankStr]; exists {
+		return reward
+	}
+
+	for key, reward := range rewardingMap {
+		start, end, ok := parseRankRange(key)
+		if ok && ranking >= start && ranking <= end {
+			return reward
+		}
+	}
+
+	return 0
+}
+
+// MaxRewardedRank returns the lowest ranking that still receives a reward
+func MaxRewardedRank(rewardingMap map[string]int) int {
+	maxRank := 0
+
+	for key := range rewardingMap {
+		if rank, err := strconv.Atoi(strings.TrimSpace(key)); err == nil {
+			maxRank = max(maxRank, rank)
+			continue
+		}
+		if _, end, ok := parseRankRange(key); ok {
+			maxRank = max(maxRank, end)
+		}
+	}
+
+	return maxRank
+}
+
+func parseRankRange(key string) (int, int, bool) {
+	if !strings.Contains(key, "-") {
+		return 0, 0, false
+	}
+
+	parts := strings.Split(key, "-")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+
+	start, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
+	end, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err1 != nil || err2 != nil {
+		return 0, 0, false
+	}
+
+	return start, end, true
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type SeasonStatus string
+
+const (
+	SeasonStatusActive   SeasonStatus = "ACTIVE"
+	SeasonStatusRewarded SeasonStatus = "REWARDED"
+)
+
+type Season struct {
+	SeasonId     string         `dynamodbav:"season_id"`
+	StartsAt     time.Time      `dynamodbav:"starts_at"`
+	EndsAt       time.Time      `dynamodbav:"ends_at"`
+	Status       SeasonStatus   `dynamodbav:"status"`
+	PointsMap    map[string]int `dynamodbav:"points_map"`
+	RewardingMap map[string]int `dynamodbav:"rewarding_map"`
+	CreatedAt    time.Time      `dynamodbav:"created_at"`
+	UpdatedAt    time.Time      `dynamodbav:"updated_at"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+
+	GSI1PK string `dynamodbav:"GSI1PK"`
+	GSI1SK string `dynamodbav:"GSI1SK"`
+}
+
+// Key handlers
+func SeasonPK(seasonId string) string {
+	return fmt.Sprintf("SEASON#%s", seasonId)
+}
+
+func SeasonGSI1PK() string {
+	return "SEASON"
+}
@@ -6,17 +6,23 @@ import (
 )
 
 type Tournament struct {
-	TournamentId                 string         `dynamodbav:"tournament_id"`
-	StartsAt                     time.Time      `dynamodbav:"starts_at"`
-	EndsAt                       time.Time      `dynamodbav:"ends_at"`
-	LastAllowedParticipationDate time.Time      `dynamodbav:"last_allowed_participation_date"`
-	ScoreRewardPerLevelUpgrade   int            `dynamodbav:"score_reward_per_level_upgrade"`
-	GroupSize                    int            `dynamodbav:"group_size"`
-	UserLevelLimit               int            `dynamodbav:"user_level_limit"`
-	EnteranceFee                 int            `dynamodbav:"enterance_fee"`
-	RewardingMap                 map[string]int `dynamodbav:"rewarding_map"`
-	CreatedAt                    time.Time      `dynamodbav:"created_at"`
-	UpdatedAt                    time.Time      `dynamodbav:"updated_at"`
+	TournamentId                 string            `dynamodbav:"tournament_id"`
+	StartsAt                     time.Time         `dynamodbav:"starts_at"`
+	EndsAt                       time.Time         `dynamodbav:"ends_at"`
+	LastAllowedParticipationDate time.Time         `dynamodbav:"last_allowed_participation_date"`
+	ScoreRewardPerLevelUpgrade   int               `dynamodbav:"score_reward_per_level_upgrade"`
+	GroupSize                    int               `dynamodbav:"group_size"`
+	UserLevelLimit               int               `dynamodbav:"user_level_limit"`
+	EligibilityRules             []EligibilityRule `dynamodbav:"eligibility_rules"`
+	EnteranceFee                 int               `dynamodbav:"enterance_fee"`
+	EnteranceFeeCurrency         Currency          `dynamodbav:"enterance_fee_currency,omitempty"`
+	RewardingMap                 map[string]int    `dynamodbav:"rewarding_map"`
+	RewardCurrency               Currency          `dynamodbav:"reward_currency,omitempty"`
+	BotSettings                  BotSettings       `dynamodbav:"bot_settings"`
+	SeasonId                     string            `dynamodbav:"season_id,omitempty"`
+	FinalizedAt                  *time.Time        `dynamodbav:"finalized_at,omitempty"`
+	CreatedAt                    time.Time         `dynamodbav:"created_at"`
+	UpdatedAt                    time.Time         `dynamodbav:"updated_at"`
 
 	PK string `dynamodbav:"PK"`
 	SK string `dynamodbav:"SK"`
@@ -25,6 +31,17 @@ type Tournament struct {
 	GSI1SK string `dynamodbav:"GSI1SK"`
 }
 
+// BotSettings controls how synthetic participants are injected into groups
+// that are still below size once FillAfterMinutes have passed since the group
+// was created.
+type BotSettings struct {
+	Enabled          bool    `dynamodbav:"enabled"`
+	FillAfterMinutes int     `dynamodbav:"fill_after_minutes"`
+	ScoreMean        float64 `dynamodbav:"score_mean"`
+	ScoreStdDev      float64 `dynamodbav:"score_std_dev"`
+	ActivityRate     float64 `dynamodbav:"activity_rate"`
+}
+
 // Key handlers
 func TournamentPK(tournamentId string) string {
 	return fmt.Sprintf("TOURNAMENT#%s", tournamentId)
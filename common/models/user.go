@@ -2,21 +2,106 @@ package models
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
+// User is the profile of a player. ReferralCode is the user's own code,
+// ReferredBy the referrer whose code they signed up with. Moderation is nil
+// for users who were never suspended or banned. Country is the optional ISO
+// 3166-1 alpha-2 code the user plays from. Version is incremented by
+// every write of the profile, a write only succeeds against the version it read.
 type User struct {
-	UserId      string    `dynamodbav:"user_id"`
-	DisplayName string    `dynamodbav:"display_name"`
-	Level       int       `dynamodbav:"level"`
-	Coin        int       `dynamodbav:"coin"`
-	CreatedAt   time.Time `dynamodbav:"created_at"`
-	UpdatedAt   time.Time `dynamodbav:"updated_at"`
+	UserId       string           `dynamodbav:"user_id"`
+	DisplayName  string           `dynamodbav:"display_name"`
+	Level        int              `dynamodbav:"level"`
+	XP           int              `dynamodbav:"xp"`
+	Prestige     int              `dynamodbav:"prestige"`
+	Coin         int              `dynamodbav:"coin"`
+	Wallet       map[Currency]int `dynamodbav:"wallet,omitempty"`
+	ReferralCode string           `dynamodbav:"referral_code,omitempty"`
+	ReferredBy   string           `dynamodbav:"referred_by,omitempty"`
+	Moderation   *Moderation      `dynamodbav:"moderation,omitempty"`
+	Country      string           `dynamodbav:"country,omitempty"`
+	Version      int              `dynamodbav:"version"`
+	CreatedAt    time.Time        `dynamodbav:"created_at"`
+	UpdatedAt    time.Time        `dynamodbav:"updated_at"`
 
 	PK string `dynamodbav:"PK"`
 	SK string `dynamodbav:"SK"`
 }
 
+// NormalizeCountry is the form country codes are stored and compared by
+func NormalizeCountry(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
+
+// IsValidCountry reports whether a normalized country has the form of an ISO
+// 3166-1 alpha-2 code
+func IsValidCountry(country string) bool {
+	if len(country) != 2 {
+		return false
+	}
+	for _, letter := range country {
+		if letter < 'A' || letter > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Progress is the XP position of a user
+type Progress struct {
+	Level    int
+	XP       int
+	Prestige int
+}
+
+func (u *User) Progress() Progress {
+	return Progress{Level: u.Level, XP: u.XP, Prestige: u.Prestige}
+}
+
+func (u *User) SetProgress(progress Progress) {
+	u.Level = progress.Level
+	u.XP = progress.XP
+	u.Prestige = progress.Prestige
+}
+
+// Balance returns the user's balance in the given currency. Coin keeps its
+// original top level attribute, every other currency lives in the wallet map.
+func (u *User) Balance(currency Currency) int {
+	if currency == CurrencyCoin {
+		return u.Coin
+	}
+	return u.Wallet[currency]
+}
+
+func (u *User) SetBalance(currency Currency, balance int) {
+	if currency == CurrencyCoin {
+		u.Coin = balance
+		return
+	}
+
+	if u.Wallet == nil {
+		u.Wallet = make(map[Currency]int)
+	}
+	u.Wallet[currency] = balance
+}
+
+// ModerationStatus returns the user's moderation status at the given time
+func (u *User) ModerationStatus(now time.Time) ModerationStatus {
+	return u.Moderation.EffectiveStatus(now)
+}
+
+// Balances returns every currency balance of the user including coin
+func (u *User) Balances() map[Currency]int {
+	balances := map[Currency]int{CurrencyCoin: u.Coin}
+	for currency, balance := range u.Wallet {
+		balances[currency] = balance
+	}
+	return balances
+}
+
 // Key handlers
 func UserPK(userId string) string {
 	return fmt.Sprintf("USER#%s", userId)
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type UserDeletionStatus string
+
+const (
+	UserDeletionInProgress UserDeletionStatus = "IN_PROGRESS"
+	UserDeletionCompleted  UserDeletionStatus = "COMPLETED"
+)
+
+// Services that hold user data and must confirm its removal
+const (
+	DeletionStepUserService        = "user-service"
+	DeletionStepTournamentService  = "tournament-service"
+	DeletionStepLeaderboardService = "leaderboard-service"
+)
+
+// UserDeletion tracks the removal of a user's data across services, it is kept
+// after completion as a tombstone holding no personal data
+type UserDeletion struct {
+	UserId      string             `dynamodbav:"user_id"`
+	Status      UserDeletionStatus `dynamodbav:"status"`
+	Steps       map[string]bool    `dynamodbav:"steps"`
+	RequestedAt time.Time          `dynamodbav:"requested_at"`
+	CompletedAt *time.Time         `dynamodbav:"completed_at,omitempty"`
+
+	PK string `dynamodbav:"PK"`
+	SK string `dynamodbav:"SK"`
+}
+
+func DeletionSteps() []string {
+	return []string{
+		DeletionStepUserService,
+		DeletionStepTournamentService,
+		DeletionStepLeaderboardService,
+	}
+}
+
+// PendingSteps returns the services that have not confirmed yet
+func (d *UserDeletion) PendingSteps() []string {
+	pending := make([]string, 0)
+	for _, step := range DeletionSteps() {
+		if !d.Steps[step] {
+			pending = append(pending, step)
+		}
+	}
+	return pending
+}
+
+// Key handlers
+func UserDeletionPK(userId string) string {
+	return fmt.Sprintf("DELETION#%s", userId)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/nats-io/nats.go/jetstream"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -31,3 +32,13 @@ func (p *Publisher) Publish(ctx context.Context, subject string, data []byte) *a
 	}
 	return nil
 }
+
+// PublishWithId publishes a message the stream drops when a message with the same
+// id was published within its duplicate window
+func (p *Publisher) PublishWithId(ctx context.Context, subject, msgId string, data []byte) *apperrors.AppError {
+	_, err := p.client.js.Publish(ctx, subject, data, jetstream.WithMsgID(msgId))
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to publish message")
+	}
+	return nil
+}
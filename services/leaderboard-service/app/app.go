@@ -9,16 +9,20 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/burakmert236/goodswipe-common/auth"
 	"github.com/burakmert236/goodswipe-common/cache"
 	"github.com/burakmert236/goodswipe-common/config"
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	commonevents "github.com/burakmert236/goodswipe-common/events"
 	protogrpc "github.com/burakmert236/goodswipe-common/generated/v1/grpc"
 	"github.com/burakmert236/goodswipe-common/logger"
 	"github.com/burakmert236/goodswipe-common/natsjetstream"
 	"github.com/burakmert236/goodswipe-leaderboard-service/internal/events"
 	"github.com/burakmert236/goodswipe-leaderboard-service/internal/handler"
 	"github.com/burakmert236/goodswipe-leaderboard-service/internal/repository"
+	"github.com/burakmert236/goodswipe-leaderboard-service/internal/scheduler"
 	"github.com/burakmert236/goodswipe-leaderboard-service/internal/service"
+	"github.com/nats-io/nats.go/jetstream"
 )
 
 type App struct {
@@ -29,6 +33,7 @@ type App struct {
 	logger             *logger.Logger
 	leaderboardService service.LeaderboardService
 	eventSubscriber    *events.EventSubscriber
+	scheduler          *scheduler.Scheduler
 
 	cleanup []func() error
 }
@@ -47,7 +52,7 @@ func New(ctx context.Context, cfg *config.Config) (*App, *apperrors.AppError) {
 		return nil, err
 	}
 
-	if err := app.initNATS(); err != nil {
+	if err := app.initNATS(ctx); err != nil {
 		return nil, err
 	}
 
@@ -55,10 +60,18 @@ func New(ctx context.Context, cfg *config.Config) (*App, *apperrors.AppError) {
 		return nil, err
 	}
 
+	if err := app.initMigrations(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := app.initMessaging(ctx); err != nil {
 		return nil, err
 	}
 
+	if err := app.initScheduler(); err != nil {
+		return nil, err
+	}
+
 	return app, nil
 }
 
@@ -78,7 +91,7 @@ func (a *App) initRedis() *apperrors.AppError {
 	return nil
 }
 
-func (a *App) initNATS() *apperrors.AppError {
+func (a *App) initNATS(ctx context.Context) *apperrors.AppError {
 	natsClient, err := natsjetstream.NewClient(&natsjetstream.Config{
 		URL:           a.cfg.NATS.URL,
 		MaxReconnect:  a.cfg.NATS.MaxReconnect,
@@ -90,16 +103,46 @@ func (a *App) initNATS() *apperrors.AppError {
 	}
 
 	a.natsClient = natsClient
+
+	stream := jetstream.StreamConfig{
+		Name:     commonevents.LeaderboardEventsStream,
+		Subjects: []string{commonevents.LeaderboardEventsWildcard},
+	}
+
+	if _, err := a.natsClient.JetStream().CreateOrUpdateStream(ctx, stream); err != nil {
+		a.logger.Error("Failed to create stream",
+			"error", err,
+			"stream", stream.Name,
+		)
+		return apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to create jetstream event stream")
+	}
+	a.logger.Info("Stream ready", "stream", stream.Name)
+
 	a.cleanup = append(a.cleanup, natsClient.Close)
 
 	return nil
 }
 
 func (a *App) initMessaging(ctx context.Context) *apperrors.AppError {
-	a.eventSubscriber = events.NewEventSubscriber(a.natsClient, a.leaderboardService, a.logger)
+	eventPublisher := events.NewEventPublisher(a.natsClient, a.logger)
+	a.eventSubscriber = events.NewEventSubscriber(a.natsClient, a.leaderboardService, eventPublisher, a.logger)
 	return a.eventSubscriber.Start(ctx)
 }
 
+// initMigrations runs before the subscribers start, so no event is applied to
+// leaderboards that are not migrated yet
+func (a *App) initMigrations(ctx context.Context) *apperrors.AppError {
+	return a.leaderboardService.MigrateLeaderboards(ctx)
+}
+
+func (a *App) initScheduler() *apperrors.AppError {
+	a.scheduler = scheduler.NewScheduler(a.leaderboardService)
+
+	a.cleanup = append(a.cleanup, a.scheduler.Stop)
+
+	return nil
+}
+
 func (a *App) initGRPC() *apperrors.AppError {
 	leaderboardRepo := repository.NewLeaderboardRepository(a.redisClient, a.logger)
 
@@ -110,8 +153,17 @@ func (a *App) initGRPC() *apperrors.AppError {
 
 	leaderboardHandler := handler.NewLeaderboardHandler(a.leaderboardService, a.logger)
 
+	interceptors := []grpc.UnaryServerInterceptor{a.loggingInterceptor}
+	if a.cfg.Auth.Enabled {
+		authenticator, err := auth.NewAuthenticator(a.cfg.Auth, a.cfg.Server.ServiceCredentials)
+		if err != nil {
+			return err
+		}
+		interceptors = append(interceptors, auth.UnaryServerInterceptor(authenticator, methodPolicies))
+	}
+
 	a.grpcServer = grpc.NewServer(
-		grpc.UnaryInterceptor(a.loggingInterceptor),
+		grpc.ChainUnaryInterceptor(interceptors...),
 	)
 
 	protogrpc.RegisterLeaderboardServiceServer(a.grpcServer, leaderboardHandler)
@@ -127,6 +179,9 @@ func (a *App) Start() *apperrors.AppError {
 			a.logger.Fatal("Failed to listen: %v", err)
 		}
 
+		go a.scheduler.Start()
+		a.logger.Info("Leaderboard archive scheduler is started")
+
 		a.logger.Info(fmt.Sprintf("gRPC server listening on %d", a.cfg.Server.GRPCPort))
 		if err := a.grpcServer.Serve(lis); err != nil {
 			a.logger.Fatal("Failed to serve: %v", err)
@@ -161,3 +216,12 @@ func (a *App) loggingInterceptor(ctx context.Context, req interface{}, info *grp
 	a.logger.Info(fmt.Sprintf("Method: %s, Duration: %v", info.FullMethod, time.Since(start)))
 	return resp, err
 }
+
+var methodPolicies = auth.MethodPolicies{
+	protogrpc.LeaderboardService_GetGlobalLeaderboard_FullMethodName:     auth.Public(),
+	protogrpc.LeaderboardService_GetSeasonLeaderboard_FullMethodName:     auth.Public(),
+	protogrpc.LeaderboardService_GetRegionalLeaderboard_FullMethodName:   auth.Public(),
+	protogrpc.LeaderboardService_GetTournamentLeaderboard_FullMethodName: auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.LeaderboardService_GetTournamentRank_FullMethodName:        auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin).AndServices(auth.ServiceTournament),
+	protogrpc.LeaderboardService_GetFriendsLeaderboard_FullMethodName:    auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+}
@@ -1,7 +1,23 @@
 package errors
 
-import apperrors "github.com/burakmert236/goodswipe-common/errors"
+import (
+	"fmt"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
 
 func UserNotExistsInAnyGroup() *apperrors.AppError {
 	return apperrors.New(apperrors.CodeNotFound, "user doesn't exists in any group og this tournament")
 }
+
+func UserNotOnLeaderboardError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeNotFound, "user is not ranked on this leaderboard")
+}
+
+func InvalidCountryError(country string) *apperrors.AppError {
+	return apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("country must be an ISO 3166-1 alpha-2 code: %s", country))
+}
+
+func InvalidPageTokenError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeInvalidInput, "invalid page token")
+}
@@ -0,0 +1,95 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	commonevents "github.com/burakmert236/goodswipe-common/events"
+	protoevents "github.com/burakmert236/goodswipe-common/generated/v1/events"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/natsjetstream"
+)
+
+type EventPublisher struct {
+	publisher *natsjetstream.Publisher
+	logger    *logger.Logger
+}
+
+func NewEventPublisher(client *natsjetstream.Client, logger *logger.Logger) *EventPublisher {
+	return &EventPublisher{
+		publisher: natsjetstream.NewPublisher(client),
+		logger:    logger,
+	}
+}
+
+func (p *EventPublisher) PublishUserDataPurged(ctx context.Context, userId, service string) *apperrors.AppError {
+	event := &protoevents.UserDataPurged{
+		UserId:    userId,
+		Service:   service,
+		TimeStamp: time.Now().UTC().Unix(),
+	}
+
+	if err := p.publisher.PublishProto(ctx, commonevents.UserDataPurged, event); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to publish user data purged event: %v", err))
+		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish user data purged event")
+	}
+
+	p.logger.Info(fmt.Sprintf("Published user data purged event for user: %s", userId))
+	return nil
+}
+
+// PublishTournamentStandingFinalized carries the time the tournament finished,
+// so a republished standing is the same event
+func (p *EventPublisher) PublishTournamentStandingFinalized(
+	ctx context.Context,
+	tournamentId, groupId, userId string,
+	rank, score, reward int,
+	currency string,
+	finishedAt int64,
+) *apperrors.AppError {
+	event := &protoevents.TournamentStandingFinalized{
+		UserId:       userId,
+		TournamentId: tournamentId,
+		GroupId:      groupId,
+		Rank:         int32(rank),
+		Score:        int32(score),
+		Reward:       int32(reward),
+		Currency:     currency,
+		TimeStamp:    finishedAt,
+	}
+
+	if err := p.publisher.PublishProto(ctx, commonevents.LeaderboardTournamentStandingFinalized, event); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to publish tournament standing finalized event: %v", err))
+		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish tournament standing finalized event")
+	}
+
+	p.logger.Info(fmt.Sprintf("Published tournament standing finalized event for user: %s", userId))
+	return nil
+}
+
+func (p *EventPublisher) PublishGroupRankOvertaken(
+	ctx context.Context,
+	tournamentId, groupId, userId string,
+	overtakenById, overtakenByDisplayName string,
+	rank int,
+) *apperrors.AppError {
+	event := &protoevents.GroupRankOvertaken{
+		UserId:                 userId,
+		TournamentId:           tournamentId,
+		GroupId:                groupId,
+		OvertakenById:          overtakenById,
+		OvertakenByDisplayName: overtakenByDisplayName,
+		Rank:                   int32(rank),
+		TimeStamp:              time.Now().UTC().Unix(),
+	}
+
+	if err := p.publisher.PublishProto(ctx, commonevents.LeaderboardGroupRankOvertaken, event); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to publish group rank overtaken event: %v", err))
+		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish group rank overtaken event")
+	}
+
+	p.logger.Info(fmt.Sprintf("Published group rank overtaken event for user: %s", userId))
+	return nil
+}
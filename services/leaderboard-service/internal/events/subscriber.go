@@ -2,33 +2,57 @@ package events
 
 import (
 	"context"
+	"time"
 
 	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
 
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
 	commonevents "github.com/burakmert236/goodswipe-common/events"
 	protoevents "github.com/burakmert236/goodswipe-common/generated/v1/events"
 	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
 	"github.com/burakmert236/goodswipe-common/natsjetstream"
 	"github.com/burakmert236/goodswipe-leaderboard-service/internal/service"
 )
 
+// userEvent is an event that changes the leaderboard data of its users
+type userEvent interface {
+	proto.Message
+	GetUserId() string
+}
+
+// userEvents lists the subjects whose events are dropped when one of their users
+// was deleted
+var userEvents = map[string]func() userEvent{
+	commonevents.UserCreated:                         func() userEvent { return &protoevents.UserCreated{} },
+	commonevents.UserDisplayNameChanged:              func() userEvent { return &protoevents.UserDisplayNameChanged{} },
+	commonevents.UserCountryChanged:                  func() userEvent { return &protoevents.UserCountryChanged{} },
+	commonevents.UserFriendAdded:                     func() userEvent { return &protoevents.UserFriendAdded{} },
+	commonevents.UserModerationChanged:               func() userEvent { return &protoevents.UserModerationChanged{} },
+	commonevents.TournamentEntered:                   func() userEvent { return &protoevents.TournamentEntered{} },
+	commonevents.TournamentParticipationScoreUpdated: func() userEvent { return &protoevents.TournamentParticipationScoreUpdated{} },
+}
+
 type EventSubscriber struct {
 	natsClient         *natsjetstream.Client
 	subscriber         *natsjetstream.Subscriber
 	leaderboardService service.LeaderboardService
+	publisher          *EventPublisher
 	logger             *logger.Logger
 }
 
 func NewEventSubscriber(
 	natsClient *natsjetstream.Client,
 	leaderboardService service.LeaderboardService,
+	publisher *EventPublisher,
 	logger *logger.Logger,
 ) *EventSubscriber {
 	return &EventSubscriber{
 		natsClient:         natsClient,
 		subscriber:         natsjetstream.NewSubscriber(natsClient),
 		leaderboardService: leaderboardService,
+		publisher:          publisher,
 		logger:             logger.With("component", "event-subscriber"),
 	}
 }
@@ -61,7 +85,7 @@ func (s *EventSubscriber) subscribeToUserEvents(ctx context.Context) *apperrors.
 		"consumer", cfg.ConsumerName,
 	)
 
-	return s.subscriber.Subscribe(ctx, cfg, s.handleUserEvents)
+	return s.subscriber.Subscribe(ctx, cfg, s.skipDeletedUsers(s.handleUserEvents))
 }
 
 func (s *EventSubscriber) subscribeToTournamentEvents(ctx context.Context) *apperrors.AppError {
@@ -77,7 +101,44 @@ func (s *EventSubscriber) subscribeToTournamentEvents(ctx context.Context) *appe
 		"consumer", cfg.ConsumerName,
 	)
 
-	return s.subscriber.Subscribe(ctx, cfg, s.handleTournamentEvents)
+	return s.subscriber.Subscribe(ctx, cfg, s.skipDeletedUsers(s.handleTournamentEvents))
+}
+
+// skipDeletedUsers drops the events of deleted users before they reach handle.
+// Late or redelivered events would bring their data back. Events that do not
+// decode are left to handle.
+func (s *EventSubscriber) skipDeletedUsers(handle natsjetstream.MessageHandler) natsjetstream.MessageHandler {
+	return func(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+		newEvent, ok := userEvents[msg.Subject()]
+		if !ok {
+			return handle(ctx, msg)
+		}
+
+		event := newEvent()
+		if err := natsjetstream.UnmarshalProto(msg, event); err != nil {
+			return handle(ctx, msg)
+		}
+
+		userIds := []string{event.GetUserId()}
+		if friendship, ok := event.(*protoevents.UserFriendAdded); ok {
+			userIds = append(userIds, friendship.FriendId)
+		}
+
+		deleted, err := s.leaderboardService.IsAnyUserDeleted(ctx, userIds...)
+		if err != nil {
+			return err
+		}
+
+		if deleted {
+			s.logger.Info("Dropping event for deleted user",
+				"subject", msg.Subject(),
+				"user_ids", userIds,
+			)
+			return nil
+		}
+
+		return handle(ctx, msg)
+	}
 }
 
 func (s *EventSubscriber) handleUserEvents(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
@@ -88,6 +149,18 @@ func (s *EventSubscriber) handleUserEvents(ctx context.Context, msg jetstream.Ms
 	switch subject {
 	case commonevents.UserCreated:
 		return s.handleUserCreated(ctx, msg)
+	case commonevents.UserDisplayNameChanged:
+		return s.handleUserDisplayNameChanged(ctx, msg)
+	case commonevents.UserCountryChanged:
+		return s.handleUserCountryChanged(ctx, msg)
+	case commonevents.UserDeleted:
+		return s.handleUserDeleted(ctx, msg)
+	case commonevents.UserFriendAdded:
+		return s.handleUserFriendAdded(ctx, msg)
+	case commonevents.UserFriendRemoved:
+		return s.handleUserFriendRemoved(ctx, msg)
+	case commonevents.UserModerationChanged:
+		return s.handleUserModerationChanged(ctx, msg)
 	default:
 		s.logger.Warn("Unknown user event subject", "subject", subject)
 		return nil
@@ -104,6 +177,11 @@ func (s *EventSubscriber) handleTournamentEvents(ctx context.Context, msg jetstr
 		return s.handleTournamentEntered(ctx, msg)
 	case commonevents.TournamentParticipationScoreUpdated:
 		return s.handleTournamentParticipationScoreUpdated(ctx, msg)
+	case commonevents.TournamentFinished:
+		return s.handleTournamentFinished(ctx, msg)
+	case commonevents.TournamentRewardClaimed:
+		// Claims do not change any standings
+		return nil
 	default:
 		s.logger.Warn("Unknown tournament event subject", "subject", subject)
 		return nil
@@ -119,9 +197,10 @@ func (s *EventSubscriber) handleUserCreated(ctx context.Context, msg jetstream.M
 	s.logger.Info("Processing user created event",
 		"user_id", event.UserId,
 		"display_name", event.DisplayName,
+		"country", event.Country,
 	)
 
-	if err := s.leaderboardService.AddGlobalUser(ctx, event.UserId, event.DisplayName); err != nil {
+	if err := s.leaderboardService.AddGlobalUser(ctx, event.UserId, event.DisplayName, event.Country); err != nil {
 		return err
 	}
 
@@ -130,6 +209,112 @@ func (s *EventSubscriber) handleUserCreated(ctx context.Context, msg jetstream.M
 	return nil
 }
 
+func (s *EventSubscriber) handleUserDisplayNameChanged(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserDisplayNameChanged
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Processing user display name changed event",
+		"user_id", event.UserId,
+		"display_name", event.DisplayName,
+	)
+
+	if err := s.leaderboardService.UpdateDisplayName(ctx, event.UserId, event.DisplayName); err != nil {
+		return err
+	}
+
+	s.logger.Info("User display name changed event processed successfully")
+
+	return nil
+}
+
+func (s *EventSubscriber) handleUserCountryChanged(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserCountryChanged
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Processing user country changed event",
+		"user_id", event.UserId,
+		"country", event.Country,
+	)
+
+	if err := s.leaderboardService.UpdateCountry(ctx, event.UserId, event.Country); err != nil {
+		return err
+	}
+
+	s.logger.Info("User country changed event processed successfully")
+
+	return nil
+}
+
+func (s *EventSubscriber) handleUserDeleted(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserDeleted
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Processing user deleted event", "user_id", event.UserId)
+
+	if err := s.leaderboardService.RemoveUser(ctx, event.UserId); err != nil {
+		return err
+	}
+
+	if err := s.publisher.PublishUserDataPurged(ctx, event.UserId, models.DeletionStepLeaderboardService); err != nil {
+		return err
+	}
+
+	s.logger.Info("User deleted event processed successfully")
+
+	return nil
+}
+
+func (s *EventSubscriber) handleUserFriendAdded(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserFriendAdded
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Processing user friend added event",
+		"user_id", event.UserId,
+		"friend_id", event.FriendId,
+	)
+
+	return s.leaderboardService.AddFriendship(ctx, event.UserId, event.FriendId)
+}
+
+func (s *EventSubscriber) handleUserFriendRemoved(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserFriendRemoved
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Processing user friend removed event",
+		"user_id", event.UserId,
+		"friend_id", event.FriendId,
+	)
+
+	return s.leaderboardService.RemoveFriendship(ctx, event.UserId, event.FriendId)
+}
+
+// handleUserModerationChanged hides banned users from the leaderboards, suspended
+// users stay visible
+func (s *EventSubscriber) handleUserModerationChanged(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserModerationChanged
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Processing user moderation changed event",
+		"user_id", event.UserId,
+		"status", event.Status,
+	)
+
+	banned := models.ModerationStatus(event.Status) == models.ModerationStatusBanned
+	return s.leaderboardService.SetUserBanned(ctx, event.UserId, banned)
+}
+
 func (s *EventSubscriber) handleTournamentEntered(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
 	var event protoevents.TournamentEntered
 	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
@@ -159,11 +344,104 @@ func (s *EventSubscriber) handleTournamentParticipationScoreUpdated(ctx context.
 		"user_id", event.UserId,
 	)
 
-	if err := s.leaderboardService.UpdateTournamentScore(ctx, event.UserId, event.TournamentId, int(event.NewScore)); err != nil {
+	// Points count towards the windows the score was made in, also when the
+	// event arrives late
+	scoredAt := time.Now()
+	if event.TimeStamp > 0 {
+		scoredAt = time.Unix(event.TimeStamp, 0)
+	}
+
+	update, err := s.leaderboardService.UpdateTournamentScore(ctx, event.UserId, event.TournamentId, int(event.NewScore), scoredAt)
+	if err != nil {
 		return err
 	}
 
+	// The score is already stored and a redelivery would not find anyone to pass
+	// again, a failed notification is logged and not returned
+	for _, overtaken := range update.Overtaken {
+		if err := s.publisher.PublishGroupRankOvertaken(
+			ctx,
+			event.TournamentId,
+			update.GroupId,
+			overtaken.UserId,
+			event.UserId,
+			update.DisplayName,
+			int(overtaken.Rank),
+		); err != nil {
+			s.logger.Warn("Failed to announce overtaken group member",
+				"error", err,
+				"user_id", overtaken.UserId,
+				"tournament_id", event.TournamentId,
+			)
+		}
+	}
+
 	s.logger.Info("Tournament participation score updated event processed successfully")
 
 	return nil
 }
+
+func (s *EventSubscriber) handleTournamentFinished(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.TournamentFinished
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Processing tournament finished event",
+		"tournament_id", event.TournamentId,
+		"season_id", event.SeasonId,
+	)
+
+	if event.SeasonId != "" {
+		pointsMap := make(map[string]int, len(event.SeasonPointsMap))
+		for placement, points := range event.SeasonPointsMap {
+			pointsMap[placement] = int(points)
+		}
+
+		if err := s.leaderboardService.AwardSeasonPoints(ctx, event.TournamentId, event.SeasonId, pointsMap); err != nil {
+			return err
+		}
+	}
+
+	if err := s.publishTournamentStandings(ctx, &event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Tournament finished event processed successfully")
+
+	return nil
+}
+
+// publishTournamentStandings announces the final placement of every human player.
+// Season points are awarded once per group, so a redelivered event only repeats
+// the announcements.
+func (s *EventSubscriber) publishTournamentStandings(ctx context.Context, event *protoevents.TournamentFinished) *apperrors.AppError {
+	standings, err := s.leaderboardService.GetTournamentStandings(ctx, event.TournamentId)
+	if err != nil {
+		return err
+	}
+
+	rewardingMap := make(map[string]int, len(event.RewardingMap))
+	for placement, reward := range event.RewardingMap {
+		rewardingMap[placement] = int(reward)
+	}
+
+	for _, standing := range standings {
+		rank := int(standing.Rank)
+		if err := s.publisher.PublishTournamentStandingFinalized(
+			ctx,
+			event.TournamentId,
+			standing.GroupId,
+			standing.UserId,
+			rank,
+			int(standing.Score),
+			models.RankReward(rank, rewardingMap),
+			event.RewardCurrency,
+			event.TimeStamp,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,9 +3,12 @@ package handler
 import (
 	"context"
 
+	"github.com/burakmert236/goodswipe-common/auth"
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
 	proto "github.com/burakmert236/goodswipe-common/generated/v1/grpc"
 	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-leaderboard-service/internal/period"
+	"github.com/burakmert236/goodswipe-leaderboard-service/internal/repository"
 	"github.com/burakmert236/goodswipe-leaderboard-service/internal/service"
 )
 
@@ -26,60 +29,179 @@ func (h *LeaderboardHandler) GetGlobalLeaderboard(
 	ctx context.Context,
 	req *proto.GetGlobalLeaderboardRequest,
 ) (*proto.GetGlobalLeaderboardResponse, error) {
-	leaderboard, err := h.leaderboardService.GetGlobalLeaderboard(ctx)
+	// The global leaderboard is public, a user is only needed around them
+	var userId string
+	if req.AroundMe > 0 {
+		var err *apperrors.AppError
+		userId, err = auth.ResolveUserId(ctx, req.UserId)
+		if err != nil {
+			return nil, apperrors.ToGRPCError(err)
+		}
+	}
+
+	leaderboardPeriod, err := period.Parse(req.Period)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
 
-	responseUsers := make([]*proto.UserInfo, len(leaderboard))
-	for i, entry := range leaderboard {
-		responseUsers[i] = &proto.UserInfo{
-			UserId:      entry.UserId,
-			DisplayName: entry.DisplayName,
-			Score:       int64(entry.Score),
+	leaderboardPage, err := h.leaderboardService.GetGlobalLeaderboard(ctx, userId, leaderboardPeriod, req.PeriodId, repository.PageRequest{
+		Offset:    int64(req.Offset),
+		Limit:     int64(req.Limit),
+		PageToken: req.PageToken,
+		AroundMe:  int64(req.AroundMe),
+	})
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.GetGlobalLeaderboardResponse{
+		Users:         userInfoList(leaderboardPage.Entries),
+		NextPageToken: leaderboardPage.NextPageToken,
+		PeriodId:      leaderboardPage.PeriodId,
+	}, nil
+}
+
+func (h *LeaderboardHandler) GetRegionalLeaderboard(
+	ctx context.Context,
+	req *proto.GetRegionalLeaderboardRequest,
+) (*proto.GetRegionalLeaderboardResponse, error) {
+	if req.Country == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "country is required"))
+	}
+
+	// Regional leaderboards are public like the global one
+	var userId string
+	if req.AroundMe > 0 {
+		var err *apperrors.AppError
+		userId, err = auth.ResolveUserId(ctx, req.UserId)
+		if err != nil {
+			return nil, apperrors.ToGRPCError(err)
 		}
 	}
 
-	return &proto.GetGlobalLeaderboardResponse{Users: responseUsers}, nil
+	leaderboardPeriod, err := period.Parse(req.Period)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	leaderboardPage, err := h.leaderboardService.GetRegionalLeaderboard(ctx, userId, req.Country, leaderboardPeriod, repository.PageRequest{
+		Offset:    int64(req.Offset),
+		Limit:     int64(req.Limit),
+		PageToken: req.PageToken,
+		AroundMe:  int64(req.AroundMe),
+	})
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.GetRegionalLeaderboardResponse{
+		Users:         userInfoList(leaderboardPage.Entries),
+		NextPageToken: leaderboardPage.NextPageToken,
+		PeriodId:      leaderboardPage.PeriodId,
+	}, nil
 }
 
 func (h *LeaderboardHandler) GetTournamentLeaderboard(
 	ctx context.Context,
 	req *proto.GetTournamentLeaderboardRequest,
 ) (*proto.GetTournamentLeaderboardResponse, error) {
-	if req.UserId == "" || req.TournamentId == "" {
-		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id and tournament id is required"))
-	}
-
-	leaderboard, err := h.leaderboardService.GetTournamentLeaderboard(ctx, req.UserId, req.TournamentId)
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
 
-	responseUsers := make([]*proto.UserInfo, len(leaderboard))
-	for i, entry := range leaderboard {
-		responseUsers[i] = &proto.UserInfo{
-			UserId:      entry.UserId,
-			DisplayName: entry.DisplayName,
-			Score:       int64(entry.Score),
-		}
+	if req.TournamentId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "tournament id is required"))
+	}
+
+	leaderboardPage, err := h.leaderboardService.GetTournamentLeaderboard(ctx, userId, req.TournamentId, repository.PageRequest{
+		Offset:    int64(req.Offset),
+		Limit:     int64(req.Limit),
+		PageToken: req.PageToken,
+		AroundMe:  int64(req.AroundMe),
+	})
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
 	}
 
-	return &proto.GetTournamentLeaderboardResponse{Users: responseUsers}, nil
+	return &proto.GetTournamentLeaderboardResponse{
+		Users:         userInfoList(leaderboardPage.Entries),
+		NextPageToken: leaderboardPage.NextPageToken,
+	}, nil
 }
 
 func (h *LeaderboardHandler) GetTournamentRank(
 	ctx context.Context,
 	req *proto.GetTournamentRankRequest,
 ) (*proto.GetTournamentRankResponse, error) {
-	if req.UserId == "" || req.TournamentId == "" {
-		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id and tournament id is required"))
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	if req.TournamentId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "tournament id is required"))
 	}
 
-	rank, err := h.leaderboardService.GetTournamentRank(ctx, req.UserId, req.TournamentId)
+	rank, err := h.leaderboardService.GetTournamentRank(ctx, userId, req.TournamentId, req.ExcludeBots)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
 
 	return &proto.GetTournamentRankResponse{Rank: int32(rank)}, nil
 }
+
+func (h *LeaderboardHandler) GetSeasonLeaderboard(
+	ctx context.Context,
+	req *proto.GetSeasonLeaderboardRequest,
+) (*proto.GetSeasonLeaderboardResponse, error) {
+	if req.SeasonId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "season id is required"))
+	}
+
+	leaderboard, err := h.leaderboardService.GetSeasonLeaderboard(ctx, req.SeasonId, int(req.Limit))
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	appliedTournamentIds, err := h.leaderboardService.GetSeasonAppliedTournaments(ctx, req.SeasonId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.GetSeasonLeaderboardResponse{
+		Users:                userInfoList(leaderboard),
+		AppliedTournamentIds: appliedTournamentIds,
+	}, nil
+}
+
+func (h *LeaderboardHandler) GetFriendsLeaderboard(
+	ctx context.Context,
+	req *proto.GetFriendsLeaderboardRequest,
+) (*proto.GetFriendsLeaderboardResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	leaderboard, err := h.leaderboardService.GetFriendsLeaderboard(ctx, userId, req.TournamentId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.GetFriendsLeaderboardResponse{Users: userInfoList(leaderboard)}, nil
+}
+
+func userInfoList(entries []repository.LeaderboardEntry) []*proto.UserInfo {
+	users := make([]*proto.UserInfo, len(entries))
+	for i, entry := range entries {
+		users[i] = &proto.UserInfo{
+			UserId:      entry.UserId,
+			DisplayName: entry.DisplayName,
+			Score:       int64(entry.Score),
+			IsBot:       entry.IsBot,
+			Rank:        entry.Rank,
+		}
+	}
+	return users
+}
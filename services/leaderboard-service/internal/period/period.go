@@ -0,0 +1,128 @@
+package period
+
+import (
+	"fmt"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
+
+// Period is the time window a global leaderboard ranks. Windows follow UTC
+// calendar days, ISO weeks and calendar months.
+type Period string
+
+const (
+	AllTime Period = "ALL_TIME"
+	Daily   Period = "DAILY"
+	Weekly  Period = "WEEKLY"
+	Monthly Period = "MONTHLY"
+)
+
+// Windowed are the periods that roll over, all-time never closes
+var Windowed = []Period{Daily, Weekly, Monthly}
+
+const (
+	dayLayout   = "2006-01-02"
+	monthLayout = "2006-01"
+)
+
+// Parse returns the period of the given name, empty means all-time
+func Parse(value string) (Period, *apperrors.AppError) {
+	switch Period(value) {
+	case "", AllTime:
+		return AllTime, nil
+	case Daily, Weekly, Monthly:
+		return Period(value), nil
+	}
+	return "", apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("unsupported leaderboard period: %s", value))
+}
+
+// Id names the window of the period that contains the given time, e.g.
+// 2026-10-18, 2026-W42 or 2026-10
+func (p Period) Id(t time.Time) string {
+	t = t.UTC()
+
+	switch p {
+	case Daily:
+		return t.Format(dayLayout)
+	case Weekly:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case Monthly:
+		return t.Format(monthLayout)
+	}
+	return ""
+}
+
+// Start returns the beginning of the window that contains the given time
+func (p Period) Start(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch p {
+	case Weekly:
+		// ISO weeks start on Monday
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case Monthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return day
+}
+
+// PreviousId names the window that closed last before the given time
+func (p Period) PreviousId(t time.Time) string {
+	return p.Id(p.Start(t).Add(-time.Nanosecond))
+}
+
+// RetainedClosedIds names the closed windows whose live leaderboard is still
+// kept at the given time, the latest first
+func (p Period) RetainedClosedIds(t time.Time) []string {
+	ids := make([]string, 0)
+
+	end := p.Start(t)
+	for t.Sub(end) < p.Retention() {
+		start := p.Start(end.Add(-time.Nanosecond))
+		ids = append(ids, p.Id(start))
+		end = start
+	}
+
+	return ids
+}
+
+// ValidateId checks that the id names a window of the period
+func (p Period) ValidateId(id string) *apperrors.AppError {
+	var valid bool
+
+	switch p {
+	case Daily:
+		_, err := time.Parse(dayLayout, id)
+		valid = err == nil
+	case Weekly:
+		var year, week int
+		if _, err := fmt.Sscanf(id, "%d-W%d", &year, &week); err == nil && week >= 1 && week <= 53 {
+			valid = id == fmt.Sprintf("%d-W%02d", year, week)
+		}
+	case Monthly:
+		_, err := time.Parse(monthLayout, id)
+		valid = err == nil
+	}
+
+	if !valid {
+		return apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("invalid %s period id: %s", p, id))
+	}
+	return nil
+}
+
+// Retention is how long the live leaderboard of a window is kept. It outlasts the
+// window so the closed window can still be archived.
+func (p Period) Retention() time.Duration {
+	switch p {
+	case Daily:
+		return 3 * 24 * time.Hour
+	case Weekly:
+		return 14 * 24 * time.Hour
+	case Monthly:
+		return 62 * 24 * time.Hour
+	}
+	return 0
+}
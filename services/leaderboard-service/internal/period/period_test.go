@@ -0,0 +1,114 @@
+package period
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestId(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		at     string
+		want   string
+	}{
+		{name: "day", period: Daily, at: "2026-10-18T12:00:00Z", want: "2026-10-18"},
+		{name: "last moment of the year", period: Daily, at: "2026-12-31T23:59:59Z", want: "2026-12-31"},
+		{name: "day in UTC", period: Daily, at: "2027-01-01T01:00:00+03:00", want: "2026-12-31"},
+		{name: "week", period: Weekly, at: "2026-10-18T12:00:00Z", want: "2026-W42"},
+		{name: "first week in the previous year", period: Weekly, at: "2024-12-30T00:00:00Z", want: "2025-W01"},
+		{name: "week 53 in the next year", period: Weekly, at: "2027-01-01T00:00:00Z", want: "2026-W53"},
+		{name: "week 53 on sunday", period: Weekly, at: "2021-01-03T23:59:59Z", want: "2020-W53"},
+		{name: "month", period: Monthly, at: "2026-10-18T12:00:00Z", want: "2026-10"},
+		{name: "last month of the year", period: Monthly, at: "2026-12-31T23:59:59Z", want: "2026-12"},
+		{name: "all time", period: AllTime, at: "2026-10-18T12:00:00Z", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Id(date(tt.at)); got != tt.want {
+				t.Errorf("%s.Id(%s) = %q, want %q", tt.period, tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		at     string
+		want   string
+	}{
+		{name: "day", period: Daily, at: "2026-10-18T12:34:56Z", want: "2026-10-18T00:00:00Z"},
+		{name: "week from sunday", period: Weekly, at: "2026-10-18T12:00:00Z", want: "2026-10-12T00:00:00Z"},
+		{name: "week from monday", period: Weekly, at: "2026-10-12T00:00:00Z", want: "2026-10-12T00:00:00Z"},
+		{name: "week across the year", period: Weekly, at: "2027-01-01T12:00:00Z", want: "2026-12-28T00:00:00Z"},
+		{name: "first week in the previous year", period: Weekly, at: "2025-01-01T12:00:00Z", want: "2024-12-30T00:00:00Z"},
+		{name: "month", period: Monthly, at: "2027-01-15T12:00:00Z", want: "2027-01-01T00:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Start(date(tt.at)); !got.Equal(date(tt.want)) {
+				t.Errorf("%s.Start(%s) = %s, want %s", tt.period, tt.at, got.Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
+
+func TestPreviousId(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		at     string
+		want   string
+	}{
+		{name: "day", period: Daily, at: "2026-10-18T12:00:00Z", want: "2026-10-17"},
+		{name: "day across the year", period: Daily, at: "2027-01-01T00:00:00Z", want: "2026-12-31"},
+		{name: "week 53", period: Weekly, at: "2027-01-04T00:00:00Z", want: "2026-W53"},
+		{name: "week before the first week", period: Weekly, at: "2025-01-01T12:00:00Z", want: "2024-W52"},
+		{name: "month across the year", period: Monthly, at: "2027-01-15T12:00:00Z", want: "2026-12"},
+		{name: "month at its first moment", period: Monthly, at: "2027-03-01T00:00:00Z", want: "2027-02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.PreviousId(date(tt.at)); got != tt.want {
+				t.Errorf("%s.PreviousId(%s) = %q, want %q", tt.period, tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetainedClosedIds(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		at     string
+		want   []string
+	}{
+		{name: "days across the year", period: Daily, at: "2027-01-01T12:00:00Z", want: []string{"2026-12-31", "2026-12-30", "2026-12-29"}},
+		{name: "days at midnight", period: Daily, at: "2027-01-01T00:00:00Z", want: []string{"2026-12-31", "2026-12-30", "2026-12-29"}},
+		{name: "weeks across the year", period: Weekly, at: "2027-01-06T00:00:00Z", want: []string{"2026-W53", "2026-W52"}},
+		{name: "months across the year", period: Monthly, at: "2027-01-10T00:00:00Z", want: []string{"2026-12", "2026-11"}},
+		{name: "all time never closes", period: AllTime, at: "2027-01-10T00:00:00Z", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.RetainedClosedIds(date(tt.at)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s.RetainedClosedIds(%s) = %v, want %v", tt.period, tt.at, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,21 +2,109 @@ package repository
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/burakmert236/goodswipe-common/cache"
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
 	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
 	leaderboarderrors "github.com/burakmert236/goodswipe-leaderboard-service/internal/errors"
+	"github.com/burakmert236/goodswipe-leaderboard-service/internal/period"
 	"github.com/redis/go-redis/v9"
 )
 
 const (
 	GlobalLeaderboardLimit = 1000
 	DefaultTTL             = 7 * 24 * time.Hour
+	SeasonTTL              = 90 * 24 * time.Hour
+	ArchiveTTL             = 365 * 24 * time.Hour
 )
 
+// applyTournamentScore stores the new group score of a user and adds the change to
+// their points in every further global leaderboard given, in one step. A repeated
+// score adds nothing, so redelivered updates are counted once. A user missing
+// from a group that exists is added with the score. A group that expired since is
+// left as it is and nil returned.
+var applyTournamentScore = redis.NewScript(`
+local current = redis.call('ZSCORE', KEYS[1], ARGV[2])
+if not current then
+	if redis.call('EXISTS', KEYS[1]) == 0 then
+		return false
+	end
+	current = 0
+end
+local previous = tonumber(current)
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+local delta = tonumber(ARGV[1]) - previous
+if delta ~= 0 then
+	for i = 2, #KEYS do
+		redis.call('ZINCRBY', KEYS[i], delta, ARGV[2])
+	end
+end
+return delta
+`)
+
+// awardGroupSeasonPoints marks a group as awarded and adds the season points of its
+// players in one step, so a failed award can be retried and a repeated one adds
+// nothing. ARGV holds the season id, the season ttl in seconds and then user and
+// points pairs.
+var awardGroupSeasonPoints = redis.NewScript(`
+if not redis.call('SET', KEYS[1], ARGV[1], 'NX', 'EX', ARGV[2]) then
+	return 0
+end
+for i = 3, #ARGV, 2 do
+	redis.call('ZINCRBY', KEYS[2], ARGV[i + 1], ARGV[i])
+end
+redis.call('EXPIRE', KEYS[2], ARGV[2])
+return 1
+`)
+
+// hideLeaderboardMember moves a banned user with their points from every
+// leaderboard to its shadow. KEYS[1] records the leaderboards the user was found
+// in, the rest are leaderboard and shadow pairs. A new shadow expires with its
+// leaderboard.
+var hideLeaderboardMember = redis.NewScript(`
+for i = 2, #KEYS, 2 do
+	local score = redis.call('ZSCORE', KEYS[i], ARGV[1])
+	if score then
+		local ttl = redis.call('PTTL', KEYS[i])
+		local existed = redis.call('EXISTS', KEYS[i + 1])
+		redis.call('ZINCRBY', KEYS[i + 1], score, ARGV[1])
+		redis.call('ZREM', KEYS[i], ARGV[1])
+		if existed == 0 and ttl > 0 then
+			redis.call('PEXPIRE', KEYS[i + 1], ttl)
+		end
+		redis.call('SADD', KEYS[1], KEYS[i])
+	end
+end
+return 0
+`)
+
+// restoreLeaderboardMember moves a reinstated user with their points from every
+// shadow back to its leaderboard, KEYS are shadow and leaderboard pairs. Points
+// already on the leaderboard are added to, a recreated leaderboard expires with
+// its shadow.
+var restoreLeaderboardMember = redis.NewScript(`
+for i = 1, #KEYS, 2 do
+	local score = redis.call('ZSCORE', KEYS[i], ARGV[1])
+	if score then
+		local ttl = redis.call('PTTL', KEYS[i])
+		local existed = redis.call('EXISTS', KEYS[i + 1])
+		redis.call('ZINCRBY', KEYS[i + 1], score, ARGV[1])
+		redis.call('ZREM', KEYS[i], ARGV[1])
+		if existed == 0 and ttl > 0 then
+			redis.call('PEXPIRE', KEYS[i + 1], ttl)
+		end
+	end
+end
+return 0
+`)
+
 type LeaderboardRepository struct {
 	client *redis.Client
 	logger *logger.Logger
@@ -32,6 +120,12 @@ func NewLeaderboardRepository(redisClient *cache.RedisClient, log *logger.Logger
 // Key Generation (Private Helpers)
 
 func globalLeaderboardKey() string {
+	return "leaderboard:global:lifetime"
+}
+
+// legacyGlobalLeaderboardKey held the last tournament score of every user, with
+// an expiry
+func legacyGlobalLeaderboardKey() string {
 	return "leaderboard:global"
 }
 
@@ -39,6 +133,10 @@ func usernamesHashKey() string {
 	return "usernames"
 }
 
+func userCountriesHashKey() string {
+	return "user:country"
+}
+
 func userGroupMappingsHashKey() string {
 	return "user:group"
 }
@@ -51,24 +149,93 @@ func userTournamentField(userId, tournamentId string) string {
 	return fmt.Sprintf("%s:%s", userId, tournamentId)
 }
 
+func tournamentGroupsKey(tournamentId string) string {
+	return fmt.Sprintf("tournament:groups:%s", tournamentId)
+}
+
+func seasonLeaderboardKey(seasonId string) string {
+	return fmt.Sprintf("leaderboard:season:%s", seasonId)
+}
+
+func seasonAwardedGroupKey(tournamentId, groupId string) string {
+	return fmt.Sprintf("season:awarded:%s:%s", tournamentId, groupId)
+}
+
+func seasonAppliedTournamentsKey(seasonId string) string {
+	return fmt.Sprintf("season:applied:%s", seasonId)
+}
+
+func friendsKey(userId string) string {
+	return fmt.Sprintf("friends:%s", userId)
+}
+
+func bannedUsersKey() string {
+	return "leaderboard:banned"
+}
+
+// bannedUserLeaderboardsKey holds the leaderboards a banned user was moved out of
+func bannedUserLeaderboardsKey(userId string) string {
+	return fmt.Sprintf("leaderboard:banned:%s", userId)
+}
+
+// shadowLeaderboardKey keeps the points of banned users next to the leaderboard
+// at key, without ranking them there
+func shadowLeaderboardKey(key string) string {
+	return fmt.Sprintf("shadow:%s", key)
+}
+
+// deletedUsersKey holds the users whose data was removed, events that arrive for
+// them later are dropped
+func deletedUsersKey() string {
+	return "leaderboard:deleted"
+}
+
+func migrationKey(name string) string {
+	return fmt.Sprintf("leaderboard:migration:%s", name)
+}
+
+func periodLeaderboardKey(p period.Period, periodId string) string {
+	return fmt.Sprintf("leaderboard:global:%s:%s", strings.ToLower(string(p)), periodId)
+}
+
+func countryLeaderboardKey(country string) string {
+	return fmt.Sprintf("leaderboard:country:%s", country)
+}
+
+func countryPeriodLeaderboardKey(country string, p period.Period, periodId string) string {
+	return fmt.Sprintf("leaderboard:country:%s:%s:%s", country, strings.ToLower(string(p)), periodId)
+}
+
+func periodArchiveKey(p period.Period, periodId string) string {
+	return fmt.Sprintf("leaderboard:archive:%s:%s", strings.ToLower(string(p)), periodId)
+}
+
 // Write Operations
 
-// AddUserToTournament adds user to tournament with 0 score
-func (r *LeaderboardRepository) AddGlobalUser(ctx context.Context, userId, displayName string) *apperrors.AppError {
+// AddGlobalUser adds a new user to the global leaderboard and the leaderboard of
+// their country with no lifetime points, a redelivered creation keeps the points
+// collected since
+func (r *LeaderboardRepository) AddGlobalUser(ctx context.Context, userId, displayName, country string) *apperrors.AppError {
+	writeKey, err := r.leaderboardWriter(ctx, userId)
+	if err != nil {
+		return err
+	}
+
 	pipe := r.client.Pipeline()
 
 	pipe.HSet(ctx, usernamesHashKey(), userId, displayName)
-
-	globalLeaderboardKey := globalLeaderboardKey()
-
-	member := redis.Z{
+	pipe.ZAddNX(ctx, writeKey(pipe, globalLeaderboardKey()), redis.Z{
 		Score:  0,
 		Member: userId,
+	})
+
+	if country != "" {
+		pipe.HSet(ctx, userCountriesHashKey(), userId, country)
+		pipe.ZAddNX(ctx, writeKey(pipe, countryLeaderboardKey(country)), redis.Z{
+			Score:  0,
+			Member: userId,
+		})
 	}
-	if err := pipe.ZAdd(ctx, globalLeaderboardKey, member).Err(); err != nil {
-		return leaderboarderrors.UserNotExistsInAnyGroup()
-	}
-	pipe.ZRemRangeByRank(ctx, globalLeaderboardKey, 0, -GlobalLeaderboardLimit-1)
 
 	if _, err := pipe.Exec(ctx); err != nil {
 		r.logger.Error("Failed to add global user",
@@ -81,17 +248,37 @@ func (r *LeaderboardRepository) AddGlobalUser(ctx context.Context, userId, displ
 	return nil
 }
 
+// SetDisplayName replaces the name shown for the user on every leaderboard
+func (r *LeaderboardRepository) SetDisplayName(ctx context.Context, userId, displayName string) *apperrors.AppError {
+	if err := r.client.HSet(ctx, usernamesHashKey(), userId, displayName).Err(); err != nil {
+		r.logger.Error("Failed to set display name",
+			"error", err,
+			"user_id", userId,
+		)
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to set display name")
+	}
+
+	return nil
+}
+
 func (r *LeaderboardRepository) AddUserToTournament(
 	ctx context.Context,
 	userId, displayName, groupId, tournamentId string,
 ) *apperrors.AppError {
+	writeKey, writerErr := r.leaderboardWriter(ctx, userId)
+	if writerErr != nil {
+		return writerErr
+	}
+
 	pipe := r.client.Pipeline()
 
 	pipe.HSet(ctx, usernamesHashKey(), userId, displayName)
 	pipe.HSet(ctx, userGroupMappingsHashKey(), userTournamentField(userId, tournamentId), groupId)
 	pipe.Expire(ctx, userGroupMappingsHashKey(), DefaultTTL)
+	pipe.SAdd(ctx, tournamentGroupsKey(tournamentId), groupId)
+	pipe.Expire(ctx, tournamentGroupsKey(tournamentId), DefaultTTL)
 
-	leaderboardKey := groupLeaderboardKey(tournamentId, groupId)
+	leaderboardKey := writeKey(pipe, groupLeaderboardKey(tournamentId, groupId))
 
 	member := redis.Z{
 		Score:  0,
@@ -114,44 +301,553 @@ func (r *LeaderboardRepository) AddUserToTournament(
 	return nil
 }
 
-// UpdateTournamentScore updates score for a specific tournament (NOT cumulative)
+// UpdateTournamentScore sets the user's score in their tournament group and adds
+// the change to their lifetime points in the global leaderboard and to their
+// points in the daily, weekly and monthly windows the score was made in, in the
+// same way on the leaderboards of their country. It reports the human group
+// members the user passed with it, banned users score in the shadows and pass
+// nobody.
 func (r *LeaderboardRepository) UpdateTournamentScore(
 	ctx context.Context,
 	userId, tournamentId string,
 	score int,
-) *apperrors.AppError {
+	scoredAt time.Time,
+) (*ScoreUpdate, *apperrors.AppError) {
 	groupId, err := r.client.HGet(ctx, userGroupMappingsHashKey(), userTournamentField(userId, tournamentId)).Result()
 	if err == redis.Nil {
-		return leaderboarderrors.UserNotExistsInAnyGroup()
+		return nil, leaderboarderrors.UserNotExistsInAnyGroup()
 	} else if err != nil {
-		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get user group")
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get user group")
+	}
+
+	writeKey, writerErr := r.leaderboardWriter(ctx, userId)
+	if writerErr != nil {
+		return nil, writerErr
 	}
 
 	pipe := r.client.Pipeline()
+	groupKey := writeKey(pipe, groupLeaderboardKey(tournamentId, groupId))
 
-	member := redis.Z{
-		Score:  float64(score),
-		Member: userId,
+	previousRank, err := r.client.ZRevRank(ctx, groupKey, userId).Result()
+	if err == redis.Nil {
+		previousRank = -1
+	} else if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get group rank")
 	}
 
-	pipe.ZAdd(ctx, groupLeaderboardKey(tournamentId, groupId), member)
-	pipe.Expire(ctx, groupLeaderboardKey(tournamentId, groupId), DefaultTTL)
+	// Bots only compete inside their group, they never reach the global leaderboards.
+	// Windows roll over by their key, each expires some time after it closed.
+	scoreKeys := []string{groupKey}
+	windowRetentions := make(map[string]time.Duration, 2*len(period.Windowed))
+	if !models.IsBotUserId(userId) {
+		country, err := r.client.HGet(ctx, userCountriesHashKey(), userId).Result()
+		if err != nil && err != redis.Nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get user country")
+		}
 
-	pipe.ZAdd(ctx, globalLeaderboardKey(), member)
-	pipe.Expire(ctx, globalLeaderboardKey(), DefaultTTL)
+		scoreKeys = append(scoreKeys, writeKey(pipe, globalLeaderboardKey()))
+		if country != "" {
+			scoreKeys = append(scoreKeys, writeKey(pipe, countryLeaderboardKey(country)))
+		}
 
-	if _, err := pipe.Exec(ctx); err != nil {
+		for _, p := range period.Windowed {
+			windowKey := writeKey(pipe, periodLeaderboardKey(p, p.Id(scoredAt)))
+			scoreKeys = append(scoreKeys, windowKey)
+			windowRetentions[windowKey] = p.Retention()
+
+			if country != "" {
+				countryWindowKey := writeKey(pipe, countryPeriodLeaderboardKey(country, p, p.Id(scoredAt)))
+				scoreKeys = append(scoreKeys, countryWindowKey)
+				windowRetentions[countryWindowKey] = p.Retention()
+			}
+		}
+	}
+
+	// Scripts in a pipeline are sent in full, EVALSHA can not fall back there
+	applyCmd := applyTournamentScore.Eval(ctx, pipe, scoreKeys, score, userId)
+	pipe.Expire(ctx, groupKey, DefaultTTL)
+	rankCmd := pipe.ZRevRank(ctx, groupKey, userId)
+
+	for windowKey, retention := range windowRetentions {
+		pipe.Expire(ctx, windowKey, retention)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
 		r.logger.Error("Failed to update tournament score",
 			"error", err,
 			"user_id", userId,
 			"tournament_id", tournamentId,
 		)
-		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to update tournament score")
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to update tournament score")
+	}
+
+	update := &ScoreUpdate{GroupId: groupId}
+
+	// A late redelivery after the group expired must not count the score again
+	if applyCmd.Err() == redis.Nil {
+		r.logger.Warn("Score update for expired group ignored",
+			"user_id", userId,
+			"tournament_id", tournamentId,
+			"group_id", groupId,
+		)
+		return update, nil
+	}
+
+	// A banned user only passes other banned users in the shadow, which is not reported
+	rank := rankCmd.Val()
+	if previousRank <= rank || groupKey != groupLeaderboardKey(tournamentId, groupId) {
+		return update, nil
+	}
+
+	// Everyone between the new and the previous place moved down by one
+	passed, err := r.client.ZRevRangeWithScores(ctx, groupKey, rank+1, previousRank).Result()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get overtaken group members")
+	}
+
+	for i, z := range passed {
+		memberId := z.Member.(string)
+		if models.IsBotUserId(memberId) {
+			continue
+		}
+
+		update.Overtaken = append(update.Overtaken, LeaderboardEntry{
+			UserId: memberId,
+			Score:  z.Score,
+			Rank:   rank + int64(i) + 2,
+		})
+	}
+
+	if len(update.Overtaken) > 0 {
+		displayName, err := r.client.HGet(ctx, usernamesHashKey(), userId).Result()
+		if err != nil && err != redis.Nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get display name")
+		}
+		update.DisplayName = displayName
+	}
+
+	return update, nil
+}
+
+// AwardSeasonPoints adds season points to every human player of a finished
+// tournament based on their final placement in their group. Each group is
+// awarded at most once so redelivered events do not double count, the awarded
+// marker is only written together with the points.
+func (r *LeaderboardRepository) AwardSeasonPoints(
+	ctx context.Context,
+	tournamentId, seasonId string,
+	pointsMap map[string]int,
+) *apperrors.AppError {
+	groupIds, err := r.client.SMembers(ctx, tournamentGroupsKey(tournamentId)).Result()
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get tournament groups")
+	}
+
+	seasonKey := seasonLeaderboardKey(seasonId)
+
+	for _, groupId := range groupIds {
+		awardedKey := seasonAwardedGroupKey(tournamentId, groupId)
+
+		awarded, err := r.client.Exists(ctx, awardedKey).Result()
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to check awarded group")
+		}
+		if awarded > 0 {
+			continue
+		}
+
+		members, err := r.client.ZRevRange(ctx, groupLeaderboardKey(tournamentId, groupId), 0, -1).Result()
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get group leaderboard")
+		}
+
+		args := []interface{}{seasonId, int64(SeasonTTL.Seconds())}
+		placement := 0
+		for _, userId := range members {
+			if models.IsBotUserId(userId) {
+				continue
+			}
+			placement++
+
+			if points := models.RankReward(placement, pointsMap); points > 0 {
+				args = append(args, userId, points)
+			}
+		}
+
+		if err := awardGroupSeasonPoints.Run(ctx, r.client, []string{awardedKey, seasonKey}, args...).Err(); err != nil {
+			r.logger.Error("Failed to award season points",
+				"error", err,
+				"tournament_id", tournamentId,
+				"group_id", groupId,
+			)
+			return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to award season points")
+		}
+	}
+
+	// Season payouts wait until every tournament of the season is applied
+	pipe := r.client.Pipeline()
+	pipe.SAdd(ctx, seasonAppliedTournamentsKey(seasonId), tournamentId)
+	pipe.Expire(ctx, seasonAppliedTournamentsKey(seasonId), SeasonTTL)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to mark tournament as applied")
+	}
+
+	return nil
+}
+
+// AddFriendship mirrors an accepted friendship into the friend sets of both users
+func (r *LeaderboardRepository) AddFriendship(ctx context.Context, userId, friendId string) *apperrors.AppError {
+	pipe := r.client.Pipeline()
+	pipe.SAdd(ctx, friendsKey(userId), friendId)
+	pipe.SAdd(ctx, friendsKey(friendId), userId)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Error("Failed to add friendship",
+			"error", err,
+			"user_id", userId,
+			"friend_id", friendId,
+		)
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to add friendship")
+	}
+
+	return nil
+}
+
+func (r *LeaderboardRepository) RemoveFriendship(ctx context.Context, userId, friendId string) *apperrors.AppError {
+	pipe := r.client.Pipeline()
+	pipe.SRem(ctx, friendsKey(userId), friendId)
+	pipe.SRem(ctx, friendsKey(friendId), userId)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Error("Failed to remove friendship",
+			"error", err,
+			"user_id", userId,
+			"friend_id", friendId,
+		)
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to remove friendship")
+	}
+
+	return nil
+}
+
+// SetBanned moves a banned user with their points out of every leaderboard into
+// its shadow, so reads never see them, and back when they are reinstated. Points
+// a banned user collects meanwhile are written to the shadows.
+func (r *LeaderboardRepository) SetBanned(ctx context.Context, userId string, banned bool) *apperrors.AppError {
+	var keys []string
+	if banned {
+		leaderboards, _, err := r.findUserLeaderboards(ctx, userId)
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, bannedUserLeaderboardsKey(userId))
+		for _, key := range leaderboards {
+			keys = append(keys, key, shadowLeaderboardKey(key))
+		}
+	} else {
+		leaderboards, err := r.client.SMembers(ctx, bannedUserLeaderboardsKey(userId)).Result()
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get leaderboards of banned user")
+		}
+
+		for _, key := range leaderboards {
+			keys = append(keys, shadowLeaderboardKey(key), key)
+		}
+	}
+
+	// Writes that check the flag see either every point hidden or every point back
+	pipe := r.client.TxPipeline()
+	if banned {
+		pipe.SAdd(ctx, bannedUsersKey(), userId)
+		hideLeaderboardMember.Eval(ctx, pipe, keys, userId)
+	} else {
+		pipe.SRem(ctx, bannedUsersKey(), userId)
+		if len(keys) > 0 {
+			restoreLeaderboardMember.Eval(ctx, pipe, keys, userId)
+		}
+		pipe.Del(ctx, bannedUserLeaderboardsKey(userId))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Error("Failed to set banned user",
+			"error", err,
+			"user_id", userId,
+			"banned", banned,
+		)
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to set banned user")
+	}
+
+	return nil
+}
+
+// RebuildLifetimeLeaderboard replaces the legacy global leaderboard, which held
+// the last tournament score of every user, once. Lifetime points start from the
+// scores in the groups that are still kept, every other user starts without
+// points. The lifetime leaderboard never expires.
+func (r *LeaderboardRepository) RebuildLifetimeLeaderboard(ctx context.Context) *apperrors.AppError {
+	markerKey := migrationKey("lifetime-global")
+
+	migrated, err := r.client.Exists(ctx, markerKey).Result()
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to check migration")
+	}
+	if migrated > 0 {
+		return nil
+	}
+
+	userIds, err := r.client.ZRange(ctx, legacyGlobalLeaderboardKey(), 0, -1).Result()
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get legacy global leaderboard")
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, globalLeaderboardKey())
+	for _, userId := range userIds {
+		pipe.ZAdd(ctx, globalLeaderboardKey(), redis.Z{Score: 0, Member: userId})
+	}
+
+	groups := r.client.Scan(ctx, 0, groupLeaderboardKey("*", "*"), 100).Iterator()
+	for groups.Next(ctx) {
+		members, err := r.client.ZRangeWithScores(ctx, groups.Val(), 0, -1).Result()
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get group leaderboard")
+		}
+
+		for _, z := range members {
+			if models.IsBotUserId(z.Member.(string)) {
+				continue
+			}
+			pipe.ZIncrBy(ctx, globalLeaderboardKey(), z.Score, z.Member.(string))
+		}
+	}
+	if err := groups.Err(); err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to scan group leaderboards")
+	}
+
+	pipe.Persist(ctx, globalLeaderboardKey())
+	pipe.Del(ctx, legacyGlobalLeaderboardKey())
+	pipe.Set(ctx, markerKey, time.Now().UTC().Format(time.RFC3339), 0)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to rebuild lifetime leaderboard")
+	}
+
+	r.logger.Info("Lifetime leaderboard rebuilt", "users", len(userIds))
+	return nil
+}
+
+// ShadowBannedUsers moves the users banned before leaderboards had shadows into
+// them, once
+func (r *LeaderboardRepository) ShadowBannedUsers(ctx context.Context) *apperrors.AppError {
+	markerKey := migrationKey("shadow-banned")
+
+	migrated, err := r.client.Exists(ctx, markerKey).Result()
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to check migration")
+	}
+	if migrated > 0 {
+		return nil
+	}
+
+	bannedIds, err := r.client.SMembers(ctx, bannedUsersKey()).Result()
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get banned users")
+	}
+
+	for _, userId := range bannedIds {
+		if err := r.SetBanned(ctx, userId, true); err != nil {
+			return err
+		}
+	}
+
+	if err := r.client.Set(ctx, markerKey, time.Now().UTC().Format(time.RFC3339), 0).Err(); err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to mark migration")
+	}
+
+	r.logger.Info("Banned users moved to shadow leaderboards", "count", len(bannedIds))
+	return nil
+}
+
+// SetCountry moves the user to the leaderboards of their new country with their
+// lifetime points and their points in the current windows. Closed windows of the
+// previous country keep the user, an empty country leaves every country.
+func (r *LeaderboardRepository) SetCountry(ctx context.Context, userId, country string) *apperrors.AppError {
+	previousCountry, err := r.client.HGet(ctx, userCountriesHashKey(), userId).Result()
+	if err != nil && err != redis.Nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get user country")
+	}
+	if previousCountry == country {
+		return nil
+	}
+
+	writeKey, writerErr := r.leaderboardWriter(ctx, userId)
+	if writerErr != nil {
+		return writerErr
+	}
+
+	now := time.Now()
+	pipe := r.client.TxPipeline()
+
+	reads := r.client.Pipeline()
+	globalScoreCmd := reads.ZScore(ctx, writeKey(nil, globalLeaderboardKey()), userId)
+	windowScoreCmds := make([]*redis.FloatCmd, len(period.Windowed))
+	for i, p := range period.Windowed {
+		windowScoreCmds[i] = reads.ZScore(ctx, writeKey(nil, periodLeaderboardKey(p, p.Id(now))), userId)
+	}
+	if _, err := reads.Exec(ctx); err != nil && err != redis.Nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get user points")
+	}
+
+	if previousCountry != "" {
+		pipe.ZRem(ctx, writeKey(nil, countryLeaderboardKey(previousCountry)), userId)
+		for _, p := range period.Windowed {
+			pipe.ZRem(ctx, writeKey(nil, countryPeriodLeaderboardKey(previousCountry, p, p.Id(now))), userId)
+		}
+	}
+
+	if country == "" {
+		pipe.HDel(ctx, userCountriesHashKey(), userId)
+	} else {
+		pipe.HSet(ctx, userCountriesHashKey(), userId, country)
+		pipe.ZAdd(ctx, writeKey(pipe, countryLeaderboardKey(country)), redis.Z{
+			Score:  globalScoreCmd.Val(),
+			Member: userId,
+		})
+
+		// A user who scored nothing in a window stays out of it
+		for i, p := range period.Windowed {
+			if windowScoreCmds[i].Err() != nil {
+				continue
+			}
+			windowKey := writeKey(pipe, countryPeriodLeaderboardKey(country, p, p.Id(now)))
+			pipe.ZAdd(ctx, windowKey, redis.Z{
+				Score:  windowScoreCmds[i].Val(),
+				Member: userId,
+			})
+			pipe.Expire(ctx, windowKey, p.Retention())
+		}
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Error("Failed to set country",
+			"error", err,
+			"user_id", userId,
+			"country", country,
+		)
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to set country")
+	}
+
+	return nil
+}
+
+// ArchivePeriod keeps the final standings of a closed window. The top entries are
+// copied once and outlive the live leaderboard.
+func (r *LeaderboardRepository) ArchivePeriod(ctx context.Context, p period.Period, periodId string) *apperrors.AppError {
+	archiveKey := periodArchiveKey(p, periodId)
+
+	archived, err := r.client.Exists(ctx, archiveKey).Result()
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to check period archive")
+	}
+	if archived > 0 {
+		return nil
+	}
+
+	// A window nobody scored in stores no archive, the next run finds nothing again
+	pipe := r.client.TxPipeline()
+	pipe.ZUnionStore(ctx, archiveKey, &redis.ZStore{Keys: []string{periodLeaderboardKey(p, periodId)}})
+	pipe.ZRemRangeByRank(ctx, archiveKey, 0, -GlobalLeaderboardLimit-1)
+	pipe.Expire(ctx, archiveKey, ArchiveTTL)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Error("Failed to archive period",
+			"error", err,
+			"period", p,
+			"period_id", periodId,
+		)
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to archive period")
 	}
 
 	return nil
 }
 
+// RemoveUser deletes every trace of a deleted user: the display name, global,
+// windowed, archived, country and season standings, friend sets, the banned flag,
+// the shadows of a banned user and the group leaderboards found through the user
+// group mapping
+func (r *LeaderboardRepository) RemoveUser(ctx context.Context, userId string) *apperrors.AppError {
+	friendIds, err := r.client.SMembers(ctx, friendsKey(userId)).Result()
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get friends of user")
+	}
+
+	shadowed, err := r.client.SMembers(ctx, bannedUserLeaderboardsKey(userId)).Result()
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get leaderboards of banned user")
+	}
+
+	leaderboards, mappings, appErr := r.findUserLeaderboards(ctx, userId)
+	if appErr != nil {
+		return appErr
+	}
+
+	pipe := r.client.Pipeline()
+	pipe.SAdd(ctx, deletedUsersKey(), userId)
+	pipe.HDel(ctx, usernamesHashKey(), userId)
+	pipe.HDel(ctx, userCountriesHashKey(), userId)
+	pipe.SRem(ctx, bannedUsersKey(), userId)
+
+	for _, key := range shadowed {
+		pipe.ZRem(ctx, shadowLeaderboardKey(key), userId)
+	}
+	pipe.Del(ctx, bannedUserLeaderboardsKey(userId))
+
+	for _, friendId := range friendIds {
+		pipe.SRem(ctx, friendsKey(friendId), userId)
+	}
+	pipe.Del(ctx, friendsKey(userId))
+
+	for _, key := range leaderboards {
+		pipe.ZRem(ctx, key, userId)
+	}
+	if len(mappings) > 0 {
+		pipe.HDel(ctx, userGroupMappingsHashKey(), mappings...)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.logger.Error("Failed to remove user",
+			"error", err,
+			"user_id", userId,
+		)
+		return apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to remove user from leaderboards")
+	}
+
+	return nil
+}
+
+// IsAnyUserDeleted reports whether the data of any of the users was removed
+func (r *LeaderboardRepository) IsAnyUserDeleted(ctx context.Context, userIds ...string) (bool, *apperrors.AppError) {
+	members := make([]interface{}, len(userIds))
+	for i, userId := range userIds {
+		members[i] = userId
+	}
+
+	deleted, err := r.client.SMIsMember(ctx, deletedUsersKey(), members...).Result()
+	if err != nil {
+		return false, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to check deleted users")
+	}
+
+	for _, isDeleted := range deleted {
+		if isDeleted {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // Read Operations
 
 type LeaderboardEntry struct {
@@ -159,9 +855,43 @@ type LeaderboardEntry struct {
 	DisplayName string  `json:"display_name"`
 	Score       float64 `json:"score"`
 	Rank        int64   `json:"rank"`
+	IsBot       bool    `json:"is_bot"`
+}
+
+// ScoreUpdate is the result of a score change in a group. Overtaken holds the
+// human members the user passed, ranked at their new place.
+type ScoreUpdate struct {
+	GroupId     string
+	DisplayName string
+	Overtaken   []LeaderboardEntry
 }
 
-func (r *LeaderboardRepository) generateLeaderboardEntryList(ctx context.Context, result []redis.Z) []LeaderboardEntry {
+// Standing is the final placement of a player in their tournament group
+type Standing struct {
+	GroupId string
+	LeaderboardEntry
+}
+
+// PageRequest selects a part of a leaderboard. AroundMe returns that many entries
+// above and below the user, otherwise PageToken continues after the last entry of
+// a previous page and Offset skips entries from the top.
+type PageRequest struct {
+	Offset    int64
+	Limit     int64
+	PageToken string
+	AroundMe  int64
+}
+
+// LeaderboardPage is a part of a leaderboard ranked by absolute place.
+// NextPageToken is empty on the last page and for around me windows. PeriodId
+// names the window of a windowed global leaderboard.
+type LeaderboardPage struct {
+	Entries       []LeaderboardEntry
+	NextPageToken string
+	PeriodId      string
+}
+
+func (r *LeaderboardRepository) generateLeaderboardEntryList(ctx context.Context, result []redis.Z, firstRank int64) []LeaderboardEntry {
 	entries := make([]LeaderboardEntry, len(result))
 
 	for i, z := range result {
@@ -178,33 +908,291 @@ func (r *LeaderboardRepository) generateLeaderboardEntryList(ctx context.Context
 			UserId:      userId,
 			DisplayName: displayName,
 			Score:       z.Score,
-			Rank:        int64(i + 1),
+			Rank:        firstRank + int64(i),
+			IsBot:       models.IsBotUserId(userId),
 		}
 	}
 
 	return entries
 }
 
-// GetGlobalLeaderboard returns top N users from global leaderboard
-func (r *LeaderboardRepository) GetGlobalLeaderboard(ctx context.Context) ([]LeaderboardEntry, *apperrors.AppError) {
-	r.logger.Debug("Getting global leaderboard")
+// getLeaderboardPage returns the requested part of the leaderboard at key
+func (r *LeaderboardRepository) getLeaderboardPage(
+	ctx context.Context,
+	key, userId string,
+	page PageRequest,
+) (*LeaderboardPage, *apperrors.AppError) {
+	offset, limit := page.Offset, page.Limit
+	switch {
+	case page.AroundMe > 0:
+		rank, rankErr := r.client.ZRevRank(ctx, key, userId).Result()
+		if rankErr == redis.Nil {
+			return nil, leaderboarderrors.UserNotOnLeaderboardError()
+		} else if rankErr != nil {
+			return nil, apperrors.Wrap(rankErr, apperrors.CodeRedisOperationError, "failed to get user rank")
+		}
+
+		offset = rank - page.AroundMe
+		if offset < 0 {
+			offset = 0
+		}
+		limit = rank - offset + page.AroundMe + 1
+	case page.PageToken != "":
+		var err *apperrors.AppError
+		offset, err = r.resolvePageToken(ctx, key, page.PageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Reads one entry more to tell whether a next page exists
+	result, redisErr := r.client.ZRevRangeWithScores(ctx, key, offset, offset+limit).Result()
+	if redisErr != nil {
+		r.logger.Error("Failed to get leaderboard page",
+			"error", redisErr,
+			"key", key,
+		)
+		return nil, apperrors.Wrap(redisErr, apperrors.CodeRedisOperationError, "failed to get leaderboard page")
+	}
+
+	leaderboardPage := &LeaderboardPage{}
+	if int64(len(result)) > limit {
+		result = result[:limit]
+		if page.AroundMe == 0 {
+			leaderboardPage.NextPageToken = encodePageToken(result[limit-1])
+		}
+	}
+	leaderboardPage.Entries = r.generateLeaderboardEntryList(ctx, result, offset+1)
+
+	return leaderboardPage, nil
+}
+
+// resolvePageToken returns the offset after the entry a page ended with. When that
+// entry moved since, the page continues after the users that are above its old score.
+func (r *LeaderboardRepository) resolvePageToken(ctx context.Context, key, pageToken string) (int64, *apperrors.AppError) {
+	score, member, err := decodePageToken(pageToken)
+	if err != nil {
+		return 0, err
+	}
+
+	currentScore, redisErr := r.client.ZScore(ctx, key, member).Result()
+	switch {
+	case redisErr == nil && currentScore == score:
+		rank, rankErr := r.client.ZRevRank(ctx, key, member).Result()
+		if rankErr != nil {
+			return 0, apperrors.Wrap(rankErr, apperrors.CodeRedisOperationError, "failed to get page token rank")
+		}
+		return rank + 1, nil
+	case redisErr == nil || redisErr == redis.Nil:
+		above, countErr := r.client.ZCount(ctx, key, "("+formatScore(score), "+inf").Result()
+		if countErr != nil {
+			return 0, apperrors.Wrap(countErr, apperrors.CodeRedisOperationError, "failed to count users above page token")
+		}
+		return above, nil
+	default:
+		return 0, apperrors.Wrap(redisErr, apperrors.CodeRedisOperationError, "failed to get page token score")
+	}
+}
 
-	result, err := r.client.ZRevRangeWithScores(ctx, globalLeaderboardKey(), 0, GlobalLeaderboardLimit-1).Result()
+// leaderboardWriter returns where the points of the user are written. Banned
+// users write to the shadows, which are recorded in the pipeline for their
+// reinstatement when one is given.
+func (r *LeaderboardRepository) leaderboardWriter(
+	ctx context.Context,
+	userId string,
+) (func(pipe redis.Pipeliner, key string) string, *apperrors.AppError) {
+	banned, err := r.client.SIsMember(ctx, bannedUsersKey(), userId).Result()
 	if err != nil {
-		r.logger.Error("Failed to get global leaderboard",
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to check banned user")
+	}
+
+	if !banned {
+		return func(_ redis.Pipeliner, key string) string { return key }, nil
+	}
+
+	return func(pipe redis.Pipeliner, key string) string {
+		if pipe != nil {
+			pipe.SAdd(ctx, bannedUserLeaderboardsKey(userId), key)
+		}
+		return shadowLeaderboardKey(key)
+	}, nil
+}
+
+// findUserLeaderboards returns every leaderboard the user may be ranked on: the
+// global one, the groups found through the user group mapping and the windowed,
+// archived, country and season leaderboards. The user group mapping fields that
+// led to the groups are returned as well.
+func (r *LeaderboardRepository) findUserLeaderboards(ctx context.Context, userId string) ([]string, []string, *apperrors.AppError) {
+	keys := []string{globalLeaderboardKey()}
+	var fields []string
+
+	// HSCAN yields field and value one after the other
+	mappings := r.client.HScan(ctx, userGroupMappingsHashKey(), 0, userTournamentField(userId, "*"), 100).Iterator()
+	for mappings.Next(ctx) {
+		field := mappings.Val()
+		if !mappings.Next(ctx) {
+			break
+		}
+		tournamentId := strings.TrimPrefix(field, userTournamentField(userId, ""))
+		keys = append(keys, groupLeaderboardKey(tournamentId, mappings.Val()))
+		fields = append(fields, field)
+	}
+	if err := mappings.Err(); err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to scan user group mappings")
+	}
+
+	for _, pattern := range []string{
+		seasonLeaderboardKey("*"),
+		periodLeaderboardKey("*", "*"),
+		periodArchiveKey("*", "*"),
+		countryLeaderboardKey("*"),
+	} {
+		leaderboards := r.client.Scan(ctx, 0, pattern, 100).Iterator()
+		for leaderboards.Next(ctx) {
+			keys = append(keys, leaderboards.Val())
+		}
+		if err := leaderboards.Err(); err != nil {
+			return nil, nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to scan leaderboards")
+		}
+	}
+
+	return keys, fields, nil
+}
+
+// GetGlobalLeaderboard returns a page of the global leaderboard of the period,
+// userId is only read for around me windows. Windows other than the current one
+// are read from their archived final standings, a closed window that is not
+// archived yet from its live leaderboard.
+func (r *LeaderboardRepository) GetGlobalLeaderboard(
+	ctx context.Context,
+	userId string,
+	p period.Period,
+	periodId string,
+	page PageRequest,
+) (*LeaderboardPage, *apperrors.AppError) {
+	r.logger.Debug("Getting global leaderboard", "period", p, "period_id", periodId)
+
+	key := globalLeaderboardKey()
+	switch {
+	case p == period.AllTime:
+	case periodId == p.Id(time.Now()):
+		key = periodLeaderboardKey(p, periodId)
+	default:
+		key = periodArchiveKey(p, periodId)
+
+		archived, err := r.client.Exists(ctx, key).Result()
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to check period archive")
+		}
+		if archived == 0 {
+			key = periodLeaderboardKey(p, periodId)
+		}
+	}
+
+	return r.getLeaderboardPage(ctx, key, userId, page)
+}
+
+// GetRegionalLeaderboard returns a page of the leaderboard of a country in the
+// current window of the period, userId is only read for around me windows
+func (r *LeaderboardRepository) GetRegionalLeaderboard(
+	ctx context.Context,
+	userId, country string,
+	p period.Period,
+	page PageRequest,
+) (*LeaderboardPage, *apperrors.AppError) {
+	r.logger.Debug("Getting regional leaderboard", "country", country, "period", p)
+
+	key := countryLeaderboardKey(country)
+	if p != period.AllTime {
+		key = countryPeriodLeaderboardKey(country, p, p.Id(time.Now()))
+	}
+
+	return r.getLeaderboardPage(ctx, key, userId, page)
+}
+
+// GetSeasonLeaderboard returns top N users of a season standings table
+func (r *LeaderboardRepository) GetSeasonLeaderboard(
+	ctx context.Context,
+	seasonId string,
+	limit int,
+) ([]LeaderboardEntry, *apperrors.AppError) {
+	r.logger.Debug("Getting season leaderboard", "season_id", seasonId)
+
+	result, err := r.client.ZRevRangeWithScores(ctx, seasonLeaderboardKey(seasonId), 0, int64(limit)-1).Result()
+	if err != nil {
+		r.logger.Error("Failed to get season leaderboard",
 			"error", err,
+			"season_id", seasonId,
 		)
-		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get global leaderboard")
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get season leaderboard")
+	}
+
+	return r.generateLeaderboardEntryList(ctx, result, 1), nil
+}
+
+// GetSeasonAppliedTournaments returns the tournaments whose season points were
+// fully awarded
+func (r *LeaderboardRepository) GetSeasonAppliedTournaments(ctx context.Context, seasonId string) ([]string, *apperrors.AppError) {
+	tournamentIds, err := r.client.SMembers(ctx, seasonAppliedTournamentsKey(seasonId)).Result()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get applied season tournaments")
 	}
 
-	return r.generateLeaderboardEntryList(ctx, result), nil
+	return tournamentIds, nil
 }
 
-// GetGroupLeaderboard returns all users in a specific group
+// GetTournamentStandings returns the final placement of every human player of
+// the tournament in their group. Bots are skipped when ranking, the same way
+// rewards are paid.
+func (r *LeaderboardRepository) GetTournamentStandings(
+	ctx context.Context,
+	tournamentId string,
+) ([]Standing, *apperrors.AppError) {
+	groupIds, err := r.client.SMembers(ctx, tournamentGroupsKey(tournamentId)).Result()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get tournament groups")
+	}
+
+	standings := make([]Standing, 0)
+	for _, groupId := range groupIds {
+		result, err := r.client.ZRevRangeWithScores(ctx, groupLeaderboardKey(tournamentId, groupId), 0, -1).Result()
+		if err != nil {
+			r.logger.Error("Failed to get group leaderboard",
+				"error", err,
+				"tournament_id", tournamentId,
+				"group_id", groupId,
+			)
+			return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get group leaderboard")
+		}
+
+		placement := int64(0)
+		for _, z := range result {
+			userId := z.Member.(string)
+			if models.IsBotUserId(userId) {
+				continue
+			}
+			placement++
+
+			standings = append(standings, Standing{
+				GroupId: groupId,
+				LeaderboardEntry: LeaderboardEntry{
+					UserId: userId,
+					Score:  z.Score,
+					Rank:   placement,
+				},
+			})
+		}
+	}
+
+	return standings, nil
+}
+
+// GetGroupLeaderboard returns a page of the user's group in the tournament
 func (r *LeaderboardRepository) GetGroupLeaderboard(
 	ctx context.Context,
 	userId, tournamentId string,
-) ([]LeaderboardEntry, *apperrors.AppError) {
+	page PageRequest,
+) (*LeaderboardPage, *apperrors.AppError) {
 	r.logger.Debug("Getting group leaderboard",
 		"tournament_id", tournamentId,
 		"user_id", userId,
@@ -217,25 +1205,15 @@ func (r *LeaderboardRepository) GetGroupLeaderboard(
 		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get user group")
 	}
 
-	key := groupLeaderboardKey(tournamentId, groupId)
-
-	result, err := r.client.ZRevRangeWithScores(ctx, key, 0, -1).Result()
-	if err != nil {
-		r.logger.Error("Failed to get group leaderboard",
-			"error", err,
-			"tournament_id", tournamentId,
-			"group_id", groupId,
-		)
-		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get group leaderboard")
-	}
-
-	return r.generateLeaderboardEntryList(ctx, result), nil
+	return r.getLeaderboardPage(ctx, groupLeaderboardKey(tournamentId, groupId), userId, page)
 }
 
-// GetGroupRank returns user's rank within their group (1-based)
+// GetGroupRank returns user's rank within their group (0-based), optionally ignoring
+// bots
 func (r *LeaderboardRepository) GetGroupRank(
 	ctx context.Context,
 	userId, tournamentId string,
+	excludeBots bool,
 ) (int64, *apperrors.AppError) {
 	r.logger.Debug("Getting group rank",
 		"tournament_id", tournamentId,
@@ -264,5 +1242,122 @@ func (r *LeaderboardRepository) GetGroupRank(
 		return -1, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get group rank")
 	}
 
+	if !excludeBots || rank == 0 {
+		return rank, nil
+	}
+
+	ahead, err := r.client.ZRevRange(ctx, key, 0, rank-1).Result()
+	if err != nil {
+		return -1, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get group members ahead of user")
+	}
+
+	for _, member := range ahead {
+		if models.IsBotUserId(member) {
+			rank--
+		}
+	}
+
 	return rank, nil
 }
+
+// GetFriendsLeaderboard ranks the user and their friends by their score in the
+// tournament, whichever group each of them was placed in. Without a tournament
+// their lifetime points are used. Users without a score are left out.
+func (r *LeaderboardRepository) GetFriendsLeaderboard(
+	ctx context.Context,
+	userId, tournamentId string,
+) ([]LeaderboardEntry, *apperrors.AppError) {
+	r.logger.Debug("Getting friends leaderboard",
+		"tournament_id", tournamentId,
+		"user_id", userId,
+	)
+
+	friendIds, err := r.client.SMembers(ctx, friendsKey(userId)).Result()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get friends of user")
+	}
+	memberIds := append(friendIds, userId)
+
+	// Resolve the leaderboard each member is ranked in
+	leaderboardKeys := make(map[string]string, len(memberIds))
+	if tournamentId == "" {
+		for _, memberId := range memberIds {
+			leaderboardKeys[memberId] = globalLeaderboardKey()
+		}
+	} else {
+		pipe := r.client.Pipeline()
+		groupCmds := make(map[string]*redis.StringCmd, len(memberIds))
+		for _, memberId := range memberIds {
+			groupCmds[memberId] = pipe.HGet(ctx, userGroupMappingsHashKey(), userTournamentField(memberId, tournamentId))
+		}
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get friend groups")
+		}
+
+		for memberId, cmd := range groupCmds {
+			if groupId, err := cmd.Result(); err == nil {
+				leaderboardKeys[memberId] = groupLeaderboardKey(tournamentId, groupId)
+			}
+		}
+	}
+
+	pipe := r.client.Pipeline()
+	scoreCmds := make(map[string]*redis.FloatCmd, len(leaderboardKeys))
+	for memberId, key := range leaderboardKeys {
+		scoreCmds[memberId] = pipe.ZScore(ctx, key, memberId)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		r.logger.Error("Failed to get friends leaderboard",
+			"error", err,
+			"tournament_id", tournamentId,
+			"user_id", userId,
+		)
+		return nil, apperrors.Wrap(err, apperrors.CodeRedisOperationError, "failed to get friend scores")
+	}
+
+	result := make([]redis.Z, 0, len(scoreCmds))
+	for memberId, cmd := range scoreCmds {
+		if score, err := cmd.Result(); err == nil {
+			result = append(result, redis.Z{Score: score, Member: memberId})
+		}
+	}
+
+	// Same order as ZREVRANGE: higher score first, ties by member descending
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].Member.(string) > result[j].Member.(string)
+	})
+
+	return r.generateLeaderboardEntryList(ctx, result, 1), nil
+}
+
+// Page tokens hold the score and id of the last entry of a page
+func encodePageToken(last redis.Z) string {
+	token := fmt.Sprintf("%s:%s", formatScore(last.Score), last.Member.(string))
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+func decodePageToken(pageToken string) (float64, string, *apperrors.AppError) {
+	token, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, "", leaderboarderrors.InvalidPageTokenError()
+	}
+
+	score, member, found := strings.Cut(string(token), ":")
+	if !found || member == "" {
+		return 0, "", leaderboarderrors.InvalidPageTokenError()
+	}
+
+	parsedScore, err := strconv.ParseFloat(score, 64)
+	if err != nil {
+		return 0, "", leaderboarderrors.InvalidPageTokenError()
+	}
+
+	return parsedScore, member, nil
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
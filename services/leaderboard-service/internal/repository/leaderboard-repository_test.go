@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"testing"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// testRepository connects to the Redis at GOODSWIPE_TEST_REDIS_ADDRESS, local
+// by default. Tests that need Redis are skipped when it cannot be reached.
+func testRepository(t *testing.T) *LeaderboardRepository {
+	t.Helper()
+
+	address := os.Getenv("GOODSWIPE_TEST_REDIS_ADDRESS")
+	if address == "" {
+		address = "localhost:6379"
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: address})
+	t.Cleanup(func() { client.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		t.Skipf("redis is not reachable at %s: %v", address, err)
+	}
+
+	return &LeaderboardRepository{client: client, logger: logger.Development("leaderboard-repository-test")}
+}
+
+// testLeaderboard fills a leaderboard of its own for the test and removes it
+// afterwards
+func testLeaderboard(t *testing.T, r *LeaderboardRepository, members ...redis.Z) string {
+	t.Helper()
+
+	key := "test:leaderboard:" + t.Name()
+	ctx := context.Background()
+	t.Cleanup(func() { r.client.Del(ctx, key) })
+
+	r.client.Del(ctx, key)
+	if len(members) > 0 {
+		if err := r.client.ZAdd(ctx, key, members...).Err(); err != nil {
+			t.Fatalf("failed to fill leaderboard: %v", err)
+		}
+	}
+
+	return key
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		score  float64
+		member string
+	}{
+		{name: "zero score", score: 0, member: "user-1"},
+		{name: "whole score", score: 1500, member: "user-2"},
+		{name: "fractional score", score: 12.5, member: "user-3"},
+		{name: "negative score", score: -3, member: "user-4"},
+		{name: "member with separator", score: 42, member: "bot:7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := encodePageToken(redis.Z{Score: tt.score, Member: tt.member})
+
+			score, member, err := decodePageToken(token)
+			if err != nil {
+				t.Fatalf("decodePageToken(%q) failed: %v", token, err)
+			}
+			if score != tt.score || member != tt.member {
+				t.Errorf("decodePageToken(%q) = %v, %q, want %v, %q", token, score, member, tt.score, tt.member)
+			}
+		})
+	}
+}
+
+func TestDecodePageTokenRejectsInvalidTokens(t *testing.T) {
+	encode := func(token string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(token))
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "not a token!"},
+		{name: "no separator", token: encode("100")},
+		{name: "no member", token: encode("100:")},
+		{name: "score not a number", token: encode("high:user-1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := decodePageToken(tt.token)
+			if err == nil || err.Code != apperrors.CodeInvalidInput {
+				t.Errorf("decodePageToken(%q) error = %v, want invalid input", tt.token, err)
+			}
+		})
+	}
+}
+
+func TestResolvePageToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []redis.Z
+		last    redis.Z
+		want    int64
+	}{
+		{
+			name: "entry unchanged",
+			members: []redis.Z{
+				{Score: 100, Member: "u1"},
+				{Score: 90, Member: "u2"},
+				{Score: 80, Member: "u3"},
+			},
+			last: redis.Z{Score: 90, Member: "u2"},
+			want: 2,
+		},
+		{
+			name: "entry unchanged among equal scores",
+			members: []redis.Z{
+				{Score: 100, Member: "u1"},
+				{Score: 90, Member: "u3"},
+				{Score: 90, Member: "u2"},
+				{Score: 80, Member: "u4"},
+			},
+			last: redis.Z{Score: 90, Member: "u3"},
+			want: 2,
+		},
+		{
+			name: "entry moved up",
+			members: []redis.Z{
+				{Score: 100, Member: "u1"},
+				{Score: 95, Member: "u3"},
+				{Score: 90, Member: "u2"},
+				{Score: 70, Member: "u4"},
+			},
+			last: redis.Z{Score: 80, Member: "u3"},
+			want: 3,
+		},
+		{
+			name: "entry moved down",
+			members: []redis.Z{
+				{Score: 100, Member: "u1"},
+				{Score: 80, Member: "u3"},
+				{Score: 65, Member: "u2"},
+			},
+			last: redis.Z{Score: 90, Member: "u2"},
+			want: 1,
+		},
+		{
+			name: "entry removed",
+			members: []redis.Z{
+				{Score: 100, Member: "u1"},
+				{Score: 80, Member: "u3"},
+			},
+			last: redis.Z{Score: 90, Member: "u2"},
+			want: 1,
+		},
+		{
+			name: "everyone passed the entry",
+			members: []redis.Z{
+				{Score: 100, Member: "u1"},
+				{Score: 95, Member: "u3"},
+			},
+			last: redis.Z{Score: 10, Member: "u2"},
+			want: 2,
+		},
+	}
+
+	r := testRepository(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := testLeaderboard(t, r, tt.members...)
+
+			offset, err := r.resolvePageToken(context.Background(), key, encodePageToken(tt.last))
+			if err != nil {
+				t.Fatalf("resolvePageToken failed: %v", err)
+			}
+			if offset != tt.want {
+				t.Errorf("resolvePageToken = %d, want %d", offset, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolvePageTokenRejectsInvalidToken(t *testing.T) {
+	r := testRepository(t)
+	key := testLeaderboard(t, r)
+
+	_, err := r.resolvePageToken(context.Background(), key, "not a token!")
+	if err == nil || err.Code != apperrors.CodeInvalidInput {
+		t.Errorf("resolvePageToken error = %v, want invalid input", err)
+	}
+}
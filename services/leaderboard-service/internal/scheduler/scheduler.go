@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/burakmert236/goodswipe-leaderboard-service/internal/service"
+)
+
+// Scheduler archives the windowed leaderboards once their window closed. Every
+// window closes at a UTC midnight, a run at startup catches up on missed ones.
+type Scheduler struct {
+	leaderboardService service.LeaderboardService
+	stopChan           chan struct{}
+}
+
+func NewScheduler(leaderboardService service.LeaderboardService) *Scheduler {
+	return &Scheduler{
+		leaderboardService: leaderboardService,
+		stopChan:           make(chan struct{}),
+	}
+}
+
+func (s *Scheduler) Start() {
+	s.archiveClosedPeriods()
+
+	now := time.Now().UTC()
+	nextMidnight := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	durationUntilMidnight := nextMidnight.Sub(now)
+
+	log.Printf("Next leaderboard archive scheduled at: %s (in %v)",
+		nextMidnight.Format(time.RFC3339), durationUntilMidnight)
+
+	timer := time.NewTimer(durationUntilMidnight)
+
+	for {
+		select {
+		case <-timer.C:
+			s.archiveClosedPeriods()
+			timer.Reset(24 * time.Hour)
+
+		case <-s.stopChan:
+			timer.Stop()
+			log.Println("Leaderboard archive scheduler stopped")
+			return
+		}
+	}
+}
+
+func (s *Scheduler) Stop() error {
+	close(s.stopChan)
+	return nil
+}
+
+func (s *Scheduler) archiveClosedPeriods() {
+	if err := s.leaderboardService.ArchiveClosedPeriods(context.Background()); err != nil {
+		log.Printf("ERROR: Failed to archive closed leaderboard periods: %v", err)
+	}
+}
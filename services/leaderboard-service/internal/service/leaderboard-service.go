@@ -2,22 +2,44 @@ package service
 
 import (
 	"context"
+	"time"
 
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
 	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	leaderboarderrors "github.com/burakmert236/goodswipe-leaderboard-service/internal/errors"
+	"github.com/burakmert236/goodswipe-leaderboard-service/internal/period"
 	"github.com/burakmert236/goodswipe-leaderboard-service/internal/repository"
 )
 
+// Around me windows reach at most this many entries to each side of the user
+const maxAroundMe = 100
+
 type LeaderboardService interface {
 	// Write Operations
-	AddGlobalUser(ctx context.Context, userId, displayName string) *apperrors.AppError
+	AddGlobalUser(ctx context.Context, userId, displayName, country string) *apperrors.AppError
+	UpdateDisplayName(ctx context.Context, userId, displayName string) *apperrors.AppError
+	UpdateCountry(ctx context.Context, userId, country string) *apperrors.AppError
+	RemoveUser(ctx context.Context, userId string) *apperrors.AppError
 	AddUserToTournament(ctx context.Context, userId, displayName, groupId, tournamentId string) *apperrors.AppError
-	UpdateTournamentScore(ctx context.Context, userId, tournamentId string, score int) *apperrors.AppError
+	UpdateTournamentScore(ctx context.Context, userId, tournamentId string, score int, scoredAt time.Time) (*repository.ScoreUpdate, *apperrors.AppError)
+	AwardSeasonPoints(ctx context.Context, tournamentId, seasonId string, pointsMap map[string]int) *apperrors.AppError
+	AddFriendship(ctx context.Context, userId, friendId string) *apperrors.AppError
+	RemoveFriendship(ctx context.Context, userId, friendId string) *apperrors.AppError
+	SetUserBanned(ctx context.Context, userId string, banned bool) *apperrors.AppError
+	ArchiveClosedPeriods(ctx context.Context) *apperrors.AppError
+	MigrateLeaderboards(ctx context.Context) *apperrors.AppError
 
 	// Read Operations
-	GetGlobalLeaderboard(ctx context.Context) ([]repository.LeaderboardEntry, *apperrors.AppError)
-	GetTournamentLeaderboard(ctx context.Context, userId, tournamentId string) ([]repository.LeaderboardEntry, *apperrors.AppError)
-	GetTournamentRank(ctx context.Context, userId, tournamentId string) (int, *apperrors.AppError)
+	GetGlobalLeaderboard(ctx context.Context, userId string, p period.Period, periodId string, page repository.PageRequest) (*repository.LeaderboardPage, *apperrors.AppError)
+	GetRegionalLeaderboard(ctx context.Context, userId, country string, p period.Period, page repository.PageRequest) (*repository.LeaderboardPage, *apperrors.AppError)
+	GetTournamentLeaderboard(ctx context.Context, userId, tournamentId string, page repository.PageRequest) (*repository.LeaderboardPage, *apperrors.AppError)
+	GetTournamentRank(ctx context.Context, userId, tournamentId string, excludeBots bool) (int, *apperrors.AppError)
+	GetSeasonLeaderboard(ctx context.Context, seasonId string, limit int) ([]repository.LeaderboardEntry, *apperrors.AppError)
+	GetSeasonAppliedTournaments(ctx context.Context, seasonId string) ([]string, *apperrors.AppError)
+	GetFriendsLeaderboard(ctx context.Context, userId, tournamentId string) ([]repository.LeaderboardEntry, *apperrors.AppError)
+	GetTournamentStandings(ctx context.Context, tournamentId string) ([]repository.Standing, *apperrors.AppError)
+	IsAnyUserDeleted(ctx context.Context, userIds ...string) (bool, *apperrors.AppError)
 }
 
 type leaderboardService struct {
@@ -37,10 +59,10 @@ func NewLeaderboardService(
 
 // Write Operations
 
-func (s *leaderboardService) AddGlobalUser(ctx context.Context, userId, displayName string) *apperrors.AppError {
+func (s *leaderboardService) AddGlobalUser(ctx context.Context, userId, displayName, country string) *apperrors.AppError {
 	s.logger.Info("Adding global user")
 
-	if err := s.leaderboardRepo.AddGlobalUser(ctx, userId, displayName); err != nil {
+	if err := s.leaderboardRepo.AddGlobalUser(ctx, userId, displayName, country); err != nil {
 		return err
 	}
 
@@ -48,6 +70,20 @@ func (s *leaderboardService) AddGlobalUser(ctx context.Context, userId, displayN
 	return nil
 }
 
+func (s *leaderboardService) UpdateDisplayName(ctx context.Context, userId, displayName string) *apperrors.AppError {
+	return s.leaderboardRepo.SetDisplayName(ctx, userId, displayName)
+}
+
+func (s *leaderboardService) UpdateCountry(ctx context.Context, userId, country string) *apperrors.AppError {
+	s.logger.Info("Updating user country", "user_id", userId, "country", country)
+
+	return s.leaderboardRepo.SetCountry(ctx, userId, country)
+}
+
+func (s *leaderboardService) RemoveUser(ctx context.Context, userId string) *apperrors.AppError {
+	return s.leaderboardRepo.RemoveUser(ctx, userId)
+}
+
 func (s *leaderboardService) AddUserToTournament(
 	ctx context.Context,
 	userId, displayName, groupId, tournamentId string,
@@ -66,41 +102,161 @@ func (s *leaderboardService) UpdateTournamentScore(
 	ctx context.Context,
 	userId, tournamentId string,
 	score int,
-) *apperrors.AppError {
+	scoredAt time.Time,
+) (*repository.ScoreUpdate, *apperrors.AppError) {
 	s.logger.Info("Updating tournament score")
 
-	if err := s.leaderboardRepo.UpdateTournamentScore(ctx, userId, tournamentId, score); err != nil {
+	update, err := s.leaderboardRepo.UpdateTournamentScore(ctx, userId, tournamentId, score, scoredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Tournament score updated", "overtaken", len(update.Overtaken))
+	return update, nil
+}
+
+func (s *leaderboardService) AwardSeasonPoints(
+	ctx context.Context,
+	tournamentId, seasonId string,
+	pointsMap map[string]int,
+) *apperrors.AppError {
+	s.logger.Info("Awarding season points",
+		"tournament_id", tournamentId,
+		"season_id", seasonId,
+	)
+
+	if err := s.leaderboardRepo.AwardSeasonPoints(ctx, tournamentId, seasonId, pointsMap); err != nil {
 		return err
 	}
 
-	s.logger.Info("Tournament score updated")
+	s.logger.Info("Season points awarded")
 	return nil
 }
 
+func (s *leaderboardService) AddFriendship(ctx context.Context, userId, friendId string) *apperrors.AppError {
+	return s.leaderboardRepo.AddFriendship(ctx, userId, friendId)
+}
+
+func (s *leaderboardService) RemoveFriendship(ctx context.Context, userId, friendId string) *apperrors.AppError {
+	return s.leaderboardRepo.RemoveFriendship(ctx, userId, friendId)
+}
+
+func (s *leaderboardService) SetUserBanned(ctx context.Context, userId string, banned bool) *apperrors.AppError {
+	s.logger.Info("Setting banned user", "user_id", userId, "banned", banned)
+
+	return s.leaderboardRepo.SetBanned(ctx, userId, banned)
+}
+
+// ArchiveClosedPeriods stores the final standings of every closed window whose
+// live leaderboard is still kept, so windows missed while the service was down
+// are caught up. Windows archived before are left as they are.
+func (s *leaderboardService) ArchiveClosedPeriods(ctx context.Context) *apperrors.AppError {
+	now := time.Now()
+
+	for _, p := range period.Windowed {
+		for _, periodId := range p.RetainedClosedIds(now) {
+			if err := s.leaderboardRepo.ArchivePeriod(ctx, p, periodId); err != nil {
+				return err
+			}
+			s.logger.Debug("Period archived", "period", p, "period_id", periodId)
+		}
+	}
+
+	return nil
+}
+
+// MigrateLeaderboards brings leaderboards written by earlier versions to the
+// current layout. Every migration runs once.
+func (s *leaderboardService) MigrateLeaderboards(ctx context.Context) *apperrors.AppError {
+	// Banned users are moved out of the rebuilt lifetime leaderboard
+	if err := s.leaderboardRepo.RebuildLifetimeLeaderboard(ctx); err != nil {
+		return err
+	}
+
+	return s.leaderboardRepo.ShadowBannedUsers(ctx)
+}
+
 // Read Operations
 
-func (s *leaderboardService) GetGlobalLeaderboard(ctx context.Context) ([]repository.LeaderboardEntry, *apperrors.AppError) {
-	s.logger.Info("Getting global leaderboard")
+func (s *leaderboardService) GetGlobalLeaderboard(
+	ctx context.Context,
+	userId string,
+	p period.Period,
+	periodId string,
+	page repository.PageRequest,
+) (*repository.LeaderboardPage, *apperrors.AppError) {
+	s.logger.Info("Getting global leaderboard", "period", p, "period_id", periodId)
 
-	entries, err := s.leaderboardRepo.GetGlobalLeaderboard(ctx)
+	page, err := normalizePage(page)
 	if err != nil {
 		return nil, err
 	}
 
-	s.logger.Info("Global leaderboard retrieved", "count", len(entries))
-	return entries, nil
+	// All-time has a single window, the others default to the current one
+	if p == period.AllTime {
+		periodId = ""
+	} else if periodId == "" {
+		periodId = p.Id(time.Now())
+	} else if err := p.ValidateId(periodId); err != nil {
+		return nil, err
+	}
+
+	leaderboardPage, err := s.leaderboardRepo.GetGlobalLeaderboard(ctx, userId, p, periodId, page)
+	if err != nil {
+		return nil, err
+	}
+	leaderboardPage.PeriodId = periodId
+
+	s.logger.Info("Global leaderboard retrieved", "count", len(leaderboardPage.Entries))
+	return leaderboardPage, nil
+}
+
+func (s *leaderboardService) GetRegionalLeaderboard(
+	ctx context.Context,
+	userId, country string,
+	p period.Period,
+	page repository.PageRequest,
+) (*repository.LeaderboardPage, *apperrors.AppError) {
+	s.logger.Info("Getting regional leaderboard", "country", country, "period", p)
+
+	country = models.NormalizeCountry(country)
+	if !models.IsValidCountry(country) {
+		return nil, leaderboarderrors.InvalidCountryError(country)
+	}
+
+	page, err := normalizePage(page)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboardPage, err := s.leaderboardRepo.GetRegionalLeaderboard(ctx, userId, country, p, page)
+	if err != nil {
+		return nil, err
+	}
+	if p != period.AllTime {
+		leaderboardPage.PeriodId = p.Id(time.Now())
+	}
+
+	s.logger.Info("Regional leaderboard retrieved", "country", country, "count", len(leaderboardPage.Entries))
+	return leaderboardPage, nil
 }
 
 func (s *leaderboardService) GetTournamentLeaderboard(
 	ctx context.Context,
 	userId, tournamentId string,
-) ([]repository.LeaderboardEntry, *apperrors.AppError) {
+	page repository.PageRequest,
+) (*repository.LeaderboardPage, *apperrors.AppError) {
 	s.logger.Info("Getting tournament leaderboard",
 		"user_id", userId,
 		"tournament_id", tournamentId,
 	)
 
-	entries, err := s.leaderboardRepo.GetGroupLeaderboard(ctx, userId, tournamentId)
+	page, err := normalizePage(page)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboardPage, err := s.leaderboardRepo.GetGroupLeaderboard(ctx, userId, tournamentId, page)
 	if err != nil {
 		return nil, err
 	}
@@ -108,21 +264,23 @@ func (s *leaderboardService) GetTournamentLeaderboard(
 	s.logger.Info("Tournament leaderboard retrieved",
 		"user_id", userId,
 		"tournament_id", tournamentId,
-		"count", len(entries),
+		"count", len(leaderboardPage.Entries),
 	)
-	return entries, nil
+	return leaderboardPage, nil
 }
 
 func (s *leaderboardService) GetTournamentRank(
 	ctx context.Context,
 	userId, tournamentId string,
+	excludeBots bool,
 ) (int, *apperrors.AppError) {
 	s.logger.Info("Getting tournament rank",
 		"user_id", userId,
 		"tournament_id", tournamentId,
+		"exclude_bots", excludeBots,
 	)
 
-	rank, err := s.leaderboardRepo.GetGroupRank(ctx, userId, tournamentId)
+	rank, err := s.leaderboardRepo.GetGroupRank(ctx, userId, tournamentId, excludeBots)
 	if err != nil {
 		return 0, err
 	}
@@ -131,3 +289,92 @@ func (s *leaderboardService) GetTournamentRank(
 
 	return int(rank + 1), nil
 }
+
+func (s *leaderboardService) GetSeasonLeaderboard(
+	ctx context.Context,
+	seasonId string,
+	limit int,
+) ([]repository.LeaderboardEntry, *apperrors.AppError) {
+	s.logger.Info("Getting season leaderboard", "season_id", seasonId)
+
+	if limit <= 0 || limit > repository.GlobalLeaderboardLimit {
+		limit = repository.GlobalLeaderboardLimit
+	}
+
+	entries, err := s.leaderboardRepo.GetSeasonLeaderboard(ctx, seasonId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Season leaderboard retrieved", "season_id", seasonId, "count", len(entries))
+	return entries, nil
+}
+
+func (s *leaderboardService) GetSeasonAppliedTournaments(ctx context.Context, seasonId string) ([]string, *apperrors.AppError) {
+	return s.leaderboardRepo.GetSeasonAppliedTournaments(ctx, seasonId)
+}
+
+func (s *leaderboardService) GetFriendsLeaderboard(
+	ctx context.Context,
+	userId, tournamentId string,
+) ([]repository.LeaderboardEntry, *apperrors.AppError) {
+	s.logger.Info("Getting friends leaderboard",
+		"user_id", userId,
+		"tournament_id", tournamentId,
+	)
+
+	entries, err := s.leaderboardRepo.GetFriendsLeaderboard(ctx, userId, tournamentId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Friends leaderboard retrieved",
+		"user_id", userId,
+		"tournament_id", tournamentId,
+		"count", len(entries),
+	)
+	return entries, nil
+}
+
+func (s *leaderboardService) GetTournamentStandings(
+	ctx context.Context,
+	tournamentId string,
+) ([]repository.Standing, *apperrors.AppError) {
+	s.logger.Info("Getting tournament standings", "tournament_id", tournamentId)
+
+	standings, err := s.leaderboardRepo.GetTournamentStandings(ctx, tournamentId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Tournament standings retrieved",
+		"tournament_id", tournamentId,
+		"count", len(standings),
+	)
+	return standings, nil
+}
+
+// IsAnyUserDeleted reports whether the data of any of the users was removed
+func (s *leaderboardService) IsAnyUserDeleted(ctx context.Context, userIds ...string) (bool, *apperrors.AppError) {
+	return s.leaderboardRepo.IsAnyUserDeleted(ctx, userIds...)
+}
+
+// Private methods
+
+// normalizePage caps the page size and the around me window, a missing limit
+// returns as many entries as a page can hold
+func normalizePage(page repository.PageRequest) (repository.PageRequest, *apperrors.AppError) {
+	if page.Offset < 0 || page.AroundMe < 0 {
+		return page, apperrors.New(apperrors.CodeInvalidInput, "offset and around me must not be negative")
+	}
+
+	if page.Limit <= 0 || page.Limit > repository.GlobalLeaderboardLimit {
+		page.Limit = repository.GlobalLeaderboardLimit
+	}
+
+	if page.AroundMe > maxAroundMe {
+		page.AroundMe = maxAroundMe
+	}
+
+	return page, nil
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/burakmert236/goodswipe-common/auth"
 	"github.com/burakmert236/goodswipe-common/config"
 	"github.com/burakmert236/goodswipe-common/database"
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
@@ -33,6 +34,8 @@ type App struct {
 	natsClient        *natsjetstream.Client
 	logger            *logger.Logger
 	tournamentService service.TournamentService
+	seasonService     service.SeasonService
+	botService        service.BotService
 	userClient        protogrpc.UserServiceClient
 	leaderboardClient protogrpc.LeaderboardServiceClient
 	scheduler         *scheduler.Scheduler
@@ -141,7 +144,10 @@ func (a *App) initMessageSubscriber(ctx context.Context) *apperrors.AppError {
 
 func (a *App) initUserClient() *apperrors.AppError {
 	userServiceAddr := a.cfg.Server.UserServiceAddress
-	userConn, err := grpc.NewClient(userServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	userConn, err := grpc.NewClient(userServiceAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.ServiceCredentialsClientInterceptor(a.cfg.Server.ApiKey)),
+	)
 	if err != nil {
 		a.logger.Fatal("Failed to connect to User Service: %v", err)
 	}
@@ -156,7 +162,10 @@ func (a *App) initUserClient() *apperrors.AppError {
 
 func (a *App) initLeaderboardClient() *apperrors.AppError {
 	leaderboardServiceAddr := a.cfg.Server.LeaderboardServiceAddress
-	leaderboardConn, err := grpc.NewClient(leaderboardServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	leaderboardConn, err := grpc.NewClient(leaderboardServiceAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.ServiceCredentialsClientInterceptor(a.cfg.Server.ApiKey)),
+	)
 	if err != nil {
 		apperrors.Wrap(err, apperrors.CodeInternalServer, "Failed to connect to Laderboard Service")
 	}
@@ -173,12 +182,16 @@ func (a *App) initGRPC() *apperrors.AppError {
 	tournamentRepo := repository.NewTournamentRepository(a.db)
 	participationRepo := repository.NewParticipationRRepository(a.db)
 	groupRepo := repository.NewGroupRepository(a.db)
+	seasonRepo := repository.NewSeasonRepository(a.db)
+	deletedUserRepo := repository.NewDeletedUserRepository(a.db)
 	transactionRepo := database.NewTransactionRepository(a.db)
 
 	a.tournamentService = service.NewTournamentService(
 		tournamentRepo,
 		participationRepo,
 		groupRepo,
+		seasonRepo,
+		deletedUserRepo,
 		transactionRepo,
 		a.userClient,
 		a.leaderboardClient,
@@ -186,10 +199,46 @@ func (a *App) initGRPC() *apperrors.AppError {
 		a.logger,
 	)
 
+	var pointsAckCutover time.Time
+	if a.cfg.Seasons.PointsAckCutover != "" {
+		parsed, err := time.Parse(time.RFC3339, a.cfg.Seasons.PointsAckCutover)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeInvalidInput, "seasons pointsAckCutover must be an RFC3339 time")
+		}
+		pointsAckCutover = parsed
+	}
+
+	a.seasonService = service.NewSeasonService(
+		seasonRepo,
+		tournamentRepo,
+		a.userClient,
+		a.leaderboardClient,
+		pointsAckCutover,
+		a.logger,
+	)
+
+	a.botService = service.NewBotService(
+		tournamentRepo,
+		participationRepo,
+		groupRepo,
+		transactionRepo,
+		a.eventPublisher,
+		a.logger,
+	)
+
 	tournamentHandler := handler.NewTournamentHandler(a.tournamentService, a.logger)
 
+	interceptors := []grpc.UnaryServerInterceptor{a.loggingInterceptor}
+	if a.cfg.Auth.Enabled {
+		authenticator, err := auth.NewAuthenticator(a.cfg.Auth, a.cfg.Server.ServiceCredentials)
+		if err != nil {
+			return err
+		}
+		interceptors = append(interceptors, auth.UnaryServerInterceptor(authenticator, methodPolicies))
+	}
+
 	a.grpcServer = grpc.NewServer(
-		grpc.UnaryInterceptor(a.loggingInterceptor),
+		grpc.ChainUnaryInterceptor(interceptors...),
 	)
 
 	protogrpc.RegisterTournamentServiceServer(a.grpcServer, tournamentHandler)
@@ -205,7 +254,9 @@ func (a *App) initMessagePublisher() *apperrors.AppError {
 
 func (a *App) initScheduler() *apperrors.AppError {
 	tournamentSchedular := scheduler.NewTournamentScheduler(a.tournamentService)
-	a.scheduler = scheduler.NewScheduler(tournamentSchedular)
+	seasonScheduler := scheduler.NewSeasonScheduler(a.seasonService)
+	botScheduler := scheduler.NewBotScheduler(a.botService)
+	a.scheduler = scheduler.NewScheduler(tournamentSchedular, seasonScheduler, botScheduler)
 
 	a.cleanup = append(a.cleanup, a.scheduler.Stop)
 
@@ -256,3 +307,8 @@ func (a *App) loggingInterceptor(ctx context.Context, req interface{}, info *grp
 	a.logger.Info(fmt.Sprintf("Method: %s, Duration: %v", info.FullMethod, time.Since(start)))
 	return resp, err
 }
+
+var methodPolicies = auth.MethodPolicies{
+	protogrpc.TournamentService_EnterTournament_FullMethodName: auth.AllowRoles(auth.RolePlayer),
+	protogrpc.TournamentService_ClaimReward_FullMethodName:     auth.AllowRoles(auth.RolePlayer),
+}
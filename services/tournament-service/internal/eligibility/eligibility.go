@@ -0,0 +1,130 @@
+package eligibility
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// Subject is the user data that eligibility rules are evaluated against
+type Subject struct {
+	UserId             string
+	Level              int
+	Coin               int
+	CreatedAt          time.Time
+	Region             string
+	IsBanned           bool
+	ParticipationCount int
+}
+
+// Violation describes a single failed rule in a client presentable way
+type Violation struct {
+	Rule        models.EligibilityRuleType
+	Field       string
+	Description string
+}
+
+type predicate func(rule models.EligibilityRule, subject Subject, now time.Time) *Violation
+
+var predicates = map[models.EligibilityRuleType]predicate{
+	models.EligibilityMinLevel:          minLevel,
+	models.EligibilityMaxLevel:          maxLevel,
+	models.EligibilityMinAccountAgeDays: minAccountAgeDays,
+	models.EligibilityRegionIn:          regionIn,
+	models.EligibilityMinCoin:           minCoin,
+	models.EligibilityMinParticipations: minParticipations,
+	models.EligibilityMaxParticipations: maxParticipations,
+	models.EligibilityNotBanned:         notBanned,
+}
+
+// Evaluate runs every rule against the subject and returns all violations,
+// an empty result means the subject is eligible
+func Evaluate(rules []models.EligibilityRule, subject Subject, now time.Time) []Violation {
+	violations := make([]Violation, 0)
+
+	for _, rule := range rules {
+		check, exists := predicates[rule.Type]
+		if !exists {
+			violations = append(violations, Violation{
+				Rule:        rule.Type,
+				Field:       "rule",
+				Description: fmt.Sprintf("unknown eligibility rule: %s", rule.Type),
+			})
+			continue
+		}
+
+		if violation := check(rule, subject, now); violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+
+	return violations
+}
+
+// RequiresParticipationCount reports whether any rule needs the user's participation history
+func RequiresParticipationCount(rules []models.EligibilityRule) bool {
+	return slices.ContainsFunc(rules, func(rule models.EligibilityRule) bool {
+		return rule.Type == models.EligibilityMinParticipations || rule.Type == models.EligibilityMaxParticipations
+	})
+}
+
+// Predicates
+
+func minLevel(rule models.EligibilityRule, subject Subject, _ time.Time) *Violation {
+	if subject.Level >= rule.Value {
+		return nil
+	}
+	return &Violation{rule.Type, "level", fmt.Sprintf("user level must be at least %d", rule.Value)}
+}
+
+func maxLevel(rule models.EligibilityRule, subject Subject, _ time.Time) *Violation {
+	if subject.Level <= rule.Value {
+		return nil
+	}
+	return &Violation{rule.Type, "level", fmt.Sprintf("user level must be at most %d", rule.Value)}
+}
+
+func minAccountAgeDays(rule models.EligibilityRule, subject Subject, now time.Time) *Violation {
+	if !subject.CreatedAt.IsZero() && now.Sub(subject.CreatedAt) >= time.Duration(rule.Value)*24*time.Hour {
+		return nil
+	}
+	return &Violation{rule.Type, "created_at", fmt.Sprintf("account must be at least %d days old", rule.Value)}
+}
+
+func regionIn(rule models.EligibilityRule, subject Subject, _ time.Time) *Violation {
+	if slices.ContainsFunc(rule.Values, func(region string) bool { return strings.EqualFold(region, subject.Region) }) {
+		return nil
+	}
+	return &Violation{rule.Type, "region", fmt.Sprintf("tournament is only available in: %s", strings.Join(rule.Values, ", "))}
+}
+
+func minCoin(rule models.EligibilityRule, subject Subject, _ time.Time) *Violation {
+	if subject.Coin >= rule.Value {
+		return nil
+	}
+	return &Violation{rule.Type, "coin", fmt.Sprintf("coin balance must be at least %d", rule.Value)}
+}
+
+func minParticipations(rule models.EligibilityRule, subject Subject, _ time.Time) *Violation {
+	if subject.ParticipationCount >= rule.Value {
+		return nil
+	}
+	return &Violation{rule.Type, "participation_count", fmt.Sprintf("user must have entered at least %d tournaments", rule.Value)}
+}
+
+func maxParticipations(rule models.EligibilityRule, subject Subject, _ time.Time) *Violation {
+	if subject.ParticipationCount <= rule.Value {
+		return nil
+	}
+	return &Violation{rule.Type, "participation_count", fmt.Sprintf("user must have entered at most %d tournaments", rule.Value)}
+}
+
+func notBanned(rule models.EligibilityRule, subject Subject, _ time.Time) *Violation {
+	if !subject.IsBanned {
+		return nil
+	}
+	return &Violation{rule.Type, "ban_status", "user is banned from tournaments"}
+}
@@ -0,0 +1,122 @@
+package eligibility
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	subject := Subject{
+		UserId:             "user-1",
+		Level:              10,
+		Coin:               500,
+		CreatedAt:          now.Add(-7 * 24 * time.Hour),
+		Region:             "DE",
+		ParticipationCount: 3,
+	}
+
+	rule := func(ruleType models.EligibilityRuleType, value int, values ...string) models.EligibilityRule {
+		return models.EligibilityRule{Type: ruleType, Value: value, Values: values}
+	}
+
+	tests := []struct {
+		name       string
+		rules      []models.EligibilityRule
+		subject    func(subject *Subject)
+		wantFields []string
+	}{
+		{name: "no rules"},
+		{name: "min level met", rules: []models.EligibilityRule{rule(models.EligibilityMinLevel, 10)}},
+		{name: "min level missed", rules: []models.EligibilityRule{rule(models.EligibilityMinLevel, 11)}, wantFields: []string{"level"}},
+		{name: "max level met", rules: []models.EligibilityRule{rule(models.EligibilityMaxLevel, 10)}},
+		{name: "max level exceeded", rules: []models.EligibilityRule{rule(models.EligibilityMaxLevel, 9)}, wantFields: []string{"level"}},
+		{name: "account old enough", rules: []models.EligibilityRule{rule(models.EligibilityMinAccountAgeDays, 7)}},
+		{name: "account too new", rules: []models.EligibilityRule{rule(models.EligibilityMinAccountAgeDays, 8)}, wantFields: []string{"created_at"}},
+		{
+			name:       "account without creation time",
+			rules:      []models.EligibilityRule{rule(models.EligibilityMinAccountAgeDays, 0)},
+			subject:    func(subject *Subject) { subject.CreatedAt = time.Time{} },
+			wantFields: []string{"created_at"},
+		},
+		{name: "region listed", rules: []models.EligibilityRule{rule(models.EligibilityRegionIn, 0, "TR", "DE")}},
+		{name: "region listed in another case", rules: []models.EligibilityRule{rule(models.EligibilityRegionIn, 0, "de")}},
+		{name: "region not listed", rules: []models.EligibilityRule{rule(models.EligibilityRegionIn, 0, "TR")}, wantFields: []string{"region"}},
+		{
+			name:       "no region",
+			rules:      []models.EligibilityRule{rule(models.EligibilityRegionIn, 0, "TR")},
+			subject:    func(subject *Subject) { subject.Region = "" },
+			wantFields: []string{"region"},
+		},
+		{name: "min coin met", rules: []models.EligibilityRule{rule(models.EligibilityMinCoin, 500)}},
+		{name: "min coin missed", rules: []models.EligibilityRule{rule(models.EligibilityMinCoin, 501)}, wantFields: []string{"coin"}},
+		{name: "min participations met", rules: []models.EligibilityRule{rule(models.EligibilityMinParticipations, 3)}},
+		{name: "min participations missed", rules: []models.EligibilityRule{rule(models.EligibilityMinParticipations, 4)}, wantFields: []string{"participation_count"}},
+		{name: "max participations met", rules: []models.EligibilityRule{rule(models.EligibilityMaxParticipations, 3)}},
+		{name: "max participations exceeded", rules: []models.EligibilityRule{rule(models.EligibilityMaxParticipations, 2)}, wantFields: []string{"participation_count"}},
+		{name: "not banned", rules: []models.EligibilityRule{rule(models.EligibilityNotBanned, 0)}},
+		{
+			name:       "banned",
+			rules:      []models.EligibilityRule{rule(models.EligibilityNotBanned, 0)},
+			subject:    func(subject *Subject) { subject.IsBanned = true },
+			wantFields: []string{"ban_status"},
+		},
+		{name: "unknown rule", rules: []models.EligibilityRule{rule("MIN_FRIENDS", 1)}, wantFields: []string{"rule"}},
+		{
+			name: "every violation is reported in rule order",
+			rules: []models.EligibilityRule{
+				rule(models.EligibilityRegionIn, 0, "TR"),
+				rule(models.EligibilityMinLevel, 5),
+				rule(models.EligibilityMinCoin, 1000),
+				rule(models.EligibilityNotBanned, 0),
+			},
+			subject:    func(subject *Subject) { subject.IsBanned = true },
+			wantFields: []string{"region", "coin", "ban_status"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluated := subject
+			if tt.subject != nil {
+				tt.subject(&evaluated)
+			}
+
+			fields := make([]string, 0)
+			for _, violation := range Evaluate(tt.rules, evaluated, now) {
+				fields = append(fields, violation.Field)
+			}
+
+			if tt.wantFields == nil {
+				tt.wantFields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("violations on %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestRequiresParticipationCount(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []models.EligibilityRule
+		want  bool
+	}{
+		{name: "no rules", want: false},
+		{name: "level only", rules: []models.EligibilityRule{{Type: models.EligibilityMinLevel, Value: 5}}, want: false},
+		{name: "min participations", rules: []models.EligibilityRule{{Type: models.EligibilityMinParticipations, Value: 1}}, want: true},
+		{name: "max participations", rules: []models.EligibilityRule{{Type: models.EligibilityMaxParticipations, Value: 1}}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RequiresParticipationCount(tt.rules); got != tt.want {
+				t.Errorf("RequiresParticipationCount = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,9 +2,13 @@ package errors
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-tournament-service/internal/eligibility"
 )
 
 func ClaimRewardError() *apperrors.AppError {
@@ -17,9 +21,22 @@ func TournamentDateError(date time.Time) *apperrors.AppError {
 		fmt.Sprintf("tournament last participation date is over: %s", date.Format(time.RFC3339)))
 }
 
-func UserLevelLimitError(limit int) *apperrors.AppError {
-	return apperrors.New(apperrors.CodeForbidden,
-		fmt.Sprintf("user level must be at least %d", limit))
+func EligibilityError(violations []eligibility.Violation) *apperrors.AppError {
+	descriptions := make([]string, len(violations))
+	failure := &errdetails.PreconditionFailure{}
+
+	for i, violation := range violations {
+		descriptions[i] = violation.Description
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        string(violation.Rule),
+			Subject:     violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	return apperrors.New(apperrors.CodePreconditionFailed,
+		fmt.Sprintf("user is not eligible for tournament: %s", strings.Join(descriptions, "; "))).
+		WithDetails(failure)
 }
 
 func InvalidRankingError() *apperrors.AppError {
@@ -29,3 +46,12 @@ func InvalidRankingError() *apperrors.AppError {
 func TournamentNotFinishedError() *apperrors.AppError {
 	return apperrors.New(apperrors.CodeForbidden, "tournament is not finished yet")
 }
+
+func UserSuspendedError(until time.Time) *apperrors.AppError {
+	return apperrors.New(apperrors.CodeForbidden,
+		fmt.Sprintf("user is suspended until %s", until.Format(time.RFC3339)))
+}
+
+func UserBannedError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeForbidden, "user is banned")
+}
@@ -9,6 +9,7 @@ import (
 	commonevents "github.com/burakmert236/goodswipe-common/events"
 	protoevents "github.com/burakmert236/goodswipe-common/generated/v1/events"
 	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
 	"github.com/burakmert236/goodswipe-common/natsjetstream"
 )
 
@@ -27,6 +28,7 @@ func NewEventPublisher(client *natsjetstream.Client, logger *logger.Logger) *Eve
 func (p *EventPublisher) PublishTournamentEntered(
 	ctx context.Context,
 	userId, displayName, groupId, tournamentId string,
+	isBot bool,
 ) *apperrors.AppError {
 	event := &protoevents.TournamentEntered{
 		UserId:       userId,
@@ -34,6 +36,7 @@ func (p *EventPublisher) PublishTournamentEntered(
 		GroupId:      groupId,
 		TournamentId: tournamentId,
 		TimeStamp:    time.Now().UTC().Unix(),
+		IsBot:        isBot,
 	}
 
 	if err := p.publisher.PublishProto(ctx, commonevents.TournamentEntered, event); err != nil {
@@ -67,3 +70,78 @@ func (p *EventPublisher) PublishTournamentParticipationScoreUpdated(
 	p.logger.Info(fmt.Sprintf("Published tournament score updated event for user: %s", userId))
 	return nil
 }
+
+func (p *EventPublisher) PublishTournamentFinished(
+	ctx context.Context,
+	tournamentId, seasonId string,
+	seasonPointsMap map[string]int,
+	rewardingMap map[string]int,
+	rewardCurrency models.Currency,
+) *apperrors.AppError {
+	pointsMap := make(map[string]int32, len(seasonPointsMap))
+	for placement, points := range seasonPointsMap {
+		pointsMap[placement] = int32(points)
+	}
+
+	rewards := make(map[string]int32, len(rewardingMap))
+	for placement, reward := range rewardingMap {
+		rewards[placement] = int32(reward)
+	}
+
+	event := &protoevents.TournamentFinished{
+		TournamentId:    tournamentId,
+		SeasonId:        seasonId,
+		SeasonPointsMap: pointsMap,
+		TimeStamp:       time.Now().UTC().Unix(),
+		RewardingMap:    rewards,
+		RewardCurrency:  string(models.CurrencyOrDefault(string(rewardCurrency))),
+	}
+
+	if err := p.publisher.PublishProto(ctx, commonevents.TournamentFinished, event); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to publish tournament finished event: %v", err))
+		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish tournament finished event")
+	}
+
+	p.logger.Info(fmt.Sprintf("Published tournament finished event for tournament: %s", tournamentId))
+	return nil
+}
+
+func (p *EventPublisher) PublishTournamentRewardClaimed(
+	ctx context.Context,
+	userId, tournamentId string,
+	rank, reward int,
+	currency models.Currency,
+) *apperrors.AppError {
+	event := &protoevents.TournamentRewardClaimed{
+		UserId:       userId,
+		TournamentId: tournamentId,
+		Rank:         int32(rank),
+		Reward:       int32(reward),
+		Currency:     string(currency),
+		TimeStamp:    time.Now().UTC().Unix(),
+	}
+
+	if err := p.publisher.PublishProto(ctx, commonevents.TournamentRewardClaimed, event); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to publish tournament reward claimed event: %v", err))
+		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish tournament reward claimed event")
+	}
+
+	p.logger.Info(fmt.Sprintf("Published tournament reward claimed event for user: %s", userId))
+	return nil
+}
+
+func (p *EventPublisher) PublishUserDataPurged(ctx context.Context, userId, service string) *apperrors.AppError {
+	event := &protoevents.UserDataPurged{
+		UserId:    userId,
+		Service:   service,
+		TimeStamp: time.Now().UTC().Unix(),
+	}
+
+	if err := p.publisher.PublishProto(ctx, commonevents.UserDataPurged, event); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to publish user data purged event: %v", err))
+		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish user data purged event")
+	}
+
+	p.logger.Info(fmt.Sprintf("Published user data purged event for user: %s", userId))
+	return nil
+}
@@ -68,6 +68,8 @@ func (s *EventSubscriber) handleUserEvents(ctx context.Context, msg jetstream.Ms
 	switch subject {
 	case commonevents.UserLevelUp:
 		return s.handleUserLevelUp(ctx, msg)
+	case commonevents.UserDeleted:
+		return s.handleUserDeleted(ctx, msg)
 	default:
 		s.logger.Warn("Unknown user event subject", "subject", subject)
 		return nil
@@ -89,6 +91,11 @@ func (s *EventSubscriber) handleUserLevelUp(ctx context.Context, msg jetstream.M
 		"new_level", event.NewLevel,
 	)
 
+	// Prestige resets gain no levels and do not score
+	if event.LevelIncrease <= 0 {
+		return nil
+	}
+
 	if err := s.tournamentService.UpdateParticipationScore(ctx, event.UserId, int(event.LevelIncrease)); err != nil {
 		s.logger.Error("Failed to update user progress",
 			"error", err,
@@ -101,3 +108,27 @@ func (s *EventSubscriber) handleUserLevelUp(ctx context.Context, msg jetstream.M
 
 	return nil
 }
+
+func (s *EventSubscriber) handleUserDeleted(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserDeleted
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user deleted event",
+			"error", err,
+		)
+		return err
+	}
+
+	s.logger.Info("Processing user deleted event", "user_id", event.UserId)
+
+	if err := s.tournamentService.PurgeUser(ctx, event.UserId); err != nil {
+		s.logger.Error("Failed to purge deleted user",
+			"error", err,
+			"user_id", event.UserId,
+		)
+		return err
+	}
+
+	s.logger.Info("User deleted event processed successfully")
+
+	return nil
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 
+	"github.com/burakmert236/goodswipe-common/auth"
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
 	proto "github.com/burakmert236/goodswipe-common/generated/v1/grpc"
 	"github.com/burakmert236/goodswipe-common/logger"
@@ -23,11 +24,12 @@ func NewTournamentHandler(TournamentService service.TournamentService, logger *l
 }
 
 func (h *TournamentHandler) EnterTournament(ctx context.Context, req *proto.EnterTournamentRequest) (*proto.EnterTournamentResponse, error) {
-	if req.UserId == "" {
-		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id is required"))
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
 	}
 
-	tournamentId, groupId, err := h.tournamentService.EnterTournament(ctx, req.UserId)
+	tournamentId, groupId, err := h.tournamentService.EnterTournament(ctx, userId)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
@@ -41,11 +43,16 @@ func (h *TournamentHandler) EnterTournament(ctx context.Context, req *proto.Ente
 }
 
 func (h *TournamentHandler) ClaimReward(ctx context.Context, req *proto.ClaimRewardRequest) (*proto.ClaimRewardResponse, error) {
-	if req.UserId == "" || req.TournamentId == "" {
-		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id and tournament id are required"))
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	if req.TournamentId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "tournament id is required"))
 	}
 
-	tournamentId, reward, err := h.tournamentService.ClaimReward(ctx, req.UserId, req.TournamentId)
+	tournamentId, reward, err := h.tournamentService.ClaimReward(ctx, userId, req.TournamentId)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// DeletedUserRepository reads the deletion tombstone the user service writes
+// before it announces a deleted user, so late work for that user is dropped
+type DeletedUserRepository interface {
+	IsDeleted(ctx context.Context, userId string) (bool, *apperrors.AppError)
+
+	// Transaction operations
+	GetConditionCheckForActiveUser(ctx context.Context, userId string) types.ConditionCheck
+}
+
+type deletedUserRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewDeletedUserRepository(db *database.DynamoDBClient) DeletedUserRepository {
+	return &deletedUserRepo{db: db}
+}
+
+func (r *deletedUserRepo) IsDeleted(ctx context.Context, userId string) (bool, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:            aws.String(r.db.Table()),
+		Key:                  r.key(userId),
+		ProjectionExpression: aws.String("PK"),
+	})
+
+	if err != nil {
+		return false, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get user deletion")
+	}
+
+	return result.Item != nil, nil
+}
+
+// Transaction Operations
+
+// GetConditionCheckForActiveUser fails the transaction once the user is deleted
+func (r *deletedUserRepo) GetConditionCheckForActiveUser(ctx context.Context, userId string) types.ConditionCheck {
+	return types.ConditionCheck{
+		TableName:           aws.String(r.db.Table()),
+		Key:                 r.key(userId),
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}
+}
+
+func (r *deletedUserRepo) key(userId string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"PK": &types.AttributeValueMemberS{Value: models.UserDeletionPK(userId)},
+		"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+	}
+}
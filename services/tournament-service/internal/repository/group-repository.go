@@ -16,9 +16,11 @@ import (
 type GroupRepository interface {
 	CreateGroup(ctx context.Context, group *models.Group) *apperrors.AppError
 	FindAvailableGroup(ctx context.Context, tournamentId string) (*models.Group, *apperrors.AppError)
+	ListUnderfilledGroups(ctx context.Context, tournamentId string, createdBefore time.Time) ([]models.Group, *apperrors.AppError)
 
 	// Transaction operations
 	GetTransactionForAddingParticipant(ctx context.Context, groupId string, tournamentId string) types.Update
+	GetTransactionForRemovingParticipant(ctx context.Context, groupId string, tournamentId string) types.Update
 }
 
 type groupRepo struct {
@@ -80,6 +82,40 @@ func (r *groupRepo) FindAvailableGroup(ctx context.Context, tournamentId string)
 	return &group, nil
 }
 
+func (r *groupRepo) ListUnderfilledGroups(
+	ctx context.Context,
+	tournamentId string,
+	createdBefore time.Time,
+) ([]models.Group, *apperrors.AppError) {
+	groups := make([]models.Group, 0)
+
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		FilterExpression:       aws.String("participant_count < group_size AND created_at <= :before"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: models.TournamentPK(tournamentId)},
+			":sk":     &types.AttributeValueMemberS{Value: models.GroupSKPrefix()},
+			":before": &types.AttributeValueMemberS{Value: createdBefore.UTC().Format(time.RFC3339)},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list underfilled groups")
+		}
+
+		var pageGroups []models.Group
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageGroups); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal groups")
+		}
+		groups = append(groups, pageGroups...)
+	}
+
+	return groups, nil
+}
+
 // Transaction Operations
 
 func (r *groupRepo) GetTransactionForAddingParticipant(
@@ -104,3 +140,26 @@ func (r *groupRepo) GetTransactionForAddingParticipant(
 		ConditionExpression: aws.String("attribute_not_exists(participant_count) OR participant_count < group_size"),
 	}
 }
+
+func (r *groupRepo) GetTransactionForRemovingParticipant(
+	ctx context.Context,
+	groupId string,
+	tournamentId string,
+) types.Update {
+	return types.Update{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.TournamentPK(tournamentId)},
+			"SK": &types.AttributeValueMemberS{Value: models.GroupSK(groupId)},
+		},
+		UpdateExpression: aws.String(`
+			SET participant_count = participant_count - :dec, updated_at = :now
+		`),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":dec":  &types.AttributeValueMemberN{Value: "1"},
+			":zero": &types.AttributeValueMemberN{Value: "0"},
+			":now":  &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
+		},
+		ConditionExpression: aws.String("participant_count > :zero"),
+	}
+}
@@ -21,9 +21,13 @@ type ParticipationRepository interface {
 	UpdateRewardUnclaimed(ctx context.Context, userId, tournamentId string) (*models.Participation, *apperrors.AppError)
 	UpdateRewardClaimed(ctx context.Context, userId, tournamentId string) (*models.Participation, *apperrors.AppError)
 	UpdateParticipationScore(ctx context.Context, userId, tournamentId string, gainedScore int) (*models.Participation, *apperrors.AppError)
+	ListBotParticipations(ctx context.Context, tournamentId string) ([]models.Participation, *apperrors.AppError)
+	CountByUser(ctx context.Context, userId string) (int, *apperrors.AppError)
+	ListByUser(ctx context.Context, userId string) ([]models.Participation, *apperrors.AppError)
 
 	// Transactions
 	GetTransactionForAddingParticipation(ctx context.Context, participation *models.Participation) (types.Put, *apperrors.AppError)
+	GetTransactionForDeletingParticipation(ctx context.Context, userId, tournamentId string) types.Delete
 }
 
 type participationRepo struct {
@@ -211,6 +215,90 @@ func (s *participationRepo) UpdateParticipationScore(
 	return &participation, nil
 }
 
+func (s *participationRepo) ListBotParticipations(
+	ctx context.Context,
+	tournamentId string,
+) ([]models.Participation, *apperrors.AppError) {
+	participations := make([]models.Participation, 0)
+
+	paginator := dynamodb.NewQueryPaginator(s.db.Client, &dynamodb.QueryInput{
+		TableName:              aws.String(s.db.Table()),
+		IndexName:              aws.String("GSI1"),
+		KeyConditionExpression: aws.String("GSI1PK = :pk"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: models.BotParticipationGSI1PK(tournamentId)},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list bot participations")
+		}
+
+		var pageParticipations []models.Participation
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageParticipations); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal participations")
+		}
+		participations = append(participations, pageParticipations...)
+	}
+
+	return participations, nil
+}
+
+func (s *participationRepo) CountByUser(ctx context.Context, userId string) (int, *apperrors.AppError) {
+	count := 0
+
+	paginator := dynamodb.NewQueryPaginator(s.db.Client, &dynamodb.QueryInput{
+		TableName:              aws.String(s.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: models.UserPK(userId)},
+			":sk": &types.AttributeValueMemberS{Value: models.TournamentPK("")},
+		},
+		Select: types.SelectCount,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to count participations")
+		}
+		count += int(page.Count)
+	}
+
+	return count, nil
+}
+
+// ListByUser returns every participation of the user
+func (s *participationRepo) ListByUser(ctx context.Context, userId string) ([]models.Participation, *apperrors.AppError) {
+	participations := make([]models.Participation, 0)
+
+	paginator := dynamodb.NewQueryPaginator(s.db.Client, &dynamodb.QueryInput{
+		TableName:              aws.String(s.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: models.UserPK(userId)},
+			":sk": &types.AttributeValueMemberS{Value: models.TournamentPK("")},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list user participations")
+		}
+
+		var pageParticipations []models.Participation
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageParticipations); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal participations")
+		}
+		participations = append(participations, pageParticipations...)
+	}
+
+	return participations, nil
+}
+
 // Transactions
 
 func (s *participationRepo) GetTransactionForAddingParticipation(
@@ -221,6 +309,11 @@ func (s *participationRepo) GetTransactionForAddingParticipation(
 	participation.SK = models.TournamentPK(participation.TournamentId)
 	participation.CreatedAt = time.Now().UTC()
 
+	if participation.IsBot {
+		participation.GSI1PK = models.BotParticipationGSI1PK(participation.TournamentId)
+		participation.GSI1SK = models.BotParticipationGSI1SK(participation.GroupId, participation.UserId)
+	}
+
 	item, err := attributevalue.MarshalMap(participation)
 	if err != nil {
 		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal participation")
@@ -232,3 +325,19 @@ func (s *participationRepo) GetTransactionForAddingParticipation(
 		ConditionExpression: aws.String("attribute_not_exists(PK)"),
 	}, nil
 }
+
+// GetTransactionForDeletingParticipation fails on a participation that is already
+// gone, so its group seat is given back once
+func (s *participationRepo) GetTransactionForDeletingParticipation(
+	ctx context.Context,
+	userId, tournamentId string,
+) types.Delete {
+	return types.Delete{
+		TableName: aws.String(s.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.UserPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.TournamentPK(tournamentId)},
+		},
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+	}
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+type SeasonRepository interface {
+	Create(ctx context.Context, season *models.Season) *apperrors.AppError
+	GetById(ctx context.Context, seasonId string) (*models.Season, *apperrors.AppError)
+	GetSeasonAt(ctx context.Context, at time.Time) (*models.Season, *apperrors.AppError)
+	ListEndedActiveSeasons(ctx context.Context) ([]models.Season, *apperrors.AppError)
+	UpdateStatus(ctx context.Context, seasonId string, status models.SeasonStatus) *apperrors.AppError
+}
+
+type seasonRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewSeasonRepository(db *database.DynamoDBClient) SeasonRepository {
+	return &seasonRepo{db: db}
+}
+
+func (r *seasonRepo) Create(ctx context.Context, season *models.Season) *apperrors.AppError {
+	season.PK = models.SeasonPK(season.SeasonId)
+	season.SK = models.MetaSK()
+	season.GSI1PK = models.SeasonGSI1PK()
+	season.GSI1SK = models.StartTimeGSI1SK(season.StartsAt.Format(time.RFC3339))
+	season.CreatedAt = time.Now().UTC()
+
+	item, err := attributevalue.MarshalMap(season)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal season")
+	}
+
+	_, err = r.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	})
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to create season")
+	}
+
+	return nil
+}
+
+func (r *seasonRepo) GetById(ctx context.Context, seasonId string) (*models.Season, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.SeasonPK(seasonId)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get season")
+	}
+
+	if result.Item == nil {
+		return nil, apperrors.New(apperrors.CodeNotFound, "season not found")
+	}
+
+	var season models.Season
+	if err := attributevalue.UnmarshalMap(result.Item, &season); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal season")
+	}
+
+	return &season, nil
+}
+
+// GetSeasonAt returns the latest season started before the given time if it has not ended yet
+func (r *seasonRepo) GetSeasonAt(ctx context.Context, at time.Time) (*models.Season, *apperrors.AppError) {
+	result, err := r.db.Client.Query(ctx, &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		IndexName:              aws.String("GSI1"),
+		KeyConditionExpression: aws.String("GSI1PK = :pk AND GSI1SK <= :start"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":    &types.AttributeValueMemberS{Value: models.SeasonGSI1PK()},
+			":start": &types.AttributeValueMemberS{Value: models.StartTimeGSI1SK(at.UTC().Format(time.RFC3339))},
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int32(1),
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get season")
+	}
+
+	if len(result.Items) <= 0 {
+		return nil, apperrors.New(apperrors.CodeNotFound, "season not found")
+	}
+
+	var season models.Season
+	if err := attributevalue.UnmarshalMap(result.Items[0], &season); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal season")
+	}
+
+	if season.EndsAt.Before(at) {
+		return nil, apperrors.New(apperrors.CodeNotFound, "season not found")
+	}
+
+	return &season, nil
+}
+
+func (r *seasonRepo) ListEndedActiveSeasons(ctx context.Context) ([]models.Season, *apperrors.AppError) {
+	seasons := make([]models.Season, 0)
+
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		IndexName:              aws.String("GSI1"),
+		KeyConditionExpression: aws.String("GSI1PK = :pk"),
+		FilterExpression:       aws.String("ends_at < :now AND #status = :active"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: models.SeasonGSI1PK()},
+			":now":    &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
+			":active": &types.AttributeValueMemberS{Value: string(models.SeasonStatusActive)},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list ended seasons")
+		}
+
+		var pageSeasons []models.Season
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageSeasons); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal seasons")
+		}
+		seasons = append(seasons, pageSeasons...)
+	}
+
+	return seasons, nil
+}
+
+func (r *seasonRepo) UpdateStatus(ctx context.Context, seasonId string, status models.SeasonStatus) *apperrors.AppError {
+	_, err := r.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.SeasonPK(seasonId)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+		UpdateExpression: aws.String("SET #status = :status, updated_at = :now"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":status": &types.AttributeValueMemberS{Value: string(status)},
+			":now":    &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
+		},
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+	})
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to update season status")
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -18,6 +19,9 @@ type TournamentRepository interface {
 	Create(ctx context.Context, Tournament *models.Tournament) *apperrors.AppError
 	GetActiveTournament(ctx context.Context) (*models.Tournament, *apperrors.AppError)
 	GetById(ctx context.Context, tournamentId string) (*models.Tournament, *apperrors.AppError)
+	ListEndedUnfinalizedTournaments(ctx context.Context, since time.Time) ([]models.Tournament, *apperrors.AppError)
+	ListSeasonTournaments(ctx context.Context, season *models.Season) ([]models.Tournament, *apperrors.AppError)
+	MarkFinalized(ctx context.Context, tournamentId string) (bool, *apperrors.AppError)
 }
 
 type tournamentRepo struct {
@@ -106,3 +110,102 @@ func (r *tournamentRepo) GetById(ctx context.Context, tournamentId string) (*mod
 
 	return &tournament, nil
 }
+
+// ListEndedUnfinalizedTournaments returns tournaments started after since that are over but not finalized yet
+func (r *tournamentRepo) ListEndedUnfinalizedTournaments(
+	ctx context.Context,
+	since time.Time,
+) ([]models.Tournament, *apperrors.AppError) {
+	now := time.Now().UTC()
+	tournaments := make([]models.Tournament, 0)
+
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		IndexName:              aws.String("GSI1"),
+		KeyConditionExpression: aws.String("GSI1PK = :pk AND GSI1SK BETWEEN :since AND :now"),
+		FilterExpression:       aws.String("ends_at < :nowRaw AND attribute_not_exists(finalized_at)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: models.TournamentGSI1PK()},
+			":since":  &types.AttributeValueMemberS{Value: models.StartTimeGSI1SK(since.UTC().Format(time.RFC3339))},
+			":now":    &types.AttributeValueMemberS{Value: models.StartTimeGSI1SK(now.Format(time.RFC3339))},
+			":nowRaw": &types.AttributeValueMemberS{Value: now.Format(time.RFC3339)},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list ended tournaments")
+		}
+
+		var pageTournaments []models.Tournament
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageTournaments); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal tournaments")
+		}
+		tournaments = append(tournaments, pageTournaments...)
+	}
+
+	return tournaments, nil
+}
+
+// ListSeasonTournaments returns every tournament assigned to the season
+func (r *tournamentRepo) ListSeasonTournaments(
+	ctx context.Context,
+	season *models.Season,
+) ([]models.Tournament, *apperrors.AppError) {
+	tournaments := make([]models.Tournament, 0)
+
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		IndexName:              aws.String("GSI1"),
+		KeyConditionExpression: aws.String("GSI1PK = :pk AND GSI1SK BETWEEN :from AND :to"),
+		FilterExpression:       aws.String("season_id = :seasonId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":       &types.AttributeValueMemberS{Value: models.TournamentGSI1PK()},
+			":from":     &types.AttributeValueMemberS{Value: models.StartTimeGSI1SK(season.StartsAt.UTC().Format(time.RFC3339))},
+			":to":       &types.AttributeValueMemberS{Value: models.StartTimeGSI1SK(season.EndsAt.UTC().Format(time.RFC3339))},
+			":seasonId": &types.AttributeValueMemberS{Value: season.SeasonId},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list season tournaments")
+		}
+
+		var pageTournaments []models.Tournament
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageTournaments); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal tournaments")
+		}
+		tournaments = append(tournaments, pageTournaments...)
+	}
+
+	return tournaments, nil
+}
+
+// MarkFinalized sets finalized_at once, it returns false if the tournament was already finalized
+func (r *tournamentRepo) MarkFinalized(ctx context.Context, tournamentId string) (bool, *apperrors.AppError) {
+	_, err := r.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.TournamentPK(tournamentId)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+		UpdateExpression: aws.String("SET finalized_at = :now, updated_at = :now"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":now": &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
+		},
+		ConditionExpression: aws.String("attribute_exists(PK) AND attribute_not_exists(finalized_at)"),
+	})
+
+	if err != nil {
+		var ccf *types.ConditionalCheckFailedException
+		if errors.As(err, &ccf) {
+			return false, nil
+		}
+		return false, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to mark tournament as finalized")
+	}
+
+	return true, nil
+}
@@ -0,0 +1,31 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-tournament-service/internal/service"
+)
+
+type BotScheduler struct {
+	botService service.BotService
+}
+
+func NewBotScheduler(botService service.BotService) *BotScheduler {
+	return &BotScheduler{
+		botService: botService,
+	}
+}
+
+func (bs *BotScheduler) Tick(ctx context.Context) *apperrors.AppError {
+	if err := bs.botService.FillSparseGroups(ctx); err != nil {
+		log.Printf("Failed to fill sparse groups with bots: %v", err)
+	}
+
+	if err := bs.botService.ProgressBotScores(ctx); err != nil {
+		log.Printf("Failed to progress bot scores: %v", err)
+	}
+
+	return nil
+}
@@ -6,22 +6,37 @@ import (
 	"time"
 )
 
+const (
+	botTickInterval = 5 * time.Minute
+
+	// Season points are applied by the leaderboard after tournaments are finalized,
+	// seasons waiting for them are paid on a later tick
+	seasonPayoutInterval = 15 * time.Minute
+)
+
 type Scheduler struct {
 	tournamentScheduler *TournamentScheduler
+	seasonScheduler     *SeasonScheduler
+	botScheduler        *BotScheduler
 	stopChan            chan struct{}
 }
 
 func NewScheduler(
 	tournamentScheduler *TournamentScheduler,
+	seasonScheduler *SeasonScheduler,
+	botScheduler *BotScheduler,
 ) *Scheduler {
 	return &Scheduler{
 		tournamentScheduler: tournamentScheduler,
+		seasonScheduler:     seasonScheduler,
+		botScheduler:        botScheduler,
 		stopChan:            make(chan struct{}),
 	}
 }
 
 func (s *Scheduler) Start() {
 	ctx := context.Background()
+	s.seasonScheduler.Run(ctx)
 	s.tournamentScheduler.CreateCurrentTournamentIfNotExists(ctx)
 
 	now := time.Now()
@@ -32,12 +47,17 @@ func (s *Scheduler) Start() {
 		nextMidnight.Format(time.RFC3339), durationUntilMidnight)
 
 	timer := time.NewTimer(durationUntilMidnight)
+	botTicker := time.NewTicker(botTickInterval)
+	seasonPayoutTicker := time.NewTicker(seasonPayoutInterval)
 
 	for {
 		select {
 		case <-timer.C:
 			now := time.Now()
 			ctx := context.Background()
+			s.tournamentScheduler.FinalizeEndedTournaments(ctx)
+			s.seasonScheduler.Run(ctx)
+
 			log.Println("Creating daily tournament at 00:00 UTC...")
 
 			if err := s.tournamentScheduler.CreateTournament(ctx, now); err != nil {
@@ -48,8 +68,16 @@ func (s *Scheduler) Start() {
 
 			timer.Reset(24 * time.Hour)
 
+		case <-botTicker.C:
+			s.botScheduler.Tick(context.Background())
+
+		case <-seasonPayoutTicker.C:
+			s.seasonScheduler.PayEndedSeasons(context.Background())
+
 		case <-s.stopChan:
 			timer.Stop()
+			botTicker.Stop()
+			seasonPayoutTicker.Stop()
 			log.Println("Tournament creation scheduler stopped")
 			return
 		}
@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-tournament-service/internal/service"
+)
+
+type SeasonScheduler struct {
+	seasonService service.SeasonService
+}
+
+func NewSeasonScheduler(seasonService service.SeasonService) *SeasonScheduler {
+	return &SeasonScheduler{
+		seasonService: seasonService,
+	}
+}
+
+func (ss *SeasonScheduler) Run(ctx context.Context) *apperrors.AppError {
+	if err := ss.seasonService.PayEndedSeasons(ctx); err != nil {
+		log.Printf("Failed to pay ended seasons: %v", err)
+	}
+
+	season, err := ss.seasonService.CreateCurrentSeason(ctx)
+	if err != nil {
+		log.Printf("Failed to create season: %v", err)
+		return nil
+	}
+
+	log.Printf("Current season: (ID: %s)", season.SeasonId)
+
+	return nil
+}
+
+// PayEndedSeasons retries the payout of seasons whose tournament points were
+// still being applied on the last run
+func (ss *SeasonScheduler) PayEndedSeasons(ctx context.Context) *apperrors.AppError {
+	if err := ss.seasonService.PayEndedSeasons(ctx); err != nil {
+		log.Printf("Failed to pay ended seasons: %v", err)
+	}
+
+	return nil
+}
@@ -44,3 +44,13 @@ func (ts *TournamentScheduler) CreateCurrentTournamentIfNotExists(ctx context.Co
 
 	return nil
 }
+
+func (ts *TournamentScheduler) FinalizeEndedTournaments(ctx context.Context) *apperrors.AppError {
+	log.Println("Finalizing ended tournaments...")
+
+	if err := ts.tournamentService.FinalizeEndedTournaments(ctx); err != nil {
+		log.Printf("Failed to finalize ended tournaments: %v", err)
+	}
+
+	return nil
+}
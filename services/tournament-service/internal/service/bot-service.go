@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-tournament-service/internal/events/publisher"
+	"github.com/burakmert236/goodswipe-tournament-service/internal/repository"
+	"github.com/google/uuid"
+)
+
+type BotService interface {
+	FillSparseGroups(ctx context.Context) *apperrors.AppError
+	ProgressBotScores(ctx context.Context) *apperrors.AppError
+}
+
+type botService struct {
+	tournamentRepo    repository.TournamentRepository
+	participationRepo repository.ParticipationRepository
+	groupRepo         repository.GroupRepository
+	transactionRepo   database.TransactionRepository
+	eventPublisher    *publisher.EventPublisher
+	logger            *logger.Logger
+}
+
+func NewBotService(
+	tournamentRepo repository.TournamentRepository,
+	participationRepo repository.ParticipationRepository,
+	groupRepo repository.GroupRepository,
+	transactionRepo database.TransactionRepository,
+	eventPublisher *publisher.EventPublisher,
+	logger *logger.Logger,
+) BotService {
+	return &botService{
+		tournamentRepo:    tournamentRepo,
+		participationRepo: participationRepo,
+		groupRepo:         groupRepo,
+		transactionRepo:   transactionRepo,
+		eventPublisher:    eventPublisher,
+		logger:            logger.With("component", "bot-service"),
+	}
+}
+
+// FillSparseGroups tops up every group of the active tournament that is still
+// below size after the tournament's bot fill threshold.
+func (s *botService) FillSparseGroups(ctx context.Context) *apperrors.AppError {
+	tournament, err := s.tournamentRepo.GetActiveTournament(ctx)
+	if err != nil {
+		return err
+	}
+
+	settings := tournament.BotSettings
+	if !settings.Enabled {
+		return nil
+	}
+
+	createdBefore := time.Now().UTC().Add(-time.Duration(settings.FillAfterMinutes) * time.Minute)
+	groups, err := s.groupRepo.ListUnderfilledGroups(ctx, tournament.TournamentId, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		missing := group.GroupSize - group.ParticipantCount
+		s.logger.Info("Filling sparse group with bots",
+			"tournament_id", tournament.TournamentId,
+			"group_id", group.GroupId,
+			"bot_count", missing,
+		)
+
+		for i := 0; i < missing; i++ {
+			if err := s.addBotToGroup(ctx, tournament, group.GroupId); err != nil {
+				s.logger.Error("Failed to add bot to group",
+					"error", err,
+					"group_id", group.GroupId,
+				)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// ProgressBotScores simulates one tick of play for every bot in the active
+// tournament, sampling score gains from the tournament's bot distribution.
+func (s *botService) ProgressBotScores(ctx context.Context) *apperrors.AppError {
+	tournament, err := s.tournamentRepo.GetActiveTournament(ctx)
+	if err != nil {
+		return err
+	}
+
+	settings := tournament.BotSettings
+	if !settings.Enabled {
+		return nil
+	}
+
+	bots, err := s.participationRepo.ListBotParticipations(ctx, tournament.TournamentId)
+	if err != nil {
+		return err
+	}
+
+	for _, bot := range bots {
+		gainedScore := s.sampleScoreGain(settings)
+		if gainedScore <= 0 {
+			continue
+		}
+
+		participation, err := s.participationRepo.UpdateParticipationScore(ctx, bot.UserId, bot.TournamentId, gainedScore)
+		if err != nil {
+			s.logger.Error("Failed to update bot score",
+				"error", err,
+				"user_id", bot.UserId,
+			)
+			continue
+		}
+		if participation == nil {
+			continue
+		}
+
+		s.eventPublisher.PublishTournamentParticipationScoreUpdated(
+			ctx,
+			participation.UserId,
+			participation.GroupId,
+			participation.TournamentId,
+			participation.Score,
+		)
+	}
+
+	return nil
+}
+
+// Private methods
+
+func (s *botService) addBotToGroup(ctx context.Context, tournament *models.Tournament, groupId string) *apperrors.AppError {
+	participation := &models.Participation{
+		UserId:            models.BotUserId(uuid.New().String()),
+		TournamentId:      tournament.TournamentId,
+		GroupId:           groupId,
+		EndsAt:            tournament.EndsAt,
+		RewardingMap:      tournament.RewardingMap,
+		RewardClaimStatus: models.Unclaimed,
+		IsBot:             true,
+	}
+
+	putParticipationTransaction, err := s.participationRepo.GetTransactionForAddingParticipation(ctx, participation)
+	if err != nil {
+		return err
+	}
+	updateGroupTransaction := s.groupRepo.GetTransactionForAddingParticipant(ctx, groupId, tournament.TournamentId)
+
+	transactionBuilder := database.NewTransactionBuilder()
+	transactionBuilder.AddPut(putParticipationTransaction)
+	transactionBuilder.AddUpdate(updateGroupTransaction)
+
+	if err := s.transactionRepo.Execute(ctx, transactionBuilder); err != nil {
+		return err
+	}
+
+	return s.eventPublisher.PublishTournamentEntered(
+		ctx,
+		participation.UserId,
+		s.generateBotDisplayName(),
+		groupId,
+		tournament.TournamentId,
+		true,
+	)
+}
+
+func (s *botService) sampleScoreGain(settings models.BotSettings) int {
+	if rand.Float64() >= settings.ActivityRate {
+		return 0
+	}
+
+	gain := rand.NormFloat64()*settings.ScoreStdDev + settings.ScoreMean
+	return int(math.Max(0, math.Round(gain)))
+}
+
+func (s *botService) generateBotDisplayName() string {
+	return fmt.Sprintf("Player%05d", rand.IntN(100000))
+}
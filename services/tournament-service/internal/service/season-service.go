@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	protogrpc "github.com/burakmert236/goodswipe-common/generated/v1/grpc"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-tournament-service/internal/repository"
+	"github.com/google/uuid"
+)
+
+// Tournaments finalized before the ack cutover count as applied this long after
+// they were finalized, later ones hold the payout until the leaderboard acks them
+const seasonPointsAckTimeout = 24 * time.Hour
+
+type SeasonService interface {
+	CreateCurrentSeason(ctx context.Context) (*models.Season, *apperrors.AppError)
+	PayEndedSeasons(ctx context.Context) *apperrors.AppError
+}
+
+type seasonService struct {
+	seasonRepo        repository.SeasonRepository
+	tournamentRepo    repository.TournamentRepository
+	userClient        protogrpc.UserServiceClient
+	leaderboardClient protogrpc.LeaderboardServiceClient
+	pointsAckCutover  time.Time
+	logger            *logger.Logger
+}
+
+func NewSeasonService(
+	seasonRepo repository.SeasonRepository,
+	tournamentRepo repository.TournamentRepository,
+	userClient protogrpc.UserServiceClient,
+	leaderboardClient protogrpc.LeaderboardServiceClient,
+	pointsAckCutover time.Time,
+	logger *logger.Logger,
+) SeasonService {
+	return &seasonService{
+		seasonRepo:        seasonRepo,
+		tournamentRepo:    tournamentRepo,
+		userClient:        userClient,
+		leaderboardClient: leaderboardClient,
+		pointsAckCutover:  pointsAckCutover,
+		logger:            logger.With("component", "season-service"),
+	}
+}
+
+func (s *seasonService) CreateCurrentSeason(ctx context.Context) (*models.Season, *apperrors.AppError) {
+	now := time.Now().UTC()
+
+	currentSeason, _ := s.seasonRepo.GetSeasonAt(ctx, now)
+	if currentSeason != nil {
+		return currentSeason, nil
+	}
+
+	startsAt := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	season := &models.Season{
+		SeasonId: uuid.New().String(),
+		StartsAt: startsAt,
+	}
+	s.setDefaultValuesForSeason(season)
+
+	if err := s.seasonRepo.Create(ctx, season); err != nil {
+		return nil, err
+	}
+
+	return season, nil
+}
+
+// PayEndedSeasons pays the standings rewards of every season that is over.
+// Rewards are claimed idempotently in the user service, so a season whose
+// payout partially failed is simply retried on the next run. A season is only
+// paid once the leaderboard applied the points of all its tournaments.
+func (s *seasonService) PayEndedSeasons(ctx context.Context) *apperrors.AppError {
+	seasons, err := s.seasonRepo.ListEndedActiveSeasons(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, season := range seasons {
+		paid, err := s.paySeason(ctx, &season)
+		if err != nil {
+			s.logger.Error("Failed to pay season rewards",
+				"error", err,
+				"season_id", season.SeasonId,
+			)
+			continue
+		}
+		if !paid {
+			continue
+		}
+
+		if err := s.seasonRepo.UpdateStatus(ctx, season.SeasonId, models.SeasonStatusRewarded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Private methods
+
+// paySeason pays the season standings, it returns false without paying while
+// season points of a tournament are still pending
+func (s *seasonService) paySeason(ctx context.Context, season *models.Season) (bool, *apperrors.AppError) {
+	tournaments, err := s.tournamentRepo.ListSeasonTournaments(ctx, season)
+	if err != nil {
+		return false, err
+	}
+
+	standings, grpcErr := s.leaderboardClient.GetSeasonLeaderboard(ctx, &protogrpc.GetSeasonLeaderboardRequest{
+		SeasonId: season.SeasonId,
+		Limit:    int32(models.MaxRewardedRank(season.RewardingMap)),
+	})
+	if grpcErr != nil {
+		return false, apperrors.Wrap(grpcErr, apperrors.CodeGrpcCallError, "failed to call grpc leaderboard service getSeasonLeaderboard")
+	}
+
+	applied := make(map[string]bool, len(standings.AppliedTournamentIds))
+	for _, tournamentId := range standings.AppliedTournamentIds {
+		applied[tournamentId] = true
+	}
+
+	now := time.Now()
+	for _, tournament := range tournaments {
+		if applied[tournament.TournamentId] {
+			continue
+		}
+
+		overdue := tournament.FinalizedAt != nil && now.Sub(*tournament.FinalizedAt) > seasonPointsAckTimeout
+		if overdue && tournament.FinalizedAt.Before(s.pointsAckCutover) {
+			s.logger.Warn("Tournament predates season point acks, paying season without one",
+				"season_id", season.SeasonId,
+				"tournament_id", tournament.TournamentId,
+			)
+			continue
+		}
+
+		if overdue {
+			s.logger.Error("Tournament points were never acknowledged, season payout is held",
+				"season_id", season.SeasonId,
+				"tournament_id", tournament.TournamentId,
+				"finalized_at", tournament.FinalizedAt,
+			)
+			return false, nil
+		}
+
+		s.logger.Info("Season payout waits for tournament points",
+			"season_id", season.SeasonId,
+			"tournament_id", tournament.TournamentId,
+			"finalized", tournament.FinalizedAt != nil,
+		)
+		return false, nil
+	}
+
+	for i, user := range standings.Users {
+		reward := models.RankReward(i+1, season.RewardingMap)
+		if reward <= 0 {
+			continue
+		}
+
+		_, err := s.userClient.CollectSeasonReward(ctx, &protogrpc.CollectSeasonRewardRequest{
+			UserId:   user.UserId,
+			SeasonId: season.SeasonId,
+			Coin:     int32(reward),
+		})
+		if err != nil {
+			return false, apperrors.Wrap(err, apperrors.CodeGrpcCallError, "failed to call grpc user service collectSeasonReward")
+		}
+	}
+
+	s.logger.Info("Season rewards paid",
+		"season_id", season.SeasonId,
+		"rewarded_users", len(standings.Users),
+	)
+
+	return true, nil
+}
+
+func (s *seasonService) setDefaultValuesForSeason(season *models.Season) {
+	season.EndsAt = season.StartsAt.AddDate(0, 1, 0).Add(-1 * time.Minute)
+	season.Status = models.SeasonStatusActive
+	season.PointsMap = map[string]int{
+		"1":    100,
+		"2":    70,
+		"3":    50,
+		"4-10": 20,
+	}
+	season.RewardingMap = map[string]int{
+		"1":      50000,
+		"2":      30000,
+		"3":      20000,
+		"4-10":   10000,
+		"11-100": 2000,
+	}
+}
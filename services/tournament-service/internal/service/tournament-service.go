@@ -3,8 +3,6 @@ package service
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/burakmert236/goodswipe-common/database"
@@ -12,24 +10,31 @@ import (
 	protogrpc "github.com/burakmert236/goodswipe-common/generated/v1/grpc"
 	"github.com/burakmert236/goodswipe-common/logger"
 	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-tournament-service/internal/eligibility"
 	tournamenterrors "github.com/burakmert236/goodswipe-tournament-service/internal/errors"
 	"github.com/burakmert236/goodswipe-tournament-service/internal/events/publisher"
 	"github.com/burakmert236/goodswipe-tournament-service/internal/repository"
 	"github.com/google/uuid"
 )
 
+const finalizationLookback = 7 * 24 * time.Hour
+
 type TournamentService interface {
 	CreateTournament(ctx context.Context, startsAt time.Time) (*models.Tournament, *apperrors.AppError)
 	CreateCurrentTournament(ctx context.Context) (*models.Tournament, *apperrors.AppError)
 	EnterTournament(ctx context.Context, userId string) (string, string, *apperrors.AppError)
 	UpdateParticipationScore(ctx context.Context, userId string, levelIncrease int) *apperrors.AppError
 	ClaimReward(ctx context.Context, userId, tournamentId string) (string, int, *apperrors.AppError)
+	FinalizeEndedTournaments(ctx context.Context) *apperrors.AppError
+	PurgeUser(ctx context.Context, userId string) *apperrors.AppError
 }
 
 type tournamentService struct {
 	tournamentRepo    repository.TournamentRepository
 	participationRepo repository.ParticipationRepository
 	groupRepo         repository.GroupRepository
+	seasonRepo        repository.SeasonRepository
+	deletedUserRepo   repository.DeletedUserRepository
 	transactionRepo   database.TransactionRepository
 	userClient        protogrpc.UserServiceClient
 	leaderboardClient protogrpc.LeaderboardServiceClient
@@ -41,6 +46,8 @@ func NewTournamentService(
 	tournamentRepo repository.TournamentRepository,
 	participationRepo repository.ParticipationRepository,
 	groupRepo repository.GroupRepository,
+	seasonRepo repository.SeasonRepository,
+	deletedUserRepo repository.DeletedUserRepository,
 	transactionRepo database.TransactionRepository,
 	userClient protogrpc.UserServiceClient,
 	leaderboardClient protogrpc.LeaderboardServiceClient,
@@ -51,6 +58,8 @@ func NewTournamentService(
 		tournamentRepo:    tournamentRepo,
 		participationRepo: participationRepo,
 		groupRepo:         groupRepo,
+		seasonRepo:        seasonRepo,
+		deletedUserRepo:   deletedUserRepo,
 		transactionRepo:   transactionRepo,
 		userClient:        userClient,
 		leaderboardClient: leaderboardClient,
@@ -65,6 +74,7 @@ func (s *tournamentService) CreateTournament(ctx context.Context, startsAt time.
 		StartsAt:     startsAt,
 	}
 	s.setDefaultValuesForTournament(tournament)
+	s.assignSeason(ctx, tournament)
 
 	if err := s.tournamentRepo.Create(ctx, tournament); err != nil {
 		return nil, err
@@ -87,6 +97,7 @@ func (s *tournamentService) CreateCurrentTournament(ctx context.Context) (*model
 		StartsAt:     startsAt,
 	}
 	s.setDefaultValuesForTournament(tournament)
+	s.assignSeason(ctx, tournament)
 
 	if err := s.tournamentRepo.Create(ctx, tournament); err != nil {
 		return nil, err
@@ -124,7 +135,7 @@ func (s *tournamentService) EnterTournament(
 	}
 
 	// Handle validation and reservation
-	if err := s.handleBeforeTournamentEntryOperations(ctx, userId, tournament.TournamentId, int(userResponse.Level), tournament); err != nil {
+	if err := s.handleBeforeTournamentEntryOperations(ctx, userId, tournament.TournamentId, userResponse, tournament); err != nil {
 		return "", "", err
 	}
 
@@ -136,11 +147,12 @@ func (s *tournamentService) EnterTournament(
 
 	// Build transaction for participation
 	participation := &models.Participation{
-		UserId:       userId,
-		TournamentId: tournament.TournamentId,
-		GroupId:      group.GroupId,
-		EndsAt:       tournament.EndsAt,
-		RewardingMap: tournament.RewardingMap,
+		UserId:         userId,
+		TournamentId:   tournament.TournamentId,
+		GroupId:        group.GroupId,
+		EndsAt:         tournament.EndsAt,
+		RewardingMap:   tournament.RewardingMap,
+		RewardCurrency: tournament.RewardCurrency,
 	}
 	s.setDefaultValuesForParticipation(participation)
 	putParticipationTransaction, err := s.participationRepo.GetTransactionForAddingParticipation(ctx, participation)
@@ -150,9 +162,11 @@ func (s *tournamentService) EnterTournament(
 
 	updateGroupTransaction := s.groupRepo.GetTransactionForAddingParticipant(ctx, group.GroupId, tournament.TournamentId)
 
+	// A user deleted meanwhile does not get a participation back
 	transactionBuilder := database.NewTransactionBuilder()
 	transactionBuilder.AddPut(putParticipationTransaction)
 	transactionBuilder.AddUpdate(updateGroupTransaction)
+	transactionBuilder.AddConditionCheck(s.deletedUserRepo.GetConditionCheckForActiveUser(ctx, userId))
 
 	transactionErr := s.transactionRepo.Execute(ctx, transactionBuilder)
 
@@ -160,7 +174,7 @@ func (s *tournamentService) EnterTournament(
 		return "", "", err
 	}
 
-	if err = s.eventPublisher.PublishTournamentEntered(ctx, userId, userResponse.DisplayName, group.GroupId, tournament.TournamentId); err != nil {
+	if err = s.eventPublisher.PublishTournamentEntered(ctx, userId, userResponse.DisplayName, group.GroupId, tournament.TournamentId, false); err != nil {
 		return "", "", err
 	}
 
@@ -172,6 +186,16 @@ func (s *tournamentService) UpdateParticipationScore(
 	userId string,
 	levelIncrease int,
 ) *apperrors.AppError {
+	// Level ups announced before the user was deleted may arrive after the purge
+	deleted, err := s.deletedUserRepo.IsDeleted(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		s.logger.Info("Dropping score update for deleted user", "user_id", userId)
+		return nil
+	}
+
 	tournament, err := s.tournamentRepo.GetActiveTournament(ctx)
 	if err != nil {
 		return err
@@ -202,6 +226,21 @@ func (s *tournamentService) ClaimReward(
 	ctx context.Context,
 	userId, tournamentId string,
 ) (string, int, *apperrors.AppError) {
+	if models.IsBotUserId(userId) {
+		return tournamentId, 0, tournamenterrors.ClaimRewardError()
+	}
+
+	userResponse, userClientErr := s.userClient.GetById(ctx, &protogrpc.GetUserByIdRequest{
+		UserId: userId,
+	})
+	if userClientErr != nil {
+		return tournamentId, 0, apperrors.Wrap(userClientErr, apperrors.CodeGrpcCallError, "failed to call grpc user service getById")
+	}
+
+	if err := s.validateModeration(userResponse); err != nil {
+		return tournamentId, 0, err
+	}
+
 	participation, err := s.participationRepo.UpdateRewardProcessing(ctx, userId, tournamentId)
 	if err != nil {
 		return tournamentId, 0, err
@@ -210,7 +249,7 @@ func (s *tournamentService) ClaimReward(
 		return tournamentId, 0, tournamenterrors.ClaimRewardError()
 	}
 
-	reward, err := s.handleRewardClaim(ctx, userId, participation)
+	rank, reward, err := s.handleRewardClaim(ctx, userId, participation)
 	if err != nil {
 		if _, err := s.participationRepo.UpdateRewardUnclaimed(ctx, userId, tournamentId); err != nil {
 			return participation.TournamentId, 0, err
@@ -222,6 +261,7 @@ func (s *tournamentService) ClaimReward(
 		if _, err := s.participationRepo.UpdateRewardClaimed(ctx, userId, tournamentId); err != nil {
 			return participation.TournamentId, 0, err
 		}
+		s.publishRewardClaimed(ctx, userId, participation, rank, reward)
 		return participation.TournamentId, reward, nil
 	}
 
@@ -229,6 +269,7 @@ func (s *tournamentService) ClaimReward(
 		UserId:       userId,
 		TournamentId: tournamentId,
 		Coin:         int32(reward),
+		Currency:     string(models.CurrencyOrDefault(string(participation.RewardCurrency))),
 	})
 	if addCoinResponse == nil || addCoinErr != nil {
 		if _, err := s.participationRepo.UpdateRewardUnclaimed(ctx, userId, tournamentId); err != nil {
@@ -243,24 +284,118 @@ func (s *tournamentService) ClaimReward(
 		return participation.TournamentId, reward, err
 	}
 
+	s.publishRewardClaimed(ctx, userId, participation, rank, reward)
 	return participation.TournamentId, reward, nil
 }
 
+// FinalizeEndedTournaments announces every recently ended tournament exactly once so
+// downstream services can settle final standings, e.g. season points
+func (s *tournamentService) FinalizeEndedTournaments(ctx context.Context) *apperrors.AppError {
+	since := time.Now().UTC().Add(-finalizationLookback)
+	tournaments, err := s.tournamentRepo.ListEndedUnfinalizedTournaments(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	for _, tournament := range tournaments {
+		var seasonPointsMap map[string]int
+		if tournament.SeasonId != "" {
+			season, err := s.seasonRepo.GetById(ctx, tournament.SeasonId)
+			if err != nil {
+				return err
+			}
+			seasonPointsMap = season.PointsMap
+		}
+
+		if err := s.eventPublisher.PublishTournamentFinished(
+			ctx,
+			tournament.TournamentId,
+			tournament.SeasonId,
+			seasonPointsMap,
+			tournament.RewardingMap,
+			tournament.RewardCurrency,
+		); err != nil {
+			return err
+		}
+
+		if _, err := s.tournamentRepo.MarkFinalized(ctx, tournament.TournamentId); err != nil {
+			return err
+		}
+
+		s.logger.Info("Tournament finalized", "tournament_id", tournament.TournamentId)
+	}
+
+	return nil
+}
+
+// PurgeUser deletes the participations of a deleted user and gives their seats
+// back to the groups. Each participation goes together with its seat, a
+// redelivered purge finds nothing left to give back.
+func (s *tournamentService) PurgeUser(ctx context.Context, userId string) *apperrors.AppError {
+	participations, err := s.participationRepo.ListByUser(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	deleted := 0
+	for _, participation := range participations {
+		transactionBuilder := database.NewTransactionBuilder()
+		transactionBuilder.AddDelete(s.participationRepo.GetTransactionForDeletingParticipation(ctx, userId, participation.TournamentId))
+		transactionBuilder.AddUpdate(s.groupRepo.GetTransactionForRemovingParticipant(ctx, participation.GroupId, participation.TournamentId))
+
+		if err := s.transactionRepo.Execute(ctx, transactionBuilder); err != nil {
+			if database.IsConditionalCheckFailed(err, 0) {
+				continue
+			}
+			return err
+		}
+		deleted++
+	}
+
+	s.logger.Info("Purged participations of deleted user",
+		"user_id", userId,
+		"participations", deleted,
+	)
+
+	return s.eventPublisher.PublishUserDataPurged(ctx, userId, models.DeletionStepTournamentService)
+}
+
 // Private methods
 
+func (s *tournamentService) assignSeason(ctx context.Context, tournament *models.Tournament) {
+	season, err := s.seasonRepo.GetSeasonAt(ctx, tournament.StartsAt)
+	if err != nil {
+		s.logger.Warn("No season found for tournament", "tournament_id", tournament.TournamentId)
+		return
+	}
+
+	tournament.SeasonId = season.SeasonId
+}
+
 func (s *tournamentService) setDefaultValuesForTournament(tournament *models.Tournament) {
 	tournament.EndsAt = tournament.StartsAt.Add(24 * time.Hour).Add(-1 * time.Minute)
 	tournament.LastAllowedParticipationDate = tournament.StartsAt.Add(12 * time.Hour)
-	tournament.UserLevelLimit = 10
+	tournament.EligibilityRules = []models.EligibilityRule{
+		{Type: models.EligibilityMinLevel, Value: 10},
+	}
 	tournament.GroupSize = s.getDefaultGroupSize()
 	tournament.ScoreRewardPerLevelUpgrade = 1
 	tournament.EnteranceFee = 500
+	tournament.EnteranceFeeCurrency = models.CurrencyCoin
+	tournament.RewardCurrency = models.CurrencyCoin
 	tournament.RewardingMap = map[string]int{
 		"1":    5000,
 		"2":    3000,
 		"3":    2000,
 		"4-10": 1000,
 	}
+	tournament.BotSettings = models.BotSettings{
+		Enabled:          true,
+		FillAfterMinutes: 120,
+		ScoreMean:        2,
+		ScoreStdDev:      1,
+		ActivityRate:     0.3,
+	}
 }
 
 func (s *tournamentService) setDefaultValueForGroup(group *models.Group) {
@@ -292,14 +427,63 @@ func (s *tournamentService) validateDate(tournament *models.Tournament) *apperro
 	return nil
 }
 
-func (s *tournamentService) validateUserLevel(userLevel int, tournament *models.Tournament) *apperrors.AppError {
-	if userLevel < tournament.UserLevelLimit {
-		return tournamenterrors.UserLevelLimitError(tournament.UserLevelLimit)
+// validateModeration blocks suspended and banned users from gameplay
+func (s *tournamentService) validateModeration(user *protogrpc.GetUserByIdResponse) *apperrors.AppError {
+	switch models.ModerationStatus(user.ModerationStatus) {
+	case models.ModerationStatusSuspended:
+		return tournamenterrors.UserSuspendedError(time.Unix(user.SuspendedUntil, 0).UTC())
+	case models.ModerationStatusBanned:
+		return tournamenterrors.UserBannedError()
 	}
 
 	return nil
 }
 
+func (s *tournamentService) validateEligibility(
+	ctx context.Context,
+	user *protogrpc.GetUserByIdResponse,
+	tournament *models.Tournament,
+) *apperrors.AppError {
+	rules := s.getEligibilityRules(tournament)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	subject := eligibility.Subject{
+		UserId:    user.UserId,
+		Level:     int(user.Level),
+		Coin:      int(user.Coin),
+		CreatedAt: time.Unix(user.CreatedAt, 0).UTC(),
+		Region:    user.Country,
+		IsBanned:  models.ModerationStatus(user.ModerationStatus) == models.ModerationStatusBanned,
+	}
+
+	if eligibility.RequiresParticipationCount(rules) {
+		count, err := s.participationRepo.CountByUser(ctx, user.UserId)
+		if err != nil {
+			return err
+		}
+		subject.ParticipationCount = count
+	}
+
+	if violations := eligibility.Evaluate(rules, subject, time.Now().UTC()); len(violations) > 0 {
+		return tournamenterrors.EligibilityError(violations)
+	}
+
+	return nil
+}
+
+// getEligibilityRules falls back to the legacy level limit for tournaments created before rules existed
+func (s *tournamentService) getEligibilityRules(tournament *models.Tournament) []models.EligibilityRule {
+	if len(tournament.EligibilityRules) > 0 || tournament.UserLevelLimit <= 0 {
+		return tournament.EligibilityRules
+	}
+
+	return []models.EligibilityRule{
+		{Type: models.EligibilityMinLevel, Value: tournament.UserLevelLimit},
+	}
+}
+
 func (s *tournamentService) findOrCreateAvailableGroup(
 	ctx context.Context,
 	tournament *models.Tournament,
@@ -326,14 +510,18 @@ func (s *tournamentService) findOrCreateAvailableGroup(
 func (s *tournamentService) handleBeforeTournamentEntryOperations(
 	ctx context.Context,
 	userId, tournamentId string,
-	level int,
+	user *protogrpc.GetUserByIdResponse,
 	tournament *models.Tournament,
 ) *apperrors.AppError {
+	if err := s.validateModeration(user); err != nil {
+		return err
+	}
+
 	if err := s.validateDate(tournament); err != nil {
 		return err
 	}
 
-	if err := s.validateUserLevel(level, tournament); err != nil {
+	if err := s.validateEligibility(ctx, user, tournament); err != nil {
 		return err
 	}
 
@@ -341,6 +529,7 @@ func (s *tournamentService) handleBeforeTournamentEntryOperations(
 		UserId:       userId,
 		Amount:       int64(tournament.EnteranceFee),
 		TournamentId: tournamentId,
+		Currency:     string(models.CurrencyOrDefault(string(tournament.EnteranceFeeCurrency))),
 	})
 
 	if err != nil {
@@ -392,55 +581,51 @@ func (s *tournamentService) calculateReward(
 		return 0, tournamenterrors.InvalidRankingError()
 	}
 
-	rankStr := strconv.Itoa(ranking)
-	if reward, exists := rewardingMap[rankStr]; exists {
-		return reward, nil
-	}
-
-	for key, reward := range rewardingMap {
-		if strings.Contains(key, "-") {
-			parts := strings.Split(key, "-")
-			if len(parts) != 2 {
-				continue
-			}
-
-			start, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
-			end, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
-
-			if err1 != nil || err2 != nil {
-				continue
-			}
-
-			if ranking >= start && ranking <= end {
-				return reward, nil
-			}
-		}
-	}
-
-	return 0, nil
+	return models.RankReward(ranking, rewardingMap), nil
 }
 
+// handleRewardClaim returns the final rank of the user and the reward it earns
 func (s *tournamentService) handleRewardClaim(
 	ctx context.Context,
 	userId string,
 	participation *models.Participation,
-) (int, *apperrors.AppError) {
+) (int, int, *apperrors.AppError) {
 	if participation.EndsAt.Compare(time.Now().UTC()) > 0 {
-		return 0, tournamenterrors.TournamentNotFinishedError()
+		return 0, 0, tournamenterrors.TournamentNotFinishedError()
 	}
 
 	rankingResponse, rankingErr := s.leaderboardClient.GetTournamentRank(ctx, &protogrpc.GetTournamentRankRequest{
 		UserId:       userId,
 		TournamentId: participation.TournamentId,
+		ExcludeBots:  true,
 	})
 	if rankingErr != nil {
-		return 0, apperrors.Wrap(rankingErr, apperrors.CodeGrpcCallError, "failed to call grpc leaderboard service getTournamentRank")
+		return 0, 0, apperrors.Wrap(rankingErr, apperrors.CodeGrpcCallError, "failed to call grpc leaderboard service getTournamentRank")
 	}
 
-	reward, err := s.calculateReward(int(rankingResponse.Rank), participation.RewardingMap)
+	rank := int(rankingResponse.Rank)
+	reward, err := s.calculateReward(rank, participation.RewardingMap)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 
-	return reward, nil
+	return rank, reward, nil
+}
+
+// publishRewardClaimed announces the result of a claimed participation. The claim
+// is already settled at this point, a failed publish is logged and not returned.
+func (s *tournamentService) publishRewardClaimed(
+	ctx context.Context,
+	userId string,
+	participation *models.Participation,
+	rank, reward int,
+) {
+	currency := models.CurrencyOrDefault(string(participation.RewardCurrency))
+	if err := s.eventPublisher.PublishTournamentRewardClaimed(ctx, userId, participation.TournamentId, rank, reward, currency); err != nil {
+		s.logger.Error("Failed to announce claimed tournament reward",
+			"user_id", userId,
+			"tournament_id", participation.TournamentId,
+			"error", err,
+		)
+	}
 }
@@ -9,15 +9,25 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/burakmert236/goodswipe-common/auth"
 	"github.com/burakmert236/goodswipe-common/config"
 	"github.com/burakmert236/goodswipe-common/database"
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
 	commonevents "github.com/burakmert236/goodswipe-common/events"
 	protogrpc "github.com/burakmert236/goodswipe-common/generated/v1/grpc"
+	"github.com/burakmert236/goodswipe-common/idempotency"
 	"github.com/burakmert236/goodswipe-common/logger"
 	"github.com/burakmert236/goodswipe-common/natsjetstream"
+	"github.com/burakmert236/goodswipe-user-service/internal/achievements"
+	"github.com/burakmert236/goodswipe-user-service/internal/dailyreward"
+	"github.com/burakmert236/goodswipe-user-service/internal/displayname"
 	"github.com/burakmert236/goodswipe-user-service/internal/events"
 	"github.com/burakmert236/goodswipe-user-service/internal/handler"
+	"github.com/burakmert236/goodswipe-user-service/internal/inbox"
+	"github.com/burakmert236/goodswipe-user-service/internal/outbox"
+	"github.com/burakmert236/goodswipe-user-service/internal/progression"
+	"github.com/burakmert236/goodswipe-user-service/internal/push"
+	"github.com/burakmert236/goodswipe-user-service/internal/referral"
 	"github.com/burakmert236/goodswipe-user-service/internal/repository"
 	"github.com/burakmert236/goodswipe-user-service/internal/service"
 	"github.com/nats-io/nats.go/jetstream"
@@ -31,6 +41,15 @@ type App struct {
 	logger         *logger.Logger
 	eventPublisher *events.EventPublisher
 
+	userService         service.UserService
+	userDeletionService service.UserDeletionService
+	achievementService  service.AchievementService
+	inboxService        service.InboxService
+	referralService     service.ReferralService
+	eventSubscriber     *events.EventSubscriber
+	pushDispatcher      *push.Dispatcher
+	outboxRelay         *outbox.Relay
+
 	cleanup []func() error
 }
 
@@ -60,6 +79,14 @@ func New(ctx context.Context, cfg *config.Config) (*App, *apperrors.AppError) {
 		return nil, err
 	}
 
+	if err := app.initMigrations(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := app.initMessageSubscriber(ctx); err != nil {
+		return nil, err
+	}
+
 	return app, nil
 }
 
@@ -92,19 +119,37 @@ func (a *App) initNATS(ctx context.Context) *apperrors.AppError {
 
 	a.natsClient = natsClient
 
-	stream := jetstream.StreamConfig{
-		Name:     commonevents.UserEventsStream,
-		Subjects: []string{commonevents.UserEventsWildcard},
+	// The purge stream collects deletion confirmations of every service for the user service,
+	// tournament events are consumed for achievements and leaderboard events for the inbox
+	streams := []jetstream.StreamConfig{
+		{
+			Name:     commonevents.UserEventsStream,
+			Subjects: []string{commonevents.UserEventsWildcard},
+		},
+		{
+			Name:     commonevents.TournamentEventsStream,
+			Subjects: []string{commonevents.TournamentEventsWildcard},
+		},
+		{
+			Name:     commonevents.LeaderboardEventsStream,
+			Subjects: []string{commonevents.LeaderboardEventsWildcard},
+		},
+		{
+			Name:     commonevents.PurgeEventsStream,
+			Subjects: []string{commonevents.PurgeEventsWildcard},
+		},
 	}
 
-	if _, err := a.natsClient.JetStream().CreateOrUpdateStream(ctx, stream); err != nil {
-		a.logger.Error("Failed to create stream",
-			"error", err,
-			"stream", stream.Name,
-		)
-		return apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to create jetstream event stream")
+	for _, stream := range streams {
+		if _, err := a.natsClient.JetStream().CreateOrUpdateStream(ctx, stream); err != nil {
+			a.logger.Error("Failed to create stream",
+				"error", err,
+				"stream", stream.Name,
+			)
+			return apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to create jetstream event stream")
+		}
+		a.logger.Info("Stream ready", "stream", stream.Name)
 	}
-	a.logger.Info("Stream ready", "stream", stream.Name)
 
 	a.cleanup = append(a.cleanup, natsClient.Close)
 
@@ -116,25 +161,175 @@ func (a *App) initMessaging() *apperrors.AppError {
 	return nil
 }
 
+// initMigrations runs before the subscribers start and the server accepts calls,
+// so every user is migrated by then
+func (a *App) initMigrations(ctx context.Context) *apperrors.AppError {
+	return a.userService.BackfillDisplayNameClaims(ctx)
+}
+
+func (a *App) initMessageSubscriber(ctx context.Context) *apperrors.AppError {
+	a.eventSubscriber = events.NewEventSubscriber(
+		a.natsClient,
+		a.userDeletionService,
+		a.achievementService,
+		a.inboxService,
+		a.referralService,
+		a.logger,
+	)
+	return a.eventSubscriber.Start(ctx)
+}
+
 func (a *App) initGRPC() *apperrors.AppError {
 	userRepo := repository.NewUserRepository(a.db)
 	reservationRepo := repository.NewReservationRepository(a.db)
 	rewardClaimRepository := repository.NewRewardClaimRepository(a.db)
+	coinLedgerRepo := repository.NewCoinLedgerRepository(a.db)
+	displayNameRepo := repository.NewDisplayNameRepository(a.db)
+	dailyStreakRepo := repository.NewDailyStreakRepository(a.db)
+	achievementRepo := repository.NewAchievementRepository(a.db)
+	friendRepo := repository.NewFriendRepository(a.db)
+	inboxRepo := repository.NewInboxRepository(a.db)
+	pushRepo := repository.NewPushRepository(a.db)
+	referralRepo := repository.NewReferralRepository(a.db)
+	balanceAdjustmentRepo := repository.NewBalanceAdjustmentRepository(a.db)
+	migrationRepo := repository.NewMigrationRepository(a.db)
+	outboxRepo := repository.NewOutboxRepository(a.db)
 	transactionRepo := database.NewTransactionRepository(a.db)
 
+	progressionConfig, err := progression.FromConfig(a.cfg.Progression)
+	if err != nil {
+		return err
+	}
+
+	dailyRewardConfig, err := dailyreward.FromConfig(a.cfg.DailyReward)
+	if err != nil {
+		return err
+	}
+
+	achievementCatalog, err := achievements.FromConfig(a.cfg.Achievements)
+	if err != nil {
+		return err
+	}
+
+	inboxRenderer, err := inbox.FromConfig(a.cfg.Inbox)
+	if err != nil {
+		return err
+	}
+
+	referralConfig, err := referral.FromConfig(a.cfg.Referrals)
+	if err != nil {
+		return err
+	}
+
+	a.pushDispatcher, err = push.NewDispatcher(a.cfg.Push, pushRepo, a.logger)
+	if err != nil {
+		return err
+	}
+	a.cleanup = append(a.cleanup, a.pushDispatcher.Stop)
+
+	a.outboxRelay = outbox.NewRelay(outboxRepo, a.eventPublisher, a.logger)
+	a.cleanup = append(a.cleanup, a.outboxRelay.Stop)
+
 	userService := service.NewUserService(
 		userRepo,
 		reservationRepo,
 		rewardClaimRepository,
+		coinLedgerRepo,
+		displayNameRepo,
+		dailyStreakRepo,
+		referralRepo,
+		balanceAdjustmentRepo,
+		migrationRepo,
+		outboxRepo,
 		transactionRepo,
+		progressionConfig,
+		displayname.FromConfig(a.cfg.DisplayName),
+		dailyRewardConfig,
+		referralConfig,
+		a.outboxRelay,
+		a.logger,
+	)
+	a.userService = userService
+
+	a.userDeletionService = service.NewUserDeletionService(
+		repository.NewUserDeletionRepository(a.db),
+		userRepo,
+		reservationRepo,
+		rewardClaimRepository,
+		coinLedgerRepo,
+		displayNameRepo,
+		dailyStreakRepo,
+		achievementRepo,
+		friendRepo,
+		inboxRepo,
+		pushRepo,
+		referralRepo,
+		balanceAdjustmentRepo,
 		a.eventPublisher,
 		a.logger,
 	)
 
-	userHandler := handler.NewUserHandler(userService, a.logger)
+	a.achievementService = service.NewAchievementService(
+		achievementRepo,
+		transactionRepo,
+		userService,
+		achievementCatalog,
+		a.logger,
+	)
+
+	friendService := service.NewFriendService(
+		friendRepo,
+		userRepo,
+		outboxRepo,
+		transactionRepo,
+		a.cfg.Friends,
+		a.outboxRelay,
+		a.logger,
+	)
+
+	a.inboxService = service.NewInboxService(inboxRepo, inboxRenderer, a.pushDispatcher, a.logger)
+
+	a.referralService = service.NewReferralService(
+		referralRepo,
+		userRepo,
+		transactionRepo,
+		userService,
+		referralConfig,
+		a.logger,
+	)
+
+	userHandler := handler.NewUserHandler(
+		userService,
+		a.userDeletionService,
+		a.achievementService,
+		friendService,
+		a.inboxService,
+		service.NewPushService(pushRepo, a.logger),
+		a.referralService,
+		service.NewModerationService(userRepo, outboxRepo, transactionRepo, a.outboxRelay, a.logger),
+		a.logger,
+	)
+
+	interceptors := []grpc.UnaryServerInterceptor{a.loggingInterceptor}
+	if a.cfg.Auth.Enabled {
+		authenticator, err := auth.NewAuthenticator(a.cfg.Auth, a.cfg.Server.ServiceCredentials)
+		if err != nil {
+			return err
+		}
+		interceptors = append(interceptors, auth.UnaryServerInterceptor(authenticator, methodPolicies))
+	}
+
+	interceptors = append(interceptors, idempotency.UnaryServerInterceptor(
+		idempotency.NewStore(a.db),
+		a.logger,
+		time.Duration(a.cfg.Idempotency.TTLHours)*time.Hour,
+		protogrpc.UserService_CreateUser_FullMethodName,
+		protogrpc.UserService_UpdateProgress_FullMethodName,
+		protogrpc.UserService_PurchaseStreakFreeze_FullMethodName,
+	))
 
 	a.grpcServer = grpc.NewServer(
-		grpc.UnaryInterceptor(a.loggingInterceptor),
+		grpc.ChainUnaryInterceptor(interceptors...),
 	)
 
 	protogrpc.RegisterUserServiceServer(a.grpcServer, userHandler)
@@ -144,6 +339,9 @@ func (a *App) initGRPC() *apperrors.AppError {
 }
 
 func (a *App) Start() *apperrors.AppError {
+	go a.pushDispatcher.Start()
+	go a.outboxRelay.Start()
+
 	go func() {
 		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", a.cfg.Server.GRPCPort))
 		if err != nil {
@@ -184,3 +382,39 @@ func (a *App) loggingInterceptor(ctx context.Context, req interface{}, info *grp
 	a.logger.Info(fmt.Sprintf("Method: %s, Duration: %v", info.FullMethod, time.Since(start)))
 	return resp, err
 }
+
+// Coin moving saga operations and payouts are only open to the tournament service
+var methodPolicies = auth.MethodPolicies{
+	protogrpc.UserService_CreateUser_FullMethodName:              auth.Public(),
+	protogrpc.UserService_GetById_FullMethodName:                 auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin).AndServices(auth.ServiceTournament),
+	protogrpc.UserService_GetUsersByIds_FullMethodName:           auth.AllowRoles(auth.RoleAdmin).AndServices(auth.ServiceTournament),
+	protogrpc.UserService_UpdateProgress_FullMethodName:          auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_ListCoinTransactions_FullMethodName:    auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_UpdateDisplayName_FullMethodName:       auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_UpdateCountry_FullMethodName:           auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_ClaimDailyReward_FullMethodName:        auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_PurchaseStreakFreeze_FullMethodName:    auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_ListAchievements_FullMethodName:        auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_SendFriendRequest_FullMethodName:       auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_AcceptFriendRequest_FullMethodName:     auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_RemoveFriend_FullMethodName:            auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_ListFriends_FullMethodName:             auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_ListInbox_FullMethodName:               auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_MarkInboxRead_FullMethodName:           auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_GetInboxUnreadCount_FullMethodName:     auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_GetPushPreferences_FullMethodName:      auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_UpdatePushPreferences_FullMethodName:   auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_GetReferralInfo_FullMethodName:         auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_GrantCurrency_FullMethodName:           auth.AllowRoles(auth.RoleAdmin),
+	protogrpc.UserService_RevokeCurrency_FullMethodName:          auth.AllowRoles(auth.RoleAdmin),
+	protogrpc.UserService_SuspendUser_FullMethodName:             auth.AllowRoles(auth.RoleAdmin),
+	protogrpc.UserService_BanUser_FullMethodName:                 auth.AllowRoles(auth.RoleAdmin),
+	protogrpc.UserService_ReinstateUser_FullMethodName:           auth.AllowRoles(auth.RoleAdmin),
+	protogrpc.UserService_DeleteUser_FullMethodName:              auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_GetUserDeletionStatus_FullMethodName:   auth.AllowRoles(auth.RolePlayer, auth.RoleAdmin),
+	protogrpc.UserService_CollectTournamentReward_FullMethodName: auth.AllowServices(auth.ServiceTournament),
+	protogrpc.UserService_CollectSeasonReward_FullMethodName:     auth.AllowServices(auth.ServiceTournament),
+	protogrpc.UserService_ReserveCoins_FullMethodName:            auth.AllowServices(auth.ServiceTournament),
+	protogrpc.UserService_ConfirmReservation_FullMethodName:      auth.AllowServices(auth.ServiceTournament),
+	protogrpc.UserService_RollbackReservation_FullMethodName:     auth.AllowServices(auth.ServiceTournament),
+}
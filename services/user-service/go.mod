@@ -8,6 +8,8 @@ require (
 	github.com/burakmert236/goodswipe-common v0.0.0
 )
 
+require github.com/golang-jwt/jwt/v5 v5.3.1 // indirect
+
 require (
 	github.com/klauspost/compress v1.18.0 // indirect
 	github.com/nats-io/nats.go v1.47.0
@@ -52,7 +54,7 @@ require (
 	golang.org/x/text v0.30.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251022142026-3a174f9686a8 // indirect
 	google.golang.org/grpc v1.77.0
-	google.golang.org/protobuf v1.36.10 // indirect
+	google.golang.org/protobuf v1.36.10
 )
 
 replace github.com/burakmert236/goodswipe-common v0.0.0 => ../../common
@@ -0,0 +1,116 @@
+package achievements
+
+import (
+	"fmt"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// Definition unlocks once the user's Metric reaches Threshold and pays Reward coins
+type Definition struct {
+	Id          string
+	Name        string
+	Description string
+	Metric      models.AchievementMetric
+	Threshold   int
+	Reward      int
+}
+
+// Catalog is the ordered list of achievement definitions
+type Catalog struct {
+	Definitions []Definition
+}
+
+// Reached returns the definitions on the given metrics whose threshold is met by the stats
+func (c *Catalog) Reached(stats *models.AchievementStats, metrics ...models.AchievementMetric) []Definition {
+	reached := make([]Definition, 0)
+	for _, definition := range c.Definitions {
+		for _, metric := range metrics {
+			if definition.Metric == metric && stats.Value(metric) >= definition.Threshold {
+				reached = append(reached, definition)
+				break
+			}
+		}
+	}
+	return reached
+}
+
+// FromConfig builds the catalog, without configured definitions the defaults are used
+func FromConfig(cfg config.AchievementsConfig) (*Catalog, *apperrors.AppError) {
+	if len(cfg.Definitions) == 0 {
+		return DefaultCatalog(), nil
+	}
+
+	catalog := &Catalog{Definitions: make([]Definition, 0, len(cfg.Definitions))}
+	seen := make(map[string]bool, len(cfg.Definitions))
+
+	for _, definitionConfig := range cfg.Definitions {
+		definition := Definition{
+			Id:          definitionConfig.Id,
+			Name:        definitionConfig.Name,
+			Description: definitionConfig.Description,
+			Metric:      models.AchievementMetric(definitionConfig.Metric),
+			Threshold:   definitionConfig.Threshold,
+			Reward:      definitionConfig.Reward,
+		}
+
+		switch {
+		case definition.Id == "":
+			return nil, apperrors.New(apperrors.CodeInvalidInput, "achievement id is required")
+		case seen[definition.Id]:
+			return nil, apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("duplicate achievement id: %s", definition.Id))
+		case !definition.Metric.IsValid():
+			return nil, apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("achievement %s has an unknown metric: %s", definition.Id, definition.Metric))
+		case definition.Threshold <= 0:
+			return nil, apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("achievement %s needs a positive threshold", definition.Id))
+		case definition.Reward < 0:
+			return nil, apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("achievement %s can not have a negative reward", definition.Id))
+		}
+
+		seen[definition.Id] = true
+		catalog.Definitions = append(catalog.Definitions, definition)
+	}
+
+	return catalog, nil
+}
+
+func DefaultCatalog() *Catalog {
+	return &Catalog{
+		Definitions: []Definition{
+			{
+				Id:          "first_tournament",
+				Name:        "Contender",
+				Description: "Enter your first tournament",
+				Metric:      models.AchievementMetricTournamentsEntered,
+				Threshold:   1,
+				Reward:      100,
+			},
+			{
+				Id:          "first_tournament_win",
+				Name:        "Champion",
+				Description: "Win a tournament",
+				Metric:      models.AchievementMetricTournamentWins,
+				Threshold:   1,
+				Reward:      500,
+			},
+			{
+				Id:          "top_three_five_times",
+				Name:        "Podium Regular",
+				Description: "Finish in the top 3 of 5 tournaments",
+				Metric:      models.AchievementMetricTournamentTopThree,
+				Threshold:   5,
+				Reward:      1000,
+			},
+			{
+				Id:          "reach_level_100",
+				Name:        "Centurion",
+				Description: "Reach level 100",
+				Metric:      models.AchievementMetricLevel,
+				Threshold:   100,
+				Reward:      2500,
+			},
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package dailyreward
+
+import (
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+const dayLayout = "2006-01-02"
+
+// Config is the daily login reward schedule
+type Config struct {
+	Location          *time.Location
+	Rewards           []int
+	StreakFreezePrice int
+	MaxStreakFreezes  int
+}
+
+// Claim is the outcome of claiming the reward of a day. AlreadyClaimed is set
+// when the day was paid before, the claim then pays nothing.
+type Claim struct {
+	Day            string
+	Streak         int
+	FreezesUsed    int
+	Reward         int
+	AlreadyClaimed bool
+}
+
+// Day returns the calendar day of the given time in the configured timezone
+func (c *Config) Day(now time.Time) string {
+	return now.In(c.Location).Format(dayLayout)
+}
+
+// Next continues the streak with a claim on the given day. Every missed day
+// uses one streak freeze, without enough freezes the streak starts over and the
+// freezes are kept. A day up to the last claimed one, which a timezone change
+// can produce, was already claimed. A nil streak is the first claim of the user.
+func (c *Config) Next(streak *models.DailyStreak, day string) Claim {
+	claim := Claim{Day: day, Streak: 1}
+
+	if streak != nil && streak.LastClaimDay != "" {
+		missedDays := daysBetween(streak.LastClaimDay, day) - 1
+		switch {
+		case day <= streak.LastClaimDay:
+			return Claim{Day: streak.LastClaimDay, Streak: streak.Streak, AlreadyClaimed: true}
+		case missedDays == 0:
+			claim.Streak = streak.Streak + 1
+		case missedDays > 0 && missedDays <= streak.Freezes:
+			claim.Streak = streak.Streak + 1
+			claim.FreezesUsed = missedDays
+		}
+	}
+
+	claim.Reward = c.RewardFor(claim.Streak)
+	return claim
+}
+
+// RewardFor returns the coin reward of the given streak day
+func (c *Config) RewardFor(streak int) int {
+	if len(c.Rewards) == 0 || streak < 1 {
+		return 0
+	}
+	return c.Rewards[min(streak, len(c.Rewards))-1]
+}
+
+// FromConfig builds the schedule, unset values fall back to defaults
+func FromConfig(cfg config.DailyRewardConfig) (*Config, *apperrors.AppError) {
+	dailyReward := DefaultConfig()
+
+	if cfg.Timezone != "" {
+		location, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeInvalidInput, "daily reward timezone is not valid")
+		}
+		dailyReward.Location = location
+	}
+
+	if len(cfg.Rewards) > 0 {
+		for _, reward := range cfg.Rewards {
+			if reward < 0 {
+				return nil, apperrors.New(apperrors.CodeInvalidInput, "daily rewards can not be negative")
+			}
+		}
+		dailyReward.Rewards = cfg.Rewards
+	}
+
+	if cfg.StreakFreezePrice > 0 {
+		dailyReward.StreakFreezePrice = cfg.StreakFreezePrice
+	}
+	if cfg.MaxStreakFreezes > 0 {
+		dailyReward.MaxStreakFreezes = cfg.MaxStreakFreezes
+	}
+
+	return dailyReward, nil
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Location:          time.UTC,
+		Rewards:           []int{50, 75, 100, 150, 200, 250, 500},
+		StreakFreezePrice: 500,
+		MaxStreakFreezes:  2,
+	}
+}
+
+// daysBetween counts calendar days from one day to the other, days are
+// compared as dates so DST changes do not matter
+func daysBetween(from, to string) int {
+	fromDay, err := time.Parse(dayLayout, from)
+	if err != nil {
+		return -1
+	}
+	toDay, err := time.Parse(dayLayout, to)
+	if err != nil {
+		return -1
+	}
+	return int(toDay.Sub(fromDay).Hours() / 24)
+}
@@ -0,0 +1,125 @@
+package dailyreward
+
+import (
+	"testing"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+func TestNext(t *testing.T) {
+	config := &Config{Location: time.UTC, Rewards: []int{50, 75, 100}}
+
+	tests := []struct {
+		name   string
+		streak *models.DailyStreak
+		day    string
+		want   Claim
+	}{
+		{
+			name: "first claim",
+			day:  "2026-10-18",
+			want: Claim{Day: "2026-10-18", Streak: 1, Reward: 50},
+		},
+		{
+			name:   "streak without a claimed day",
+			streak: &models.DailyStreak{Freezes: 1},
+			day:    "2026-10-18",
+			want:   Claim{Day: "2026-10-18", Streak: 1, Reward: 50},
+		},
+		{
+			name:   "next day",
+			streak: &models.DailyStreak{Streak: 1, LastClaimDay: "2026-10-17"},
+			day:    "2026-10-18",
+			want:   Claim{Day: "2026-10-18", Streak: 2, Reward: 75},
+		},
+		{
+			name:   "last reward repeats",
+			streak: &models.DailyStreak{Streak: 5, LastClaimDay: "2026-10-17"},
+			day:    "2026-10-18",
+			want:   Claim{Day: "2026-10-18", Streak: 6, Reward: 100},
+		},
+		{
+			name:   "next day across the year",
+			streak: &models.DailyStreak{Streak: 2, LastClaimDay: "2026-12-31"},
+			day:    "2027-01-01",
+			want:   Claim{Day: "2027-01-01", Streak: 3, Reward: 100},
+		},
+		{
+			name:   "missed day without freezes",
+			streak: &models.DailyStreak{Streak: 3, LastClaimDay: "2026-10-16"},
+			day:    "2026-10-18",
+			want:   Claim{Day: "2026-10-18", Streak: 1, Reward: 50},
+		},
+		{
+			name:   "missed day uses a freeze",
+			streak: &models.DailyStreak{Streak: 1, LastClaimDay: "2026-10-16", Freezes: 2},
+			day:    "2026-10-18",
+			want:   Claim{Day: "2026-10-18", Streak: 2, FreezesUsed: 1, Reward: 75},
+		},
+		{
+			name:   "missed days use every freeze",
+			streak: &models.DailyStreak{Streak: 1, LastClaimDay: "2026-10-15", Freezes: 2},
+			day:    "2026-10-18",
+			want:   Claim{Day: "2026-10-18", Streak: 2, FreezesUsed: 2, Reward: 75},
+		},
+		{
+			name:   "more missed days than freezes",
+			streak: &models.DailyStreak{Streak: 2, LastClaimDay: "2026-10-14", Freezes: 2},
+			day:    "2026-10-18",
+			want:   Claim{Day: "2026-10-18", Streak: 1, Reward: 50},
+		},
+		{
+			name:   "same day",
+			streak: &models.DailyStreak{Streak: 2, LastClaimDay: "2026-10-18"},
+			day:    "2026-10-18",
+			want:   Claim{Day: "2026-10-18", Streak: 2, AlreadyClaimed: true},
+		},
+		{
+			name:   "earlier day after a timezone change",
+			streak: &models.DailyStreak{Streak: 2, LastClaimDay: "2026-10-18", Freezes: 1},
+			day:    "2026-10-17",
+			want:   Claim{Day: "2026-10-18", Streak: 2, AlreadyClaimed: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := config.Next(tt.streak, tt.day); got != tt.want {
+				t.Errorf("Next(%s) = %+v, want %+v", tt.day, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDay(t *testing.T) {
+	istanbul, err := time.LoadLocation("Europe/Istanbul")
+	if err != nil {
+		t.Skipf("timezone data is not available: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		location *time.Location
+		at       string
+		want     string
+	}{
+		{name: "utc", location: time.UTC, at: "2026-10-18T22:30:00Z", want: "2026-10-18"},
+		{name: "ahead of utc", location: istanbul, at: "2026-10-18T22:30:00Z", want: "2026-10-19"},
+		{name: "utc before midnight", location: time.UTC, at: "2026-10-19T01:30:00+03:00", want: "2026-10-18"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := time.Parse(time.RFC3339, tt.at)
+			if err != nil {
+				t.Fatalf("parse %s: %v", tt.at, err)
+			}
+
+			config := &Config{Location: tt.location}
+			if got := config.Day(at); got != tt.want {
+				t.Errorf("Day(%s) = %s, want %s", tt.at, got, tt.want)
+			}
+		})
+	}
+}
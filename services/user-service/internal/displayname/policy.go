@@ -0,0 +1,164 @@
+package displayname
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
+
+// Policy decides whether a display name may be shown on leaderboards
+type Policy struct {
+	MinLength int
+	MaxLength int
+	Blocklist []string
+}
+
+// Common substitutions used to slip blocked words past a plain match
+var leetReplacer = strings.NewReplacer(
+	"0", "o",
+	"1", "i",
+	"3", "e",
+	"4", "a",
+	"5", "s",
+	"7", "t",
+	"@", "a",
+	"$", "s",
+)
+
+// Validate returns the trimmed display name or an invalid input error naming the broken rule
+func (p *Policy) Validate(displayName string) (string, *apperrors.AppError) {
+	displayName = strings.TrimSpace(displayName)
+
+	length := utf8.RuneCountInString(displayName)
+	if length < p.MinLength || length > p.MaxLength {
+		return "", invalid(fmt.Sprintf("display name must be between %d and %d characters", p.MinLength, p.MaxLength))
+	}
+
+	previous := rune(0)
+	for _, char := range displayName {
+		switch {
+		case unicode.IsLetter(char), unicode.IsDigit(char), char == '_', char == '-', char == '.':
+		case char == ' ':
+			if previous == ' ' {
+				return "", invalid("display name can not contain consecutive spaces")
+			}
+		default:
+			return "", invalid("display name can only contain letters, digits, spaces, '_', '-' and '.'")
+		}
+		previous = char
+	}
+
+	if p.isBlocked(displayName) {
+		return "", invalid("display name is not allowed")
+	}
+
+	return displayName, nil
+}
+
+// isBlocked matches the blocklist against whole words of the name, so a blocked
+// word hidden inside an innocent one is allowed. Words are split at separators
+// and case changes, letters spelled out one by one are joined back into a word
+// and two neighbouring words are matched together as well.
+func (p *Policy) isBlocked(displayName string) bool {
+	blocked := make(map[string]bool, len(p.Blocklist))
+	for _, word := range p.Blocklist {
+		blocked[strings.ToLower(word)] = true
+	}
+
+	nameWords := words(displayName)
+	for i, count := 1, len(nameWords); i < count; i++ {
+		nameWords = append(nameWords, nameWords[i-1]+nameWords[i])
+	}
+
+	for _, word := range nameWords {
+		candidates := []string{
+			word,
+			leetReplacer.Replace(word),
+			leetReplacer.Replace(strings.TrimFunc(word, unicode.IsDigit)),
+		}
+		for _, candidate := range candidates {
+			if blocked[candidate] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// words splits a display name into lower case words
+func words(displayName string) []string {
+	var result []string
+	var current []rune
+	var spelled strings.Builder
+
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		word := strings.ToLower(string(current))
+		current = current[:0]
+
+		// Single letters in a row, like "f u c k", make up a word too
+		if utf8.RuneCountInString(word) == 1 {
+			spelled.WriteString(word)
+			return
+		}
+		if spelled.Len() > 1 {
+			result = append(result, spelled.String())
+		}
+		spelled.Reset()
+		result = append(result, word)
+	}
+
+	previous := rune(0)
+	for _, char := range displayName {
+		switch {
+		case char == ' ' || char == '_' || char == '-' || char == '.':
+			flush()
+		case unicode.IsUpper(char) && unicode.IsLower(previous):
+			flush()
+			current = append(current, char)
+		default:
+			current = append(current, char)
+		}
+		previous = char
+	}
+	flush()
+	if spelled.Len() > 1 {
+		result = append(result, spelled.String())
+	}
+
+	return result
+}
+
+// FromConfig builds the policy, unset values fall back to defaults
+func FromConfig(cfg config.DisplayNameConfig) *Policy {
+	policy := DefaultPolicy()
+
+	if cfg.MinLength > 0 {
+		policy.MinLength = cfg.MinLength
+	}
+	if cfg.MaxLength > 0 {
+		policy.MaxLength = cfg.MaxLength
+	}
+	policy.Blocklist = append(policy.Blocklist, cfg.Blocklist...)
+
+	return policy
+}
+
+func DefaultPolicy() *Policy {
+	return &Policy{
+		MinLength: 3,
+		MaxLength: 20,
+		Blocklist: []string{"admin", "moderator", "goodswipe"},
+	}
+}
+
+func invalid(message string) *apperrors.AppError {
+	return apperrors.New(apperrors.CodeInvalidInput, message)
+}
@@ -1,11 +1,97 @@
 package errors
 
-import apperrors "github.com/burakmert236/goodswipe-common/errors"
+import (
+	"fmt"
+	"time"
 
-func WrapInsufficientCoinError(err error) *apperrors.AppError {
-	return apperrors.Wrap(err, apperrors.CodeForbidden, "insufficient coin for tournament entry")
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+func InsufficientBalanceError(currency models.Currency) *apperrors.AppError {
+	return apperrors.New(apperrors.CodeForbidden, fmt.Sprintf("insufficient %s balance", currency))
+}
+
+func InvalidCurrencyError(currency string) *apperrors.AppError {
+	return apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("unsupported currency: %s", currency))
+}
+
+func DisplayNameTakenError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeAlreadyExists, "display name is already taken")
+}
+
+func DisplayNameChangedConcurrentlyError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeConflict, "display name was changed by another request")
+}
+
+func InvalidCountryError(country string) *apperrors.AppError {
+	return apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("country must be an ISO 3166-1 alpha-2 code: %s", country))
+}
+
+func UserChangedConcurrentlyError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeConflict, "user was changed by another request")
 }
 
 func CoinReservationRollbackError() *apperrors.AppError {
 	return apperrors.New(apperrors.CodeInternalServer, "reservation cannot be rolled back")
 }
+
+func DailyStreakChangedConcurrentlyError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeConflict, "daily streak was changed by another request")
+}
+
+func StreakFreezeLimitError(maxFreezes int) *apperrors.AppError {
+	return apperrors.New(apperrors.CodePreconditionFailed, fmt.Sprintf("at most %d streak freezes can be held", maxFreezes))
+}
+
+func SelfFriendRequestError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeInvalidInput, "users can not befriend themselves")
+}
+
+func InvalidFriendshipStatusError(status string) *apperrors.AppError {
+	return apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("unsupported friendship status: %s", status))
+}
+
+func AlreadyFriendsError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeAlreadyExists, "users are already friends")
+}
+
+func FriendRequestNotFoundError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeNotFound, "friend request not found")
+}
+
+func FriendshipNotFoundError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeNotFound, "friendship not found")
+}
+
+func FriendLimitError(maxFriends int) *apperrors.AppError {
+	return apperrors.New(apperrors.CodePreconditionFailed, fmt.Sprintf("at most %d friends and pending requests can be held", maxFriends))
+}
+
+func FriendLimitOfFriendError(maxFriends int) *apperrors.AppError {
+	return apperrors.New(apperrors.CodePreconditionFailed, fmt.Sprintf("the other user already holds %d friends and pending requests", maxFriends))
+}
+
+func FriendshipChangedConcurrentlyError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeConflict, "friendship was changed by another request")
+}
+
+func InvalidReferralCodeError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeInvalidInput, "referral code is not valid")
+}
+
+func ReferralSignupLimitError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodePreconditionFailed, "referral code reached its sign up limit for today, try again tomorrow")
+}
+
+func BalanceAdjustmentConflictError(requestId string) *apperrors.AppError {
+	return apperrors.New(apperrors.CodeConflict, fmt.Sprintf("request %s was already used for a different adjustment", requestId))
+}
+
+func UserSuspendedError(until time.Time) *apperrors.AppError {
+	return apperrors.New(apperrors.CodeForbidden, fmt.Sprintf("user is suspended until %s", until.Format(time.RFC3339)))
+}
+
+func UserBannedError() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeForbidden, "user is banned")
+}
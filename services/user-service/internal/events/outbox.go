@@ -0,0 +1,150 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	commonevents "github.com/burakmert236/goodswipe-common/events"
+	protoevents "github.com/burakmert236/goodswipe-common/generated/v1/events"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// The events below are written to the outbox in the transaction of the change
+// they announce and published by the outbox relay
+
+func NewUserCreatedEvent(userId, displayName, country string) (*models.OutboxEvent, *apperrors.AppError) {
+	now := time.Now().UTC()
+	return newOutboxEvent(commonevents.UserCreated, now, &protoevents.UserCreated{
+		UserId:      userId,
+		DisplayName: displayName,
+		TimeStamp:   now.Unix(),
+		Country:     country,
+	})
+}
+
+func NewUserLevelUpEvent(userId string, levelIncrease, newLevel, prestige int) (*models.OutboxEvent, *apperrors.AppError) {
+	now := time.Now().UTC()
+	return newOutboxEvent(commonevents.UserLevelUp, now, &protoevents.UserLevelUp{
+		UserId:        userId,
+		LevelIncrease: int32(levelIncrease),
+		NewLevel:      int32(newLevel),
+		Prestige:      int32(prestige),
+		TimeStamp:     now.Unix(),
+	})
+}
+
+func NewUserBalanceAdjustedEvent(adjustment *models.BalanceAdjustment, balanceAfter int) (*models.OutboxEvent, *apperrors.AppError) {
+	now := time.Now().UTC()
+	return newOutboxEvent(commonevents.UserBalanceAdjusted, now, &protoevents.UserBalanceAdjusted{
+		UserId:          adjustment.UserId,
+		RequestId:       adjustment.RequestId,
+		Type:            string(adjustment.Type),
+		Currency:        string(adjustment.Currency),
+		Amount:          int32(adjustment.Amount),
+		BalanceAfter:    int32(balanceAfter),
+		OperatorId:      adjustment.OperatorId,
+		Reason:          adjustment.Reason,
+		TicketReference: adjustment.TicketReference,
+		TimeStamp:       now.Unix(),
+	})
+}
+
+func NewUserModerationChangedEvent(userId string, moderation *models.Moderation) (*models.OutboxEvent, *apperrors.AppError) {
+	now := time.Now().UTC()
+	event := &protoevents.UserModerationChanged{
+		UserId:     userId,
+		Status:     string(moderation.Status),
+		Reason:     moderation.Reason,
+		OperatorId: moderation.OperatorId,
+		TimeStamp:  now.Unix(),
+	}
+	if moderation.SuspendedUntil != nil {
+		event.SuspendedUntil = moderation.SuspendedUntil.Unix()
+	}
+
+	return newOutboxEvent(commonevents.UserModerationChanged, now, event)
+}
+
+func NewUserDisplayNameChangedEvent(userId, displayName, previousDisplayName string) (*models.OutboxEvent, *apperrors.AppError) {
+	now := time.Now().UTC()
+	return newOutboxEvent(commonevents.UserDisplayNameChanged, now, &protoevents.UserDisplayNameChanged{
+		UserId:              userId,
+		DisplayName:         displayName,
+		PreviousDisplayName: previousDisplayName,
+		TimeStamp:           now.Unix(),
+	})
+}
+
+func NewUserCountryChangedEvent(userId, country, previousCountry string) (*models.OutboxEvent, *apperrors.AppError) {
+	now := time.Now().UTC()
+	return newOutboxEvent(commonevents.UserCountryChanged, now, &protoevents.UserCountryChanged{
+		UserId:          userId,
+		Country:         country,
+		PreviousCountry: previousCountry,
+		TimeStamp:       now.Unix(),
+	})
+}
+
+func NewUserReservationRolledBackEvent(
+	userId, tournamentId string,
+	currency models.Currency,
+	amount int,
+) (*models.OutboxEvent, *apperrors.AppError) {
+	now := time.Now().UTC()
+	return newOutboxEvent(commonevents.UserReservationRolledBack, now, &protoevents.UserReservationRolledBack{
+		UserId:       userId,
+		TournamentId: tournamentId,
+		Amount:       int32(amount),
+		Currency:     string(currency),
+		TimeStamp:    now.Unix(),
+	})
+}
+
+func NewUserFriendAddedEvent(userId, friendId string) (*models.OutboxEvent, *apperrors.AppError) {
+	now := time.Now().UTC()
+	return newOutboxEvent(commonevents.UserFriendAdded, now, &protoevents.UserFriendAdded{
+		UserId:    userId,
+		FriendId:  friendId,
+		TimeStamp: now.Unix(),
+	})
+}
+
+func NewUserFriendRemovedEvent(userId, friendId string) (*models.OutboxEvent, *apperrors.AppError) {
+	now := time.Now().UTC()
+	return newOutboxEvent(commonevents.UserFriendRemoved, now, &protoevents.UserFriendRemoved{
+		UserId:    userId,
+		FriendId:  friendId,
+		TimeStamp: now.Unix(),
+	})
+}
+
+// Publish sends an outbox event, its event id keeps the stream from storing it
+// twice
+func (p *EventPublisher) Publish(ctx context.Context, event *models.OutboxEvent) *apperrors.AppError {
+	if err := p.publisher.PublishWithId(ctx, event.Subject, event.EventId, event.Payload); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to publish %s event: %v", event.Subject, err))
+		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish "+event.Subject+" event")
+	}
+
+	p.logger.Info(fmt.Sprintf("Published %s event: %s", event.Subject, event.EventId))
+	return nil
+}
+
+func newOutboxEvent(subject string, createdAt time.Time, message proto.Message) (*models.OutboxEvent, *apperrors.AppError) {
+	payload, err := proto.Marshal(message)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal "+subject+" event")
+	}
+
+	return &models.OutboxEvent{
+		EventId:   uuid.New().String(),
+		Subject:   subject,
+		Payload:   payload,
+		CreatedAt: createdAt,
+	}, nil
+}
@@ -24,35 +24,17 @@ func NewEventPublisher(client *natsjetstream.Client, logger *logger.Logger) *Eve
 	}
 }
 
-func (p *EventPublisher) PublishUserCreated(ctx context.Context, userId, displayName string) *apperrors.AppError {
-	event := &protoevents.UserCreated{
-		UserId:      userId,
-		DisplayName: displayName,
-		TimeStamp:   time.Now().UTC().Unix(),
+func (p *EventPublisher) PublishUserDeleted(ctx context.Context, userId string) *apperrors.AppError {
+	event := &protoevents.UserDeleted{
+		UserId:    userId,
+		TimeStamp: time.Now().UTC().Unix(),
 	}
 
-	if err := p.publisher.PublishProto(ctx, commonevents.UserCreated, event); err != nil {
-		p.logger.Error(fmt.Sprintf("Failed to publish user created event: %v", err))
-		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish user created event")
+	if err := p.publisher.PublishProto(ctx, commonevents.UserDeleted, event); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to publish user deleted event: %v", err))
+		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish user deleted event")
 	}
 
-	p.logger.Info(fmt.Sprintf("Published user created event for user: %s", userId))
-	return nil
-}
-
-func (p *EventPublisher) PublishUserLevelUp(ctx context.Context, userId string, levelIncrease int, newLevel int) *apperrors.AppError {
-	event := &protoevents.UserLevelUp{
-		UserId:        userId,
-		LevelIncrease: int32(levelIncrease),
-		NewLevel:      int32(newLevel),
-		TimeStamp:     time.Now().UTC().Unix(),
-	}
-
-	if err := p.publisher.PublishProto(ctx, commonevents.UserLevelUp, event); err != nil {
-		p.logger.Error(fmt.Sprintf("Failed to publish user level up event: %v", err))
-		return apperrors.Wrap(err, apperrors.CodeEventPublishError, "failed to publish user level up event")
-	}
-
-	p.logger.Info(fmt.Sprintf("Published user level up event for user: %s", userId))
+	p.logger.Info(fmt.Sprintf("Published user deleted event for user: %s", userId))
 	return nil
 }
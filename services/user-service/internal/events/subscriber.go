@@ -0,0 +1,579 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	commonevents "github.com/burakmert236/goodswipe-common/events"
+	protoevents "github.com/burakmert236/goodswipe-common/generated/v1/events"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-common/natsjetstream"
+	"github.com/burakmert236/goodswipe-user-service/internal/inbox"
+)
+
+// userEvent is an event that changes the data of its user
+type userEvent interface {
+	proto.Message
+	GetUserId() string
+}
+
+// userEvents lists the subjects whose events are dropped when their user was
+// deleted
+var userEvents = map[string]func() userEvent{
+	commonevents.UserLevelUp:                            func() userEvent { return &protoevents.UserLevelUp{} },
+	commonevents.UserReservationRolledBack:              func() userEvent { return &protoevents.UserReservationRolledBack{} },
+	commonevents.TournamentEntered:                      func() userEvent { return &protoevents.TournamentEntered{} },
+	commonevents.LeaderboardTournamentStandingFinalized: func() userEvent { return &protoevents.TournamentStandingFinalized{} },
+	commonevents.LeaderboardGroupRankOvertaken:          func() userEvent { return &protoevents.GroupRankOvertaken{} },
+}
+
+// DeletionTracker records a service confirming the removal of a user's data and
+// tells deleted users apart, so late events for them are dropped
+type DeletionTracker interface {
+	CompleteDeletionStep(ctx context.Context, userId, step string) *apperrors.AppError
+	IsUserDeleted(ctx context.Context, userId string) (bool, *apperrors.AppError)
+}
+
+// AchievementRecorder counts user progress towards achievements
+type AchievementRecorder interface {
+	RecordCounters(ctx context.Context, userId, eventKey string, increments map[models.AchievementMetric]int) *apperrors.AppError
+	RecordLevel(ctx context.Context, userId string, level, prestige int) *apperrors.AppError
+}
+
+// InboxDeliverer stores notifications in the inbox of their user
+type InboxDeliverer interface {
+	Deliver(ctx context.Context, notification inbox.Notification) *apperrors.AppError
+}
+
+// ReferralCompleter completes the referral of a referee who reached a milestone
+type ReferralCompleter interface {
+	CompleteReferral(ctx context.Context, refereeId string, milestone models.ReferralMilestone, level, prestige int) *apperrors.AppError
+}
+
+type EventSubscriber struct {
+	subscriber          *natsjetstream.Subscriber
+	deletionTracker     DeletionTracker
+	achievementRecorder AchievementRecorder
+	inboxDeliverer      InboxDeliverer
+	referralCompleter   ReferralCompleter
+	logger              *logger.Logger
+}
+
+func NewEventSubscriber(
+	natsClient *natsjetstream.Client,
+	deletionTracker DeletionTracker,
+	achievementRecorder AchievementRecorder,
+	inboxDeliverer InboxDeliverer,
+	referralCompleter ReferralCompleter,
+	logger *logger.Logger,
+) *EventSubscriber {
+	return &EventSubscriber{
+		subscriber:          natsjetstream.NewSubscriber(natsClient),
+		deletionTracker:     deletionTracker,
+		achievementRecorder: achievementRecorder,
+		inboxDeliverer:      inboxDeliverer,
+		referralCompleter:   referralCompleter,
+		logger:              logger.With("component", "event-subscriber"),
+	}
+}
+
+func (s *EventSubscriber) Start(ctx context.Context) *apperrors.AppError {
+	s.logger.Info("Starting event subscriptions")
+
+	if err := s.subscribeToPurgeEvents(ctx); err != nil {
+		return err
+	}
+
+	if err := s.subscribeToUserEvents(ctx); err != nil {
+		return err
+	}
+
+	if err := s.subscribeToTournamentEvents(ctx); err != nil {
+		return err
+	}
+
+	if err := s.subscribeToLeaderboardEvents(ctx); err != nil {
+		return err
+	}
+
+	if err := s.subscribeToInboxUserEvents(ctx); err != nil {
+		return err
+	}
+
+	if err := s.subscribeToInboxLeaderboardEvents(ctx); err != nil {
+		return err
+	}
+
+	if err := s.subscribeToReferralUserEvents(ctx); err != nil {
+		return err
+	}
+
+	if err := s.subscribeToReferralTournamentEvents(ctx); err != nil {
+		return err
+	}
+
+	s.logger.Info("All event subscriptions started")
+	return nil
+}
+
+func (s *EventSubscriber) subscribeToPurgeEvents(ctx context.Context) *apperrors.AppError {
+	cfg := natsjetstream.ConsumerConfig{
+		StreamName:   commonevents.PurgeEventsStream,
+		ConsumerName: "user-service-purge-consumer",
+		Durable:      "user-service-purge-consumer",
+		AckPolicy:    "explicit",
+	}
+
+	s.logger.Info("Subscribing to purge events",
+		"stream", cfg.StreamName,
+		"consumer", cfg.ConsumerName,
+	)
+
+	return s.subscriber.Subscribe(ctx, cfg, s.handlePurgeEvents)
+}
+
+func (s *EventSubscriber) subscribeToUserEvents(ctx context.Context) *apperrors.AppError {
+	cfg := natsjetstream.ConsumerConfig{
+		StreamName:   commonevents.UserEventsStream,
+		ConsumerName: "user-service-achievements-user-consumer",
+		Durable:      "user-service-achievements-user-consumer",
+		AckPolicy:    "explicit",
+	}
+
+	s.logger.Info("Subscribing to user events",
+		"stream", cfg.StreamName,
+		"consumer", cfg.ConsumerName,
+	)
+
+	return s.subscriber.Subscribe(ctx, cfg, s.skipDeletedUsers(s.handleUserEvents))
+}
+
+func (s *EventSubscriber) subscribeToTournamentEvents(ctx context.Context) *apperrors.AppError {
+	cfg := natsjetstream.ConsumerConfig{
+		StreamName:   commonevents.TournamentEventsStream,
+		ConsumerName: "user-service-achievements-tournament-consumer",
+		Durable:      "user-service-achievements-tournament-consumer",
+		AckPolicy:    "explicit",
+	}
+
+	s.logger.Info("Subscribing to tournament events",
+		"stream", cfg.StreamName,
+		"consumer", cfg.ConsumerName,
+	)
+
+	return s.subscriber.Subscribe(ctx, cfg, s.skipDeletedUsers(s.handleTournamentEvents))
+}
+
+func (s *EventSubscriber) subscribeToLeaderboardEvents(ctx context.Context) *apperrors.AppError {
+	cfg := natsjetstream.ConsumerConfig{
+		StreamName:   commonevents.LeaderboardEventsStream,
+		ConsumerName: "user-service-achievements-leaderboard-consumer",
+		Durable:      "user-service-achievements-leaderboard-consumer",
+		AckPolicy:    "explicit",
+	}
+
+	s.logger.Info("Subscribing to leaderboard events",
+		"stream", cfg.StreamName,
+		"consumer", cfg.ConsumerName,
+	)
+
+	return s.subscriber.Subscribe(ctx, cfg, s.skipDeletedUsers(s.handleLeaderboardEvents))
+}
+
+func (s *EventSubscriber) subscribeToInboxUserEvents(ctx context.Context) *apperrors.AppError {
+	cfg := natsjetstream.ConsumerConfig{
+		StreamName:   commonevents.UserEventsStream,
+		ConsumerName: "user-service-inbox-user-consumer",
+		Durable:      "user-service-inbox-user-consumer",
+		AckPolicy:    "explicit",
+	}
+
+	s.logger.Info("Subscribing to user events for the inbox",
+		"stream", cfg.StreamName,
+		"consumer", cfg.ConsumerName,
+	)
+
+	return s.subscriber.Subscribe(ctx, cfg, s.skipDeletedUsers(s.handleInboxUserEvents))
+}
+
+func (s *EventSubscriber) subscribeToInboxLeaderboardEvents(ctx context.Context) *apperrors.AppError {
+	cfg := natsjetstream.ConsumerConfig{
+		StreamName:   commonevents.LeaderboardEventsStream,
+		ConsumerName: "user-service-inbox-leaderboard-consumer",
+		Durable:      "user-service-inbox-leaderboard-consumer",
+		AckPolicy:    "explicit",
+	}
+
+	s.logger.Info("Subscribing to leaderboard events for the inbox",
+		"stream", cfg.StreamName,
+		"consumer", cfg.ConsumerName,
+	)
+
+	return s.subscriber.Subscribe(ctx, cfg, s.skipDeletedUsers(s.handleInboxLeaderboardEvents))
+}
+
+func (s *EventSubscriber) subscribeToReferralUserEvents(ctx context.Context) *apperrors.AppError {
+	cfg := natsjetstream.ConsumerConfig{
+		StreamName:   commonevents.UserEventsStream,
+		ConsumerName: "user-service-referrals-user-consumer",
+		Durable:      "user-service-referrals-user-consumer",
+		AckPolicy:    "explicit",
+	}
+
+	s.logger.Info("Subscribing to user events for referrals",
+		"stream", cfg.StreamName,
+		"consumer", cfg.ConsumerName,
+	)
+
+	return s.subscriber.Subscribe(ctx, cfg, s.skipDeletedUsers(s.handleReferralUserEvents))
+}
+
+func (s *EventSubscriber) subscribeToReferralTournamentEvents(ctx context.Context) *apperrors.AppError {
+	cfg := natsjetstream.ConsumerConfig{
+		StreamName:   commonevents.TournamentEventsStream,
+		ConsumerName: "user-service-referrals-tournament-consumer",
+		Durable:      "user-service-referrals-tournament-consumer",
+		AckPolicy:    "explicit",
+	}
+
+	s.logger.Info("Subscribing to tournament events for referrals",
+		"stream", cfg.StreamName,
+		"consumer", cfg.ConsumerName,
+	)
+
+	return s.subscriber.Subscribe(ctx, cfg, s.skipDeletedUsers(s.handleReferralTournamentEvents))
+}
+
+func (s *EventSubscriber) handlePurgeEvents(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	subject := msg.Subject()
+
+	s.logger.Debug("Received purge event", "subject", subject)
+
+	switch subject {
+	case commonevents.UserDataPurged:
+		return s.handleUserDataPurged(ctx, msg)
+	default:
+		s.logger.Warn("Unknown purge event subject", "subject", subject)
+		return nil
+	}
+}
+
+func (s *EventSubscriber) handleUserDataPurged(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserDataPurged
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Processing user data purged event",
+		"user_id", event.UserId,
+		"service", event.Service,
+	)
+
+	return s.deletionTracker.CompleteDeletionStep(ctx, event.UserId, event.Service)
+}
+
+func (s *EventSubscriber) handleUserEvents(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	subject := msg.Subject()
+
+	s.logger.Debug("Received user event", "subject", subject)
+
+	switch subject {
+	case commonevents.UserLevelUp:
+		return s.handleUserLevelUp(ctx, msg)
+	default:
+		// Other user events do not count towards achievements
+		return nil
+	}
+}
+
+func (s *EventSubscriber) handleTournamentEvents(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	subject := msg.Subject()
+
+	s.logger.Debug("Received tournament event", "subject", subject)
+
+	switch subject {
+	case commonevents.TournamentEntered:
+		return s.handleTournamentEntered(ctx, msg)
+	default:
+		// Other tournament events do not count towards achievements
+		return nil
+	}
+}
+
+func (s *EventSubscriber) handleLeaderboardEvents(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	subject := msg.Subject()
+
+	s.logger.Debug("Received leaderboard event", "subject", subject)
+
+	switch subject {
+	case commonevents.LeaderboardTournamentStandingFinalized:
+		return s.handleTournamentResult(ctx, msg)
+	default:
+		// Other leaderboard events do not count towards achievements
+		return nil
+	}
+}
+
+func (s *EventSubscriber) handleUserLevelUp(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserLevelUp
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Processing user level up event",
+		"user_id", event.UserId,
+		"new_level", event.NewLevel,
+		"prestige", event.Prestige,
+	)
+
+	return s.achievementRecorder.RecordLevel(ctx, event.UserId, int(event.NewLevel), int(event.Prestige))
+}
+
+func (s *EventSubscriber) handleTournamentEntered(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.TournamentEntered
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	if event.IsBot {
+		return nil
+	}
+
+	s.logger.Debug("Processing tournament entered event",
+		"user_id", event.UserId,
+		"tournament_id", event.TournamentId,
+	)
+
+	return s.achievementRecorder.RecordCounters(ctx, event.UserId, fmt.Sprintf("TOURNAMENT#%s#ENTERED", event.TournamentId),
+		map[models.AchievementMetric]int{
+			models.AchievementMetricTournamentsEntered: 1,
+		},
+	)
+}
+
+// handleTournamentResult counts wins and top three placements from the final
+// standing, whether or not the user claims the reward
+func (s *EventSubscriber) handleTournamentResult(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.TournamentStandingFinalized
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Processing tournament result",
+		"user_id", event.UserId,
+		"tournament_id", event.TournamentId,
+		"rank", event.Rank,
+	)
+
+	increments := make(map[models.AchievementMetric]int)
+	if event.Rank == 1 {
+		increments[models.AchievementMetricTournamentWins] = 1
+	}
+	if event.Rank >= 1 && event.Rank <= 3 {
+		increments[models.AchievementMetricTournamentTopThree] = 1
+	}
+
+	return s.achievementRecorder.RecordCounters(ctx, event.UserId, fmt.Sprintf("TOURNAMENT#%s#RESULT", event.TournamentId), increments)
+}
+
+func (s *EventSubscriber) handleInboxUserEvents(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	subject := msg.Subject()
+
+	s.logger.Debug("Received user event for the inbox", "subject", subject)
+
+	switch subject {
+	case commonevents.UserReservationRolledBack:
+		return s.handleUserReservationRolledBack(ctx, msg)
+	default:
+		// Other user events do not notify the user
+		return nil
+	}
+}
+
+func (s *EventSubscriber) handleInboxLeaderboardEvents(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	subject := msg.Subject()
+
+	s.logger.Debug("Received leaderboard event for the inbox", "subject", subject)
+
+	switch subject {
+	case commonevents.LeaderboardTournamentStandingFinalized:
+		return s.handleTournamentStandingFinalized(ctx, msg)
+	case commonevents.LeaderboardGroupRankOvertaken:
+		return s.handleGroupRankOvertaken(ctx, msg)
+	default:
+		s.logger.Warn("Unknown leaderboard event subject", "subject", subject)
+		return nil
+	}
+}
+
+func (s *EventSubscriber) handleUserReservationRolledBack(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.UserReservationRolledBack
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Processing user reservation rolled back event",
+		"user_id", event.UserId,
+		"tournament_id", event.TournamentId,
+	)
+
+	return s.inboxDeliverer.Deliver(ctx, inbox.Notification{
+		UserId:      event.UserId,
+		Type:        models.InboxMessageTypeReservationRolledBack,
+		EventKey:    fmt.Sprintf("RESERVATION#%s", event.TournamentId),
+		OccurredAt:  time.Unix(event.TimeStamp, 0),
+		ReferenceId: event.TournamentId,
+		Data: map[string]any{
+			"TournamentId": event.TournamentId,
+			"Amount":       event.Amount,
+			"Currency":     event.Currency,
+		},
+	})
+}
+
+// handleTournamentStandingFinalized tells the user the tournament ended, a
+// placement with a reward is announced as a reward to claim
+func (s *EventSubscriber) handleTournamentStandingFinalized(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.TournamentStandingFinalized
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Processing tournament standing finalized event",
+		"user_id", event.UserId,
+		"tournament_id", event.TournamentId,
+		"rank", event.Rank,
+	)
+
+	messageType := models.InboxMessageTypeTournamentEnded
+	if event.Reward > 0 {
+		messageType = models.InboxMessageTypeRewardAvailable
+	}
+
+	return s.inboxDeliverer.Deliver(ctx, inbox.Notification{
+		UserId:      event.UserId,
+		Type:        messageType,
+		EventKey:    fmt.Sprintf("TOURNAMENT#%s#STANDING", event.TournamentId),
+		OccurredAt:  time.Unix(event.TimeStamp, 0),
+		ReferenceId: event.TournamentId,
+		Data: map[string]any{
+			"TournamentId": event.TournamentId,
+			"Rank":         event.Rank,
+			"Score":        event.Score,
+			"Reward":       event.Reward,
+			"Currency":     event.Currency,
+		},
+	})
+}
+
+func (s *EventSubscriber) handleGroupRankOvertaken(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	var event protoevents.GroupRankOvertaken
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Processing group rank overtaken event",
+		"user_id", event.UserId,
+		"tournament_id", event.TournamentId,
+		"overtaken_by", event.OvertakenById,
+	)
+
+	overtakenBy := event.OvertakenByDisplayName
+	if overtakenBy == "" {
+		overtakenBy = "Another player"
+	}
+
+	return s.inboxDeliverer.Deliver(ctx, inbox.Notification{
+		UserId:      event.UserId,
+		Type:        models.InboxMessageTypeOvertaken,
+		EventKey:    fmt.Sprintf("TOURNAMENT#%s#OVERTAKEN#%s", event.TournamentId, event.OvertakenById),
+		OccurredAt:  time.Unix(event.TimeStamp, 0),
+		ReferenceId: event.TournamentId,
+		Data: map[string]any{
+			"TournamentId": event.TournamentId,
+			"OvertakenBy":  overtakenBy,
+			"Rank":         event.Rank,
+		},
+	})
+}
+
+func (s *EventSubscriber) handleReferralUserEvents(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	if msg.Subject() != commonevents.UserLevelUp {
+		// Only level-ups can reach a referral milestone
+		return nil
+	}
+
+	var event protoevents.UserLevelUp
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Processing user level up event for referrals",
+		"user_id", event.UserId,
+		"new_level", event.NewLevel,
+		"prestige", event.Prestige,
+	)
+
+	return s.referralCompleter.CompleteReferral(ctx, event.UserId, models.ReferralMilestoneLevel, int(event.NewLevel), int(event.Prestige))
+}
+
+func (s *EventSubscriber) handleReferralTournamentEvents(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+	if msg.Subject() != commonevents.TournamentEntered {
+		// Only entries can reach a referral milestone
+		return nil
+	}
+
+	var event protoevents.TournamentEntered
+	if err := natsjetstream.UnmarshalProto(msg, &event); err != nil {
+		return err
+	}
+
+	if event.IsBot {
+		return nil
+	}
+
+	s.logger.Debug("Processing tournament entered event for referrals",
+		"user_id", event.UserId,
+		"tournament_id", event.TournamentId,
+	)
+
+	return s.referralCompleter.CompleteReferral(ctx, event.UserId, models.ReferralMilestoneTournamentEntered, 0, 0)
+}
+
+// skipDeletedUsers drops the events of deleted users before they reach handle.
+// A late or redelivered event would bring their data back. Events that do not
+// decode are left to handle.
+func (s *EventSubscriber) skipDeletedUsers(handle natsjetstream.MessageHandler) natsjetstream.MessageHandler {
+	return func(ctx context.Context, msg jetstream.Msg) *apperrors.AppError {
+		newEvent, ok := userEvents[msg.Subject()]
+		if !ok {
+			return handle(ctx, msg)
+		}
+
+		event := newEvent()
+		if err := natsjetstream.UnmarshalProto(msg, event); err != nil {
+			return handle(ctx, msg)
+		}
+
+		deleted, err := s.deletionTracker.IsUserDeleted(ctx, event.GetUserId())
+		if err != nil {
+			return err
+		}
+
+		if deleted {
+			s.logger.Info("Dropping event for deleted user",
+				"subject", msg.Subject(),
+				"user_id", event.GetUserId(),
+			)
+			return nil
+		}
+
+		return handle(ctx, msg)
+	}
+}
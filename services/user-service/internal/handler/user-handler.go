@@ -2,23 +2,51 @@ package handler
 
 import (
 	"context"
+	"time"
 
+	"github.com/burakmert236/goodswipe-common/auth"
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
 	proto "github.com/burakmert236/goodswipe-common/generated/v1/grpc"
 	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	usererrors "github.com/burakmert236/goodswipe-user-service/internal/errors"
 	"github.com/burakmert236/goodswipe-user-service/internal/service"
 )
 
 type UserHandler struct {
 	proto.UnimplementedUserServiceServer
-	userService service.UserService
-	logger      *logger.Logger
+	userService         service.UserService
+	userDeletionService service.UserDeletionService
+	achievementService  service.AchievementService
+	friendService       service.FriendService
+	inboxService        service.InboxService
+	pushService         service.PushService
+	referralService     service.ReferralService
+	moderationService   service.ModerationService
+	logger              *logger.Logger
 }
 
-func NewUserHandler(UserService service.UserService, logger *logger.Logger) *UserHandler {
+func NewUserHandler(
+	UserService service.UserService,
+	userDeletionService service.UserDeletionService,
+	achievementService service.AchievementService,
+	friendService service.FriendService,
+	inboxService service.InboxService,
+	pushService service.PushService,
+	referralService service.ReferralService,
+	moderationService service.ModerationService,
+	logger *logger.Logger,
+) *UserHandler {
 	return &UserHandler{
-		userService: UserService,
-		logger:      logger,
+		userService:         UserService,
+		userDeletionService: userDeletionService,
+		achievementService:  achievementService,
+		friendService:       friendService,
+		inboxService:        inboxService,
+		pushService:         pushService,
+		referralService:     referralService,
+		moderationService:   moderationService,
+		logger:              logger,
 	}
 }
 
@@ -27,70 +55,175 @@ func (h *UserHandler) CreateUser(ctx context.Context, req *proto.CreateUserReque
 		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "display name is required"))
 	}
 
-	user, err := h.userService.CreateUser(ctx, req.DisplayName)
+	user, err := h.userService.CreateUser(ctx, req.DisplayName, req.ReferralCode, req.Country)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
 
 	resp := &proto.CreateUserResponse{
-		UserId: user.UserId,
+		UserId:       user.UserId,
+		ReferralCode: user.ReferralCode,
 	}
 
 	return resp, nil
 }
 
+func (h *UserHandler) UpdateDisplayName(ctx context.Context, req *proto.UpdateDisplayNameRequest) (*proto.UpdateDisplayNameResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	if req.DisplayName == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "display name is required"))
+	}
+
+	user, err := h.userService.UpdateDisplayName(ctx, userId, req.DisplayName)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	message := &proto.UpdateDisplayNameResponse{
+		UserId:      user.UserId,
+		DisplayName: user.DisplayName,
+	}
+
+	return message, nil
+}
+
+func (h *UserHandler) UpdateCountry(ctx context.Context, req *proto.UpdateCountryRequest) (*proto.UpdateCountryResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	user, err := h.userService.UpdateCountry(ctx, userId, req.Country)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	message := &proto.UpdateCountryResponse{
+		UserId:  user.UserId,
+		Country: user.Country,
+	}
+
+	return message, nil
+}
+
+func (h *UserHandler) DeleteUser(ctx context.Context, req *proto.DeleteUserRequest) (*proto.UserDeletionStatusResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	deletion, err := h.userDeletionService.DeleteUser(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return deletionToProto(deletion), nil
+}
+
+func (h *UserHandler) GetUserDeletionStatus(
+	ctx context.Context,
+	req *proto.GetUserDeletionStatusRequest,
+) (*proto.UserDeletionStatusResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	deletion, err := h.userDeletionService.GetDeletionStatus(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return deletionToProto(deletion), nil
+}
+
 func (h *UserHandler) UpdateProgress(ctx context.Context, req *proto.UpdateProgressRequest) (*proto.UpdateProgressResponse, error) {
-	if req.UserId == "" {
-		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id is required"))
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
 	}
+
 	if req.ProgressAmount <= 0 {
 		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "Progress amount must be a positive number"))
 	}
 
-	user, err := h.userService.UpdateProgress(ctx, req.UserId, int(req.ProgressAmount))
+	user, err := h.userService.UpdateProgress(ctx, userId, int(req.ProgressAmount))
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
 
 	message := &proto.UpdateProgressResponse{
-		UserId: user.UserId,
-		Level:  int32(user.Level),
-		Coin:   int32(user.Coin),
+		UserId:   user.UserId,
+		Level:    int32(user.Level),
+		Coin:     int32(user.Coin),
+		Xp:       int32(user.XP),
+		Prestige: int32(user.Prestige),
 	}
 
 	return message, nil
 }
 
 func (h *UserHandler) GetById(ctx context.Context, req *proto.GetUserByIdRequest) (*proto.GetUserByIdResponse, error) {
-	if req.UserId == "" {
-		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id is required"))
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
 	}
 
-	user, err := h.userService.GetById(ctx, req.UserId)
+	user, err := h.userService.GetById(ctx, userId)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
 
-	message := &proto.GetUserByIdResponse{
-		UserId:      user.UserId,
-		DisplayName: user.DisplayName,
-		Level:       int32(user.Level),
-		Coin:        int32(user.Coin),
+	return userToProto(user), nil
+}
+
+func (h *UserHandler) GetUsersByIds(ctx context.Context, req *proto.GetUsersByIdsRequest) (*proto.GetUsersByIdsResponse, error) {
+	if len(req.UserIds) == 0 {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user ids are required"))
+	}
+
+	users, missingUserIds, err := h.userService.GetUsersByIds(ctx, req.UserIds)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	profiles := make([]*proto.GetUserByIdResponse, 0, len(users))
+	for _, user := range users {
+		profiles = append(profiles, userToProto(user))
+	}
+
+	message := &proto.GetUsersByIdsResponse{
+		Users:          profiles,
+		MissingUserIds: missingUserIds,
 	}
 
 	return message, nil
 }
 
 func (h *UserHandler) CollectTournamentReward(ctx context.Context, req *proto.CollectTournamentRewardRequest) (*proto.MessageResponse, error) {
-	if req.UserId == "" || req.TournamentId == "" {
-		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id is required"))
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	if req.TournamentId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "tournament id is required"))
 	}
 
 	if req.Coin <= 0 {
 		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "Reward must be a positive number"))
 	}
 
-	err := h.userService.CollectTournamentReward(ctx, req.UserId, req.TournamentId, int(req.Coin))
+	currency, err := parseCurrency(req.Currency)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	err = h.userService.CollectTournamentReward(ctx, userId, req.TournamentId, currency, int(req.Coin))
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
@@ -103,8 +236,425 @@ func (h *UserHandler) CollectTournamentReward(ctx context.Context, req *proto.Co
 	return message, nil
 }
 
+func (h *UserHandler) CollectSeasonReward(ctx context.Context, req *proto.CollectSeasonRewardRequest) (*proto.MessageResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	if req.SeasonId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "season id is required"))
+	}
+
+	if req.Coin <= 0 {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "Reward must be a positive number"))
+	}
+
+	err = h.userService.CollectSeasonReward(ctx, userId, req.SeasonId, int(req.Coin))
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	message := &proto.MessageResponse{
+		IsSuccess: true,
+		Message:   "Collecting season reward for user is succesful",
+	}
+
+	return message, nil
+}
+
+func (h *UserHandler) ListCoinTransactions(ctx context.Context, req *proto.ListCoinTransactionsRequest) (*proto.ListCoinTransactionsResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	coinTransactions, nextPageToken, err := h.userService.ListCoinTransactions(ctx, userId, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	transactions := make([]*proto.CoinTransaction, 0, len(coinTransactions))
+	for _, coinTransaction := range coinTransactions {
+		transactions = append(transactions, &proto.CoinTransaction{
+			TransactionId: coinTransaction.TransactionId,
+			Currency:      string(models.CurrencyOrDefault(string(coinTransaction.Currency))),
+			Amount:        int64(coinTransaction.Amount),
+			BalanceAfter:  int64(coinTransaction.BalanceAfter),
+			Reason:        string(coinTransaction.Reason),
+			ReferenceId:   coinTransaction.ReferenceId,
+			CreatedAt:     coinTransaction.CreatedAt.Unix(),
+		})
+	}
+
+	message := &proto.ListCoinTransactionsResponse{
+		Transactions:  transactions,
+		NextPageToken: nextPageToken,
+	}
+
+	return message, nil
+}
+
+func (h *UserHandler) ClaimDailyReward(ctx context.Context, req *proto.ClaimDailyRewardRequest) (*proto.ClaimDailyRewardResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	dailyRewardClaim, err := h.userService.ClaimDailyReward(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	message := &proto.ClaimDailyRewardResponse{
+		UserId:         userId,
+		Day:            dailyRewardClaim.Claim.Day,
+		Streak:         int32(dailyRewardClaim.Claim.Streak),
+		Reward:         int32(dailyRewardClaim.Claim.Reward),
+		FreezesUsed:    int32(dailyRewardClaim.Claim.FreezesUsed),
+		StreakFreezes:  int32(dailyRewardClaim.Streak.Freezes),
+		Coin:           int32(dailyRewardClaim.User.Coin),
+		AlreadyClaimed: dailyRewardClaim.AlreadyClaimed,
+	}
+
+	return message, nil
+}
+
+func (h *UserHandler) PurchaseStreakFreeze(ctx context.Context, req *proto.PurchaseStreakFreezeRequest) (*proto.PurchaseStreakFreezeResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	streak, user, err := h.userService.PurchaseStreakFreeze(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	message := &proto.PurchaseStreakFreezeResponse{
+		UserId:        userId,
+		StreakFreezes: int32(streak.Freezes),
+		Coin:          int32(user.Coin),
+	}
+
+	return message, nil
+}
+
+func (h *UserHandler) ListAchievements(ctx context.Context, req *proto.ListAchievementsRequest) (*proto.ListAchievementsResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	progress, err := h.achievementService.ListAchievements(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	achievements := make([]*proto.Achievement, 0, len(progress))
+	for _, achievement := range progress {
+		message := &proto.Achievement{
+			AchievementId: achievement.Definition.Id,
+			Name:          achievement.Definition.Name,
+			Description:   achievement.Definition.Description,
+			Metric:        string(achievement.Definition.Metric),
+			Threshold:     int32(achievement.Definition.Threshold),
+			Progress:      int32(achievement.Progress),
+			Reward:        int32(achievement.Definition.Reward),
+		}
+
+		if achievement.Unlocked != nil {
+			message.Unlocked = true
+			message.UnlockedAt = achievement.Unlocked.UnlockedAt.Unix()
+		}
+
+		achievements = append(achievements, message)
+	}
+
+	return &proto.ListAchievementsResponse{Achievements: achievements}, nil
+}
+
+func (h *UserHandler) SendFriendRequest(ctx context.Context, req *proto.SendFriendRequestRequest) (*proto.FriendshipResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	if req.FriendId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "friend id is required"))
+	}
+
+	friendship, err := h.friendService.SendFriendRequest(ctx, userId, req.FriendId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.FriendshipResponse{Friend: friendshipToProto(friendship, "")}, nil
+}
+
+func (h *UserHandler) AcceptFriendRequest(ctx context.Context, req *proto.AcceptFriendRequestRequest) (*proto.FriendshipResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	if req.FriendId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "friend id is required"))
+	}
+
+	friendship, err := h.friendService.AcceptFriendRequest(ctx, userId, req.FriendId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.FriendshipResponse{Friend: friendshipToProto(friendship, "")}, nil
+}
+
+func (h *UserHandler) RemoveFriend(ctx context.Context, req *proto.RemoveFriendRequest) (*proto.MessageResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	if req.FriendId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "friend id is required"))
+	}
+
+	if err := h.friendService.RemoveFriend(ctx, userId, req.FriendId); err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.MessageResponse{
+		IsSuccess: true,
+		Message:   "friend removed successfully",
+	}, nil
+}
+
+func (h *UserHandler) ListFriends(ctx context.Context, req *proto.ListFriendsRequest) (*proto.ListFriendsResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	status := models.FriendshipStatus(req.Status)
+	if status != "" && !status.IsValid() {
+		return nil, apperrors.ToGRPCError(usererrors.InvalidFriendshipStatusError(req.Status))
+	}
+
+	friends, err := h.friendService.ListFriends(ctx, userId, status)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	response := &proto.ListFriendsResponse{Friends: make([]*proto.Friend, 0, len(friends))}
+	for _, friend := range friends {
+		response.Friends = append(response.Friends, friendshipToProto(&friend.Friendship, friend.DisplayName))
+	}
+
+	return response, nil
+}
+
+func (h *UserHandler) ListInbox(ctx context.Context, req *proto.ListInboxRequest) (*proto.ListInboxResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	messages, nextPageToken, err := h.inboxService.ListInbox(ctx, userId, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	unreadCount, err := h.inboxService.GetUnreadCount(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	response := &proto.ListInboxResponse{
+		Messages:      make([]*proto.InboxMessage, 0, len(messages)),
+		NextPageToken: nextPageToken,
+		UnreadCount:   int32(unreadCount),
+	}
+	for _, message := range messages {
+		response.Messages = append(response.Messages, inboxMessageToProto(&message))
+	}
+
+	return response, nil
+}
+
+func (h *UserHandler) MarkInboxRead(ctx context.Context, req *proto.MarkInboxReadRequest) (*proto.MarkInboxReadResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	marked, err := h.inboxService.MarkRead(ctx, userId, req.MessageIds)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	unreadCount, err := h.inboxService.GetUnreadCount(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.MarkInboxReadResponse{
+		Marked:      int32(marked),
+		UnreadCount: int32(unreadCount),
+	}, nil
+}
+
+func (h *UserHandler) GetInboxUnreadCount(ctx context.Context, req *proto.GetInboxUnreadCountRequest) (*proto.InboxUnreadCountResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	unreadCount, err := h.inboxService.GetUnreadCount(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.InboxUnreadCountResponse{UnreadCount: int32(unreadCount)}, nil
+}
+
+func (h *UserHandler) GetPushPreferences(ctx context.Context, req *proto.GetPushPreferencesRequest) (*proto.PushPreferencesResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	preferences, err := h.pushService.GetPreferences(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.PushPreferencesResponse{Preferences: pushPreferencesToProto(preferences)}, nil
+}
+
+func (h *UserHandler) UpdatePushPreferences(ctx context.Context, req *proto.UpdatePushPreferencesRequest) (*proto.PushPreferencesResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	mutedTypes := make([]models.InboxMessageType, 0, len(req.MutedTypes))
+	for _, mutedType := range req.MutedTypes {
+		mutedTypes = append(mutedTypes, models.InboxMessageType(mutedType))
+	}
+
+	preferences, err := h.pushService.UpdatePreferences(ctx, &models.PushPreferences{
+		UserId:          userId,
+		Enabled:         req.Enabled,
+		MutedTypes:      mutedTypes,
+		QuietHoursStart: req.QuietHoursStart,
+		QuietHoursEnd:   req.QuietHoursEnd,
+		Timezone:        req.Timezone,
+	})
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.PushPreferencesResponse{Preferences: pushPreferencesToProto(preferences)}, nil
+}
+
+func (h *UserHandler) GetReferralInfo(ctx context.Context, req *proto.GetReferralInfoRequest) (*proto.GetReferralInfoResponse, error) {
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	info, err := h.referralService.GetReferralInfo(ctx, userId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	referrals := make([]*proto.Referral, 0, len(info.Referrals))
+	for _, referee := range info.Referrals {
+		referrals = append(referrals, referralToProto(&referee.Referral, referee.DisplayName))
+	}
+
+	return &proto.GetReferralInfoResponse{
+		ReferralCode: info.Code,
+		ReferredBy:   info.ReferredBy,
+		Referrals:    referrals,
+	}, nil
+}
+
+func (h *UserHandler) GrantCurrency(ctx context.Context, req *proto.GrantCurrencyRequest) (*proto.BalanceAdjustmentResponse, error) {
+	return h.adjustBalance(ctx, models.BalanceAdjustmentTypeGrant, req.UserId, req.Currency, req.Amount, req.OperatorId, req.Reason, req.TicketReference, req.RequestId)
+}
+
+func (h *UserHandler) RevokeCurrency(ctx context.Context, req *proto.RevokeCurrencyRequest) (*proto.BalanceAdjustmentResponse, error) {
+	return h.adjustBalance(ctx, models.BalanceAdjustmentTypeRevoke, req.UserId, req.Currency, req.Amount, req.OperatorId, req.Reason, req.TicketReference, req.RequestId)
+}
+
+func (h *UserHandler) SuspendUser(ctx context.Context, req *proto.SuspendUserRequest) (*proto.ModerationResponse, error) {
+	if req.UserId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id is required"))
+	}
+
+	operatorId, err := resolveOperatorId(ctx, req.OperatorId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	moderation, err := h.moderationService.SuspendUser(ctx, req.UserId, time.Unix(req.SuspendedUntil, 0), operatorId, req.Reason)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return moderationToProto(req.UserId, moderation), nil
+}
+
+func (h *UserHandler) BanUser(ctx context.Context, req *proto.BanUserRequest) (*proto.ModerationResponse, error) {
+	if req.UserId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id is required"))
+	}
+
+	operatorId, err := resolveOperatorId(ctx, req.OperatorId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	moderation, err := h.moderationService.BanUser(ctx, req.UserId, operatorId, req.Reason)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return moderationToProto(req.UserId, moderation), nil
+}
+
+func (h *UserHandler) ReinstateUser(ctx context.Context, req *proto.ReinstateUserRequest) (*proto.ModerationResponse, error) {
+	if req.UserId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id is required"))
+	}
+
+	operatorId, err := resolveOperatorId(ctx, req.OperatorId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	moderation, err := h.moderationService.ReinstateUser(ctx, req.UserId, operatorId, req.Reason)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return moderationToProto(req.UserId, moderation), nil
+}
+
 func (h *UserHandler) ReserveCoins(ctx context.Context, req *proto.ReserveCoinsRequest) (*proto.MessageResponse, error) {
-	err := h.userService.ReserveCoins(ctx, req.UserId, int(req.Amount), req.TournamentId)
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	currency, err := parseCurrency(req.Currency)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	err = h.userService.ReserveCoins(ctx, userId, currency, int(req.Amount), req.TournamentId)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
@@ -116,7 +666,12 @@ func (h *UserHandler) ReserveCoins(ctx context.Context, req *proto.ReserveCoinsR
 }
 
 func (h *UserHandler) ConfirmReservation(ctx context.Context, req *proto.ConfirmReservationRequest) (*proto.MessageResponse, error) {
-	err := h.userService.ConfirmReservation(ctx, req.UserId, req.TournamentId)
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	err = h.userService.ConfirmReservation(ctx, userId, req.TournamentId)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
@@ -128,7 +683,12 @@ func (h *UserHandler) ConfirmReservation(ctx context.Context, req *proto.Confirm
 }
 
 func (h *UserHandler) RollbackReservation(ctx context.Context, req *proto.RollbackReservationRequest) (*proto.MessageResponse, error) {
-	err := h.userService.RollbackReservation(ctx, req.UserId, req.TournamentId)
+	userId, err := auth.ResolveUserId(ctx, req.UserId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	err = h.userService.RollbackReservation(ctx, userId, req.TournamentId)
 	if err != nil {
 		return nil, apperrors.ToGRPCError(err)
 	}
@@ -138,3 +698,206 @@ func (h *UserHandler) RollbackReservation(ctx context.Context, req *proto.Rollba
 		Message:   "reservation rollbacked successfully",
 	}, nil
 }
+
+// Private methods
+
+func (h *UserHandler) adjustBalance(
+	ctx context.Context,
+	adjustmentType models.BalanceAdjustmentType,
+	requestedUserId, requestedCurrency string,
+	amount int32,
+	requestedOperatorId, reason, ticketReference, requestId string,
+) (*proto.BalanceAdjustmentResponse, error) {
+	if requestedUserId == "" {
+		return nil, apperrors.ToGRPCError(apperrors.New(apperrors.CodeInvalidInput, "user id is required"))
+	}
+
+	operatorId, err := resolveOperatorId(ctx, requestedOperatorId)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	currency, err := parseCurrency(requestedCurrency)
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	result, err := h.userService.AdjustBalance(ctx, &models.BalanceAdjustment{
+		UserId:          requestedUserId,
+		RequestId:       requestId,
+		Type:            adjustmentType,
+		Currency:        currency,
+		Amount:          int(amount),
+		OperatorId:      operatorId,
+		Reason:          reason,
+		TicketReference: ticketReference,
+	})
+	if err != nil {
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	return &proto.BalanceAdjustmentResponse{
+		Adjustment:     balanceAdjustmentToProto(result.Adjustment),
+		BalanceAfter:   int32(result.BalanceAfter),
+		AlreadyApplied: result.AlreadyApplied,
+	}, nil
+}
+
+// resolveOperatorId returns the admin making an admin call. The operator is
+// always the authenticated admin, admin methods are rejected without auth and a
+// differing operator id in the request is rejected.
+func resolveOperatorId(ctx context.Context, requestedOperatorId string) (string, *apperrors.AppError) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.Subject == "" {
+		return "", apperrors.New(apperrors.CodeUnauthorized, "admin methods require an authenticated admin")
+	}
+	if principal.Role != auth.RoleAdmin {
+		return "", apperrors.New(apperrors.CodeForbidden, "admin methods require the admin role")
+	}
+
+	if requestedOperatorId != "" && requestedOperatorId != principal.Subject {
+		return "", apperrors.New(apperrors.CodeForbidden, "operator id does not match the authenticated admin")
+	}
+	return principal.Subject, nil
+}
+
+func userToProto(user *models.User) *proto.GetUserByIdResponse {
+	message := &proto.GetUserByIdResponse{
+		UserId:      user.UserId,
+		DisplayName: user.DisplayName,
+		Level:       int32(user.Level),
+		Coin:        int32(user.Coin),
+		CreatedAt:   user.CreatedAt.Unix(),
+		Balances:    balancesToProto(user.Balances()),
+		Xp:          int32(user.XP),
+		Prestige:    int32(user.Prestige),
+		Country:     user.Country,
+	}
+
+	message.ModerationStatus = string(user.ModerationStatus(time.Now()))
+	if message.ModerationStatus == string(models.ModerationStatusSuspended) {
+		message.SuspendedUntil = user.Moderation.SuspendedUntil.Unix()
+	}
+
+	return message
+}
+
+// parseCurrency defaults to coin so callers that predate the wallet keep working
+func parseCurrency(value string) (models.Currency, *apperrors.AppError) {
+	currency := models.CurrencyOrDefault(value)
+	if !currency.IsValid() {
+		return "", usererrors.InvalidCurrencyError(value)
+	}
+	return currency, nil
+}
+
+func friendshipToProto(friendship *models.Friendship, displayName string) *proto.Friend {
+	return &proto.Friend{
+		UserId:      friendship.FriendId,
+		DisplayName: displayName,
+		Status:      string(friendship.Status),
+		UpdatedAt:   friendship.UpdatedAt.Unix(),
+	}
+}
+
+func inboxMessageToProto(message *models.InboxMessage) *proto.InboxMessage {
+	result := &proto.InboxMessage{
+		MessageId:   message.MessageId,
+		Type:        string(message.Type),
+		Title:       message.Title,
+		Body:        message.Body,
+		ReferenceId: message.ReferenceId,
+		IsRead:      message.IsRead,
+		CreatedAt:   message.CreatedAt.Unix(),
+	}
+
+	if message.ReadAt != nil {
+		result.ReadAt = message.ReadAt.Unix()
+	}
+
+	return result
+}
+
+func pushPreferencesToProto(preferences *models.PushPreferences) *proto.PushPreferences {
+	mutedTypes := make([]string, 0, len(preferences.MutedTypes))
+	for _, mutedType := range preferences.MutedTypes {
+		mutedTypes = append(mutedTypes, string(mutedType))
+	}
+
+	return &proto.PushPreferences{
+		Enabled:         preferences.Enabled,
+		MutedTypes:      mutedTypes,
+		QuietHoursStart: preferences.QuietHoursStart,
+		QuietHoursEnd:   preferences.QuietHoursEnd,
+		Timezone:        preferences.Timezone,
+	}
+}
+
+func referralToProto(referral *models.Referral, displayName string) *proto.Referral {
+	result := &proto.Referral{
+		RefereeId:        referral.RefereeId,
+		DisplayName:      displayName,
+		Status:           string(referral.Status),
+		ReferrerRewarded: referral.ReferrerRewarded,
+		CreatedAt:        referral.CreatedAt.Unix(),
+	}
+
+	if referral.CompletedAt != nil {
+		result.CompletedAt = referral.CompletedAt.Unix()
+	}
+
+	return result
+}
+
+func balanceAdjustmentToProto(adjustment *models.BalanceAdjustment) *proto.BalanceAdjustment {
+	return &proto.BalanceAdjustment{
+		RequestId:       adjustment.RequestId,
+		UserId:          adjustment.UserId,
+		Type:            string(adjustment.Type),
+		Currency:        string(adjustment.Currency),
+		Amount:          int32(adjustment.Amount),
+		OperatorId:      adjustment.OperatorId,
+		Reason:          adjustment.Reason,
+		TicketReference: adjustment.TicketReference,
+		CreatedAt:       adjustment.CreatedAt.Unix(),
+	}
+}
+
+func moderationToProto(userId string, moderation *models.Moderation) *proto.ModerationResponse {
+	message := &proto.ModerationResponse{
+		UserId:     userId,
+		Status:     string(moderation.Status),
+		Reason:     moderation.Reason,
+		OperatorId: moderation.OperatorId,
+		UpdatedAt:  moderation.UpdatedAt.Unix(),
+	}
+
+	if moderation.SuspendedUntil != nil {
+		message.SuspendedUntil = moderation.SuspendedUntil.Unix()
+	}
+
+	return message
+}
+
+func balancesToProto(balances map[models.Currency]int) map[string]int32 {
+	result := make(map[string]int32, len(balances))
+	for currency, balance := range balances {
+		result[string(currency)] = int32(balance)
+	}
+	return result
+}
+
+func deletionToProto(deletion *models.UserDeletion) *proto.UserDeletionStatusResponse {
+	message := &proto.UserDeletionStatusResponse{
+		UserId:          deletion.UserId,
+		Status:          string(deletion.Status),
+		PendingServices: deletion.PendingSteps(),
+		RequestedAt:     deletion.RequestedAt.Unix(),
+	}
+
+	if deletion.CompletedAt != nil {
+		message.CompletedAt = deletion.CompletedAt.Unix()
+	}
+
+	return message
+}
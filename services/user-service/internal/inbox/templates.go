@@ -0,0 +1,114 @@
+package inbox
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+const defaultTTLDays = 30
+
+// Notification is a domain event addressed to a user. EventKey identifies the
+// event, so a redelivered or republished event is stored once, OccurredAt
+// orders the messages. Data is passed to the templates of the message type.
+type Notification struct {
+	UserId      string
+	Type        models.InboxMessageType
+	EventKey    string
+	OccurredAt  time.Time
+	ReferenceId string
+	Data        any
+}
+
+// Renderer renders inbox messages from the title and body template of their type
+type Renderer struct {
+	TTL       time.Duration
+	templates map[models.InboxMessageType]messageTemplate
+}
+
+type messageTemplate struct {
+	title *template.Template
+	body  *template.Template
+}
+
+// Render executes the templates of the message type with the given data
+func (r *Renderer) Render(messageType models.InboxMessageType, data any) (string, string, *apperrors.AppError) {
+	messageTemplate, ok := r.templates[messageType]
+	if !ok {
+		return "", "", apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("no inbox template for message type: %s", messageType))
+	}
+
+	var title, body bytes.Buffer
+	if err := messageTemplate.title.Execute(&title, data); err != nil {
+		return "", "", apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to render inbox message title")
+	}
+	if err := messageTemplate.body.Execute(&body, data); err != nil {
+		return "", "", apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to render inbox message body")
+	}
+
+	return title.String(), body.String(), nil
+}
+
+// FromConfig builds the renderer, configured templates replace the default
+// template of their type
+func FromConfig(cfg config.InboxConfig) (*Renderer, *apperrors.AppError) {
+	ttlDays := cfg.TTLDays
+	if ttlDays <= 0 {
+		ttlDays = defaultTTLDays
+	}
+
+	sources := defaultTemplates()
+	for _, templateConfig := range cfg.Templates {
+		messageType := models.InboxMessageType(templateConfig.Type)
+		if !messageType.IsValid() {
+			return nil, apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("unknown inbox message type: %s", templateConfig.Type))
+		}
+		sources[messageType] = [2]string{templateConfig.Title, templateConfig.Body}
+	}
+
+	renderer := &Renderer{
+		TTL:       time.Duration(ttlDays) * 24 * time.Hour,
+		templates: make(map[models.InboxMessageType]messageTemplate, len(sources)),
+	}
+
+	for messageType, source := range sources {
+		title, err := template.New(string(messageType) + ".title").Option("missingkey=error").Parse(source[0])
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeInvalidInput, fmt.Sprintf("invalid inbox title template for %s", messageType))
+		}
+		body, err := template.New(string(messageType) + ".body").Option("missingkey=error").Parse(source[1])
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeInvalidInput, fmt.Sprintf("invalid inbox body template for %s", messageType))
+		}
+		renderer.templates[messageType] = messageTemplate{title: title, body: body}
+	}
+
+	return renderer, nil
+}
+
+// defaultTemplates holds the title and body of every message type
+func defaultTemplates() map[models.InboxMessageType][2]string {
+	return map[models.InboxMessageType][2]string{
+		models.InboxMessageTypeTournamentEnded: {
+			"Tournament ended",
+			"You finished #{{.Rank}} in your group with {{.Score}} points.",
+		},
+		models.InboxMessageTypeRewardAvailable: {
+			"Your reward is waiting",
+			"You finished #{{.Rank}} in your group. Claim your {{.Reward}} {{.Currency}} now!",
+		},
+		models.InboxMessageTypeOvertaken: {
+			"You have been overtaken",
+			"{{.OvertakenBy}} passed you, you are now #{{.Rank}} in your group.",
+		},
+		models.InboxMessageTypeReservationRolledBack: {
+			"Tournament entry failed",
+			"We could not enter you into the tournament, {{.Amount}} {{.Currency}} were returned to your wallet.",
+		},
+	}
+}
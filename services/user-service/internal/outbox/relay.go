@@ -0,0 +1,166 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+)
+
+const (
+	defaultSweepInterval = 5 * time.Second
+	defaultBatchSize     = 100
+
+	// Events younger than this are still being published by the request that
+	// wrote them, sweeping them as well would only publish them twice
+	sweepGracePeriod = 5 * time.Second
+)
+
+// Publisher sends an outbox event to its subject
+type Publisher interface {
+	Publish(ctx context.Context, event *models.OutboxEvent) *apperrors.AppError
+}
+
+// Relay publishes the events that were written to the outbox together with the
+// change they announce. The request that committed the change publishes its
+// events right away, events that fail are left in the outbox and published by
+// the next sweep. An event is deleted once it is published, an event published
+// twice is dropped by the stream through its event id.
+type Relay struct {
+	outboxRepo    repository.OutboxRepository
+	publisher     Publisher
+	sweepInterval time.Duration
+	batchSize     int
+	stopChan      chan struct{}
+	done          chan struct{}
+	logger        *logger.Logger
+
+	lifecycleMu sync.Mutex
+	started     bool
+	stopped     bool
+}
+
+func NewRelay(outboxRepo repository.OutboxRepository, publisher Publisher, logger *logger.Logger) *Relay {
+	return &Relay{
+		outboxRepo:    outboxRepo,
+		publisher:     publisher,
+		sweepInterval: defaultSweepInterval,
+		batchSize:     defaultBatchSize,
+		stopChan:      make(chan struct{}),
+		done:          make(chan struct{}),
+		logger:        logger.With("component", "outbox-relay"),
+	}
+}
+
+// Publish publishes events whose transaction just committed. Failures are only
+// logged, the change is committed and the sweep publishes the event later.
+func (r *Relay) Publish(ctx context.Context, events ...*models.OutboxEvent) {
+	ctx = context.WithoutCancel(ctx)
+	for _, event := range events {
+		r.publish(ctx, event)
+	}
+}
+
+// Sweep publishes the pending events written before the grace period, oldest
+// first, and reports how many were published
+func (r *Relay) Sweep(ctx context.Context) (int, *apperrors.AppError) {
+	published := 0
+
+	for {
+		events, err := r.outboxRepo.ListPending(ctx, time.Now().UTC().Add(-sweepGracePeriod), r.batchSize)
+		if err != nil {
+			return published, err
+		}
+
+		for _, event := range events {
+			// Later events wait behind a failed one, so a subject keeps its order
+			if !r.publish(ctx, event) {
+				return published, nil
+			}
+			published++
+		}
+
+		if len(events) < r.batchSize {
+			return published, nil
+		}
+	}
+}
+
+// Start sweeps the outbox every interval until Stop is called. A relay that was
+// stopped before does not start.
+func (r *Relay) Start() {
+	r.lifecycleMu.Lock()
+	if r.stopped {
+		r.lifecycleMu.Unlock()
+		return
+	}
+	r.started = true
+	r.lifecycleMu.Unlock()
+
+	r.logger.Info("Outbox relay started", "sweep_interval", r.sweepInterval)
+
+	ticker := time.NewTicker(r.sweepInterval)
+	defer ticker.Stop()
+	defer close(r.done)
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := r.Sweep(context.Background()); err != nil {
+				r.logger.Error("Failed to sweep outbox", "error", err)
+			}
+
+		case <-r.stopChan:
+			r.logger.Info("Outbox relay stopped")
+			return
+		}
+	}
+}
+
+// Stop waits for a running sweep to finish, events left in the outbox are
+// published after the next start
+func (r *Relay) Stop() error {
+	r.lifecycleMu.Lock()
+	if r.stopped {
+		r.lifecycleMu.Unlock()
+		return nil
+	}
+	r.stopped = true
+	started := r.started
+	r.lifecycleMu.Unlock()
+
+	if !started {
+		return nil
+	}
+
+	close(r.stopChan)
+	<-r.done
+	return nil
+}
+
+// publish sends a single event and deletes it, false is returned when the
+// event is still in the outbox
+func (r *Relay) publish(ctx context.Context, event *models.OutboxEvent) bool {
+	if err := r.publisher.Publish(ctx, event); err != nil {
+		r.logger.Warn("Failed to publish outbox event, it is retried by the next sweep",
+			"error", err,
+			"event_id", event.EventId,
+			"subject", event.Subject,
+		)
+		return false
+	}
+
+	if err := r.outboxRepo.Delete(ctx, event); err != nil {
+		r.logger.Error("Failed to delete published outbox event, it is published again",
+			"error", err,
+			"event_id", event.EventId,
+			"subject", event.Subject,
+		)
+	}
+
+	return true
+}
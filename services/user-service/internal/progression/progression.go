@@ -0,0 +1,186 @@
+package progression
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// Curve converts between total XP and levels. Level 1 always starts at 0 XP.
+type Curve interface {
+	// XPForLevel returns the total XP needed to reach the level
+	XPForLevel(level int) int
+}
+
+// TableCurve lists the total XP needed for each level starting with level 2,
+// levels beyond the table keep the step between its last two entries
+type TableCurve []int
+
+func (t TableCurve) XPForLevel(level int) int {
+	if level <= 1 || len(t) == 0 {
+		return 0
+	}
+
+	if index := level - 2; index < len(t) {
+		return t[index]
+	}
+
+	step := t[len(t)-1]
+	if len(t) > 1 {
+		step -= t[len(t)-2]
+	}
+	return t[len(t)-1] + (level-1-len(t))*step
+}
+
+// FormulaCurve needs Base * (level-1)^Exponent total XP for a level
+type FormulaCurve struct {
+	Base     int
+	Exponent float64
+}
+
+func (f FormulaCurve) XPForLevel(level int) int {
+	if level <= 1 {
+		return 0
+	}
+	return int(math.Round(float64(f.Base) * math.Pow(float64(level-1), f.Exponent)))
+}
+
+// Config describes how XP turns into levels and what each level pays
+type Config struct {
+	Curve            Curve
+	LevelCap         int
+	PrestigeEnabled  bool
+	RewardPerLevel   int
+	MilestoneRewards map[int]int
+}
+
+// LevelUp is a single crossed level boundary. Reset marks reaching the cap with
+// prestige enabled, which returns the user to level 1 and pays no reward.
+type LevelUp struct {
+	Level    int
+	Prestige int
+	Reward   int
+	Reset    bool
+}
+
+// Result is the progress after applying XP along with every boundary crossed on the way
+type Result struct {
+	Progress models.Progress
+	LevelUps []LevelUp
+}
+
+func (r Result) TotalReward() int {
+	total := 0
+	for _, levelUp := range r.LevelUps {
+		total += levelUp.Reward
+	}
+	return total
+}
+
+// Apply adds xp to the progress. Reaching the level cap either resets the user to
+// level 1 with one more prestige, carrying over the surplus XP, or clamps XP at
+// the cap when prestige is disabled.
+func (c *Config) Apply(state models.Progress, xp int) Result {
+	// Users created before XP existed only have a level
+	if minXP := c.Curve.XPForLevel(state.Level); state.XP < minXP {
+		state.XP = minXP
+	}
+
+	state.XP += xp
+	levelUps := make([]LevelUp, 0)
+
+	for {
+		if c.LevelCap > 0 && state.Level >= c.LevelCap {
+			capXP := c.Curve.XPForLevel(c.LevelCap)
+			if !c.PrestigeEnabled {
+				state.XP = capXP
+				break
+			}
+
+			state.Prestige++
+			state.Level = 1
+			state.XP -= capXP
+			levelUps = append(levelUps, LevelUp{Level: state.Level, Prestige: state.Prestige, Reset: true})
+			continue
+		}
+
+		if state.XP < c.Curve.XPForLevel(state.Level+1) {
+			break
+		}
+
+		state.Level++
+		levelUps = append(levelUps, LevelUp{
+			Level:    state.Level,
+			Prestige: state.Prestige,
+			Reward:   c.RewardPerLevel + c.MilestoneRewards[state.Level],
+		})
+	}
+
+	return Result{Progress: state, LevelUps: levelUps}
+}
+
+// FromConfig builds the progression config, unset values fall back to defaults
+func FromConfig(cfg config.ProgressionConfig) (*Config, *apperrors.AppError) {
+	progression := DefaultConfig()
+
+	switch {
+	case len(cfg.XPTable) > 0:
+		if !isStrictlyIncreasing(cfg.XPTable) {
+			return nil, apperrors.New(apperrors.CodeInvalidInput, "progression xp table must be positive and strictly ascending")
+		}
+		progression.Curve = TableCurve(cfg.XPTable)
+	case cfg.XPBase > 0:
+		exponent := cfg.XPExponent
+		if exponent <= 0 {
+			exponent = 1
+		}
+		progression.Curve = FormulaCurve{Base: cfg.XPBase, Exponent: exponent}
+	}
+
+	if cfg.LevelCap > 1 {
+		progression.LevelCap = cfg.LevelCap
+		progression.PrestigeEnabled = cfg.PrestigeEnabled
+	}
+
+	if cfg.RewardPerLevel > 0 {
+		progression.RewardPerLevel = cfg.RewardPerLevel
+	}
+
+	for level, reward := range cfg.MilestoneRewards {
+		parsedLevel, err := strconv.Atoi(level)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeInvalidInput, "progression milestone levels must be numbers")
+		}
+		progression.MilestoneRewards[parsedLevel] = reward
+	}
+
+	return progression, nil
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Curve:          FormulaCurve{Base: 100, Exponent: 1.5},
+		RewardPerLevel: 100,
+		MilestoneRewards: map[int]int{
+			10: 1000,
+			25: 2500,
+			50: 5000,
+		},
+	}
+}
+
+// isStrictlyIncreasing rejects tables where a level needs no XP, levelling would
+// never stop on them
+func isStrictlyIncreasing(table []int) bool {
+	previous := 0
+	for _, xp := range table {
+		if xp <= previous {
+			return false
+		}
+		previous = xp
+	}
+	return true
+}
@@ -0,0 +1,222 @@
+package progression
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+func TestApply(t *testing.T) {
+	// Levels 2, 3 and 4 need 100, 300 and 600 XP, later levels 300 more each
+	table := TableCurve{100, 300, 600}
+
+	tests := []struct {
+		name         string
+		config       Config
+		state        models.Progress
+		xp           int
+		want         models.Progress
+		wantLevelUps []LevelUp
+	}{
+		{
+			name:         "below the next level",
+			config:       Config{Curve: table, RewardPerLevel: 10},
+			state:        models.Progress{Level: 1},
+			xp:           50,
+			want:         models.Progress{Level: 1, XP: 50},
+			wantLevelUps: []LevelUp{},
+		},
+		{
+			name:   "several levels with a milestone",
+			config: Config{Curve: table, RewardPerLevel: 10, MilestoneRewards: map[int]int{3: 500}},
+			state:  models.Progress{Level: 1},
+			xp:     300,
+			want:   models.Progress{Level: 3, XP: 300},
+			wantLevelUps: []LevelUp{
+				{Level: 2, Reward: 10},
+				{Level: 3, Reward: 510},
+			},
+		},
+		{
+			name:   "beyond the table keeps the last step",
+			config: Config{Curve: table, RewardPerLevel: 10},
+			state:  models.Progress{Level: 4, XP: 600},
+			xp:     600,
+			want:   models.Progress{Level: 6, XP: 1200},
+			wantLevelUps: []LevelUp{
+				{Level: 5, Reward: 10},
+				{Level: 6, Reward: 10},
+			},
+		},
+		{
+			name:         "user without xp starts at the level minimum",
+			config:       Config{Curve: table, RewardPerLevel: 10},
+			state:        models.Progress{Level: 3},
+			xp:           100,
+			want:         models.Progress{Level: 3, XP: 400},
+			wantLevelUps: []LevelUp{},
+		},
+		{
+			name:   "cap clamps xp without prestige",
+			config: Config{Curve: table, LevelCap: 3, RewardPerLevel: 10},
+			state:  models.Progress{Level: 2, XP: 100},
+			xp:     1000,
+			want:   models.Progress{Level: 3, XP: 300},
+			wantLevelUps: []LevelUp{
+				{Level: 3, Reward: 10},
+			},
+		},
+		{
+			name:         "at the cap without prestige",
+			config:       Config{Curve: table, LevelCap: 3, RewardPerLevel: 10},
+			state:        models.Progress{Level: 3, XP: 300},
+			xp:           50,
+			want:         models.Progress{Level: 3, XP: 300},
+			wantLevelUps: []LevelUp{},
+		},
+		{
+			name:   "prestige carries the surplus over",
+			config: Config{Curve: table, LevelCap: 3, PrestigeEnabled: true, RewardPerLevel: 10},
+			state:  models.Progress{Level: 2, XP: 100},
+			xp:     350,
+			want:   models.Progress{Level: 2, XP: 150, Prestige: 1},
+			wantLevelUps: []LevelUp{
+				{Level: 3, Reward: 10},
+				{Level: 1, Prestige: 1, Reset: true},
+				{Level: 2, Prestige: 1, Reward: 10},
+			},
+		},
+		{
+			name:   "several prestiges at once",
+			config: Config{Curve: table, LevelCap: 2, PrestigeEnabled: true},
+			state:  models.Progress{Level: 1, Prestige: 4},
+			xp:     250,
+			want:   models.Progress{Level: 1, XP: 50, Prestige: 6},
+			wantLevelUps: []LevelUp{
+				{Level: 2, Prestige: 4},
+				{Level: 1, Prestige: 5, Reset: true},
+				{Level: 2, Prestige: 5},
+				{Level: 1, Prestige: 6, Reset: true},
+			},
+		},
+		{
+			name:   "formula curve",
+			config: Config{Curve: FormulaCurve{Base: 100, Exponent: 2}},
+			state:  models.Progress{Level: 1},
+			xp:     400,
+			want:   models.Progress{Level: 3, XP: 400},
+			wantLevelUps: []LevelUp{
+				{Level: 2},
+				{Level: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Apply(tt.state, tt.xp)
+
+			if result.Progress != tt.want {
+				t.Errorf("progress = %+v, want %+v", result.Progress, tt.want)
+			}
+			if !reflect.DeepEqual(result.LevelUps, tt.wantLevelUps) {
+				t.Errorf("level ups = %+v, want %+v", result.LevelUps, tt.wantLevelUps)
+			}
+		})
+	}
+}
+
+func TestFromConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       config.ProgressionConfig
+		wantCurve    Curve
+		wantLevelCap int
+		wantPrestige bool
+		wantErr      bool
+	}{
+		{
+			name:      "defaults",
+			wantCurve: FormulaCurve{Base: 100, Exponent: 1.5},
+		},
+		{
+			name:      "table",
+			config:    config.ProgressionConfig{XPTable: []int{100, 250, 500}},
+			wantCurve: TableCurve{100, 250, 500},
+		},
+		{
+			name:      "single entry table",
+			config:    config.ProgressionConfig{XPTable: []int{100}},
+			wantCurve: TableCurve{100},
+		},
+		{
+			name:    "descending table",
+			config:  config.ProgressionConfig{XPTable: []int{100, 50}},
+			wantErr: true,
+		},
+		{
+			name:    "repeated entry",
+			config:  config.ProgressionConfig{XPTable: []int{100, 200, 200}},
+			wantErr: true,
+		},
+		{
+			name:    "first level needs no xp",
+			config:  config.ProgressionConfig{XPTable: []int{0, 100}},
+			wantErr: true,
+		},
+		{
+			name:    "negative first entry",
+			config:  config.ProgressionConfig{XPTable: []int{-100, 100}},
+			wantErr: true,
+		},
+		{
+			name:      "formula without exponent",
+			config:    config.ProgressionConfig{XPBase: 50},
+			wantCurve: FormulaCurve{Base: 50, Exponent: 1},
+		},
+		{
+			name:         "cap with prestige",
+			config:       config.ProgressionConfig{LevelCap: 50, PrestigeEnabled: true},
+			wantCurve:    FormulaCurve{Base: 100, Exponent: 1.5},
+			wantLevelCap: 50,
+			wantPrestige: true,
+		},
+		{
+			name:      "cap of one is ignored",
+			config:    config.ProgressionConfig{LevelCap: 1, PrestigeEnabled: true},
+			wantCurve: FormulaCurve{Base: 100, Exponent: 1.5},
+		},
+		{
+			name:    "milestone level is not a number",
+			config:  config.ProgressionConfig{MilestoneRewards: map[string]int{"ten": 100}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progression, err := FromConfig(tt.config)
+
+			if tt.wantErr {
+				if err == nil || err.Code != apperrors.CodeInvalidInput {
+					t.Fatalf("FromConfig error = %v, want code %s", err, apperrors.CodeInvalidInput)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("FromConfig: %v", err)
+			}
+			if !reflect.DeepEqual(progression.Curve, tt.wantCurve) {
+				t.Errorf("curve = %#v, want %#v", progression.Curve, tt.wantCurve)
+			}
+			if progression.LevelCap != tt.wantLevelCap || progression.PrestigeEnabled != tt.wantPrestige {
+				t.Errorf("cap = %d prestige = %t, want %d and %t",
+					progression.LevelCap, progression.PrestigeEnabled, tt.wantLevelCap, tt.wantPrestige)
+			}
+		})
+	}
+}
@@ -0,0 +1,467 @@
+package push
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+)
+
+const (
+	defaultBatchWindow     = 10 * time.Second
+	defaultMaxBatchSize    = 100
+	defaultMaxPerHour      = 10
+	defaultMaxAttempts     = 3
+	defaultDeliveryTTLDays = 7
+	defaultWorkers         = 8
+	retryBackoff           = time.Second
+	rateWindowLayout       = "2006-01-02T15"
+
+	// Pushes waiting for a retry or for the end of quiet hours are held in
+	// memory, beyond this many they are only kept in the inbox
+	maxWaitingPushes = 10000
+)
+
+// Dispatcher pushes new inbox messages. Messages are queued in memory and
+// flushed every batch window, each user receives their messages of a window as
+// one push. Users of a flush are pushed to by a bounded number of workers.
+// Failed pushes and pushes during quiet hours wait in memory and are picked up
+// by a later flush once they are due. The inbox keeps every message, so a push
+// that is skipped, rate limited or lost on shutdown is still readable in the app.
+type Dispatcher struct {
+	enabled      bool
+	messageTypes map[models.InboxMessageType]bool
+	batchWindow  time.Duration
+	maxBatchSize int
+	maxPerHour   int
+	maxAttempts  int
+	workers      int
+	deliveryTTL  time.Duration
+
+	provider PushProvider
+	pushRepo repository.PushRepository
+	queue    chan models.InboxMessage
+	stopChan chan struct{}
+	done     chan struct{}
+	logger   *logger.Logger
+
+	waitingMu sync.Mutex
+	waiting   []waitingPush
+
+	lifecycleMu sync.Mutex
+	started     bool
+	stopped     bool
+}
+
+// waitingPush is a push that is due later. A deferred push has only messages
+// and goes through the user's preferences again, a retry has the delivery of
+// its earlier attempts and is sent as it is.
+type waitingPush struct {
+	dueAt        time.Time
+	userId       string
+	messages     []models.InboxMessage
+	delivery     *models.PushDelivery
+	pushMessages []Message
+}
+
+func NewDispatcher(
+	cfg config.PushConfig,
+	pushRepo repository.PushRepository,
+	logger *logger.Logger,
+) (*Dispatcher, *apperrors.AppError) {
+	provider, err := NewProvider(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	dispatcher := &Dispatcher{
+		enabled:      cfg.Enabled,
+		batchWindow:  defaultBatchWindow,
+		maxBatchSize: defaultMaxBatchSize,
+		maxPerHour:   defaultMaxPerHour,
+		maxAttempts:  defaultMaxAttempts,
+		workers:      defaultWorkers,
+		deliveryTTL:  defaultDeliveryTTLDays * 24 * time.Hour,
+		provider:     provider,
+		pushRepo:     pushRepo,
+		stopChan:     make(chan struct{}),
+		done:         make(chan struct{}),
+		logger:       logger.With("component", "push-dispatcher"),
+	}
+
+	if len(cfg.MessageTypes) > 0 {
+		dispatcher.messageTypes = make(map[models.InboxMessageType]bool, len(cfg.MessageTypes))
+		for _, value := range cfg.MessageTypes {
+			messageType := models.InboxMessageType(value)
+			if !messageType.IsValid() {
+				return nil, apperrors.New(apperrors.CodeInvalidInput, "unknown push message type: "+value)
+			}
+			dispatcher.messageTypes[messageType] = true
+		}
+	}
+
+	if cfg.BatchWindowSeconds > 0 {
+		dispatcher.batchWindow = time.Duration(cfg.BatchWindowSeconds) * time.Second
+	}
+	if cfg.MaxBatchSize > 0 {
+		dispatcher.maxBatchSize = cfg.MaxBatchSize
+	}
+	if cfg.MaxPerHour > 0 {
+		dispatcher.maxPerHour = cfg.MaxPerHour
+	}
+	if cfg.MaxAttempts > 0 {
+		dispatcher.maxAttempts = cfg.MaxAttempts
+	}
+	if cfg.Workers > 0 {
+		dispatcher.workers = cfg.Workers
+	}
+	if cfg.DeliveryTTLDays > 0 {
+		dispatcher.deliveryTTL = time.Duration(cfg.DeliveryTTLDays) * 24 * time.Hour
+	}
+
+	dispatcher.queue = make(chan models.InboxMessage, dispatcher.maxBatchSize*10)
+
+	return dispatcher, nil
+}
+
+// Enqueue queues a new inbox message for the next flush. It never blocks, a
+// message is dropped when the queue is full.
+func (d *Dispatcher) Enqueue(message models.InboxMessage) {
+	if !d.enabled {
+		return
+	}
+	if d.messageTypes != nil && !d.messageTypes[message.Type] {
+		return
+	}
+
+	select {
+	case d.queue <- message:
+	default:
+		d.logger.Warn("Push queue is full, message is only kept in the inbox",
+			"user_id", message.UserId,
+			"message_id", message.MessageId,
+		)
+	}
+}
+
+// Start flushes the queue every batch window and whenever a full batch is
+// waiting, until Stop is called. A dispatcher that was stopped before does not start.
+func (d *Dispatcher) Start() {
+	if !d.enabled {
+		return
+	}
+
+	d.lifecycleMu.Lock()
+	if d.stopped {
+		d.lifecycleMu.Unlock()
+		return
+	}
+	d.started = true
+	d.lifecycleMu.Unlock()
+
+	d.logger.Info("Push dispatcher started",
+		"provider", d.provider.Name(),
+		"batch_window", d.batchWindow,
+		"workers", d.workers,
+	)
+
+	ticker := time.NewTicker(d.batchWindow)
+	defer ticker.Stop()
+	defer close(d.done)
+
+	pending := make([]models.InboxMessage, 0, d.maxBatchSize)
+	for {
+		select {
+		case message := <-d.queue:
+			pending = append(pending, message)
+			if len(pending) >= d.maxBatchSize {
+				d.flush(pending)
+				pending = pending[:0]
+			}
+
+		case <-ticker.C:
+			d.flush(pending)
+			pending = pending[:0]
+
+		case <-d.stopChan:
+		drain:
+			for {
+				select {
+				case message := <-d.queue:
+					pending = append(pending, message)
+				default:
+					break drain
+				}
+			}
+			d.flush(pending)
+			d.dropWaiting()
+			d.logger.Info("Push dispatcher stopped")
+			return
+		}
+	}
+}
+
+// Stop flushes what is queued and waits for the dispatcher to finish. A
+// dispatcher that never started returns at once.
+func (d *Dispatcher) Stop() error {
+	d.lifecycleMu.Lock()
+	if d.stopped {
+		d.lifecycleMu.Unlock()
+		return nil
+	}
+	d.stopped = true
+	started := d.started
+	d.lifecycleMu.Unlock()
+
+	if !started {
+		return nil
+	}
+
+	close(d.stopChan)
+	<-d.done
+	return nil
+}
+
+// flush groups the batch by user, every user gets one push. Waiting pushes that
+// are due are handled in the same run.
+func (d *Dispatcher) flush(batch []models.InboxMessage) {
+	ctx := context.Background()
+
+	userIds := make([]string, 0)
+	messagesByUser := make(map[string][]models.InboxMessage)
+	for _, message := range batch {
+		if _, ok := messagesByUser[message.UserId]; !ok {
+			userIds = append(userIds, message.UserId)
+		}
+		messagesByUser[message.UserId] = append(messagesByUser[message.UserId], message)
+	}
+
+	jobs := make([]func(), 0, len(userIds))
+	for _, userId := range userIds {
+		messages := messagesByUser[userId]
+		jobs = append(jobs, func() { d.dispatch(ctx, userId, messages) })
+	}
+
+	for _, push := range d.takeDue(time.Now().UTC()) {
+		if push.delivery == nil {
+			jobs = append(jobs, func() { d.dispatch(ctx, push.userId, push.messages) })
+		} else {
+			jobs = append(jobs, func() { d.attempt(ctx, push.delivery, push.pushMessages) })
+		}
+	}
+
+	d.run(jobs)
+}
+
+// run runs the jobs on at most the configured number of workers and waits for them
+func (d *Dispatcher) run(jobs []func()) {
+	if len(jobs) == 0 {
+		return
+	}
+
+	slots := make(chan struct{}, d.workers)
+	var wg sync.WaitGroup
+
+	for _, job := range jobs {
+		slots <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			job()
+		}()
+	}
+
+	wg.Wait()
+}
+
+// dispatch applies the user's preferences, quiet hours and rate limit before
+// sending. Pushes during quiet hours wait for their end, every other decision
+// after the preferences is recorded as a delivery.
+func (d *Dispatcher) dispatch(ctx context.Context, userId string, messages []models.InboxMessage) {
+	preferences, err := d.pushRepo.GetPreferences(ctx, userId)
+	if err != nil {
+		d.logger.Error("Failed to get push preferences", "error", err, "user_id", userId)
+		return
+	}
+	if preferences == nil {
+		preferences = models.DefaultPushPreferences(userId)
+	}
+
+	if !preferences.Enabled {
+		return
+	}
+
+	unmuted := make([]models.InboxMessage, 0, len(messages))
+	pushMessages := make([]Message, 0, len(messages))
+	messageIds := make([]string, 0, len(messages))
+	for _, message := range messages {
+		if preferences.IsMuted(message.Type) {
+			continue
+		}
+
+		unmuted = append(unmuted, message)
+
+		pushMessages = append(pushMessages, Message{
+			MessageId:   message.MessageId,
+			Type:        string(message.Type),
+			Title:       message.Title,
+			Body:        message.Body,
+			ReferenceId: message.ReferenceId,
+		})
+		messageIds = append(messageIds, message.MessageId)
+	}
+
+	if len(pushMessages) == 0 {
+		return
+	}
+
+	now := time.Now().UTC()
+	if InQuietHours(preferences, now) {
+		d.wait(waitingPush{
+			dueAt:    QuietHoursEnd(preferences, now),
+			userId:   userId,
+			messages: unmuted,
+		})
+		return
+	}
+
+	delivery := d.newDelivery(userId, messageIds, now)
+
+	allowed, err := d.pushRepo.ConsumeRateLimit(ctx, userId, now.Format(rateWindowLayout), d.maxPerHour, now.Truncate(time.Hour).Add(2*time.Hour))
+	if err != nil {
+		d.logger.Error("Failed to check push rate limit", "error", err, "user_id", userId)
+		return
+	}
+
+	if !allowed {
+		delivery.Status = models.PushDeliveryStatusRateLimited
+		d.recordDelivery(ctx, delivery)
+		return
+	}
+
+	d.attempt(ctx, delivery, pushMessages)
+}
+
+// attempt tries the provider once and records the outcome. A failed attempt
+// below the attempt limit waits for a later flush with a growing pause.
+func (d *Dispatcher) attempt(ctx context.Context, delivery *models.PushDelivery, messages []Message) {
+	delivery.Attempts++
+
+	err := d.provider.Send(ctx, delivery.UserId, messages)
+	if err == nil {
+		delivery.Status = models.PushDeliveryStatusSent
+		delivery.Error = ""
+	} else {
+		delivery.Status = models.PushDeliveryStatusFailed
+		delivery.Error = err.Error()
+		d.logger.Warn("Push attempt failed",
+			"error", err,
+			"user_id", delivery.UserId,
+			"attempt", delivery.Attempts,
+		)
+	}
+
+	d.recordDelivery(ctx, delivery)
+
+	if err != nil && delivery.Attempts < d.maxAttempts {
+		d.wait(waitingPush{
+			dueAt:        time.Now().UTC().Add(time.Duration(delivery.Attempts) * retryBackoff),
+			userId:       delivery.UserId,
+			delivery:     delivery,
+			pushMessages: messages,
+		})
+	}
+}
+
+// wait holds a push until it is due, a push beyond the limit is dropped
+func (d *Dispatcher) wait(push waitingPush) {
+	d.waitingMu.Lock()
+	defer d.waitingMu.Unlock()
+
+	if len(d.waiting) >= maxWaitingPushes {
+		d.logger.Warn("Too many waiting pushes, messages are only kept in the inbox",
+			"user_id", push.userId,
+		)
+		return
+	}
+
+	d.waiting = append(d.waiting, push)
+}
+
+// takeDue removes and returns the waiting pushes that are due at the given time
+func (d *Dispatcher) takeDue(now time.Time) []waitingPush {
+	d.waitingMu.Lock()
+	defer d.waitingMu.Unlock()
+
+	due := make([]waitingPush, 0)
+	remaining := d.waiting[:0]
+	for _, push := range d.waiting {
+		if push.dueAt.After(now) {
+			remaining = append(remaining, push)
+		} else {
+			due = append(due, push)
+		}
+	}
+	d.waiting = remaining
+
+	return due
+}
+
+// dropWaiting records the pushes still waiting for the end of quiet hours on
+// shutdown, failed retries are recorded already
+func (d *Dispatcher) dropWaiting() {
+	d.waitingMu.Lock()
+	waiting := d.waiting
+	d.waiting = nil
+	d.waitingMu.Unlock()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	for _, push := range waiting {
+		if push.delivery != nil {
+			continue
+		}
+
+		messageIds := make([]string, 0, len(push.messages))
+		for _, message := range push.messages {
+			messageIds = append(messageIds, message.MessageId)
+		}
+
+		delivery := d.newDelivery(push.userId, messageIds, now)
+		delivery.Status = models.PushDeliveryStatusQuietHours
+		d.recordDelivery(ctx, delivery)
+	}
+}
+
+func (d *Dispatcher) newDelivery(userId string, messageIds []string, now time.Time) *models.PushDelivery {
+	return &models.PushDelivery{
+		UserId:     userId,
+		DeliveryId: uuid.New().String(),
+		Provider:   d.provider.Name(),
+		MessageIds: messageIds,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(d.deliveryTTL).Unix(),
+	}
+}
+
+func (d *Dispatcher) recordDelivery(ctx context.Context, delivery *models.PushDelivery) {
+	if err := d.pushRepo.RecordDelivery(ctx, delivery); err != nil {
+		d.logger.Error("Failed to record push delivery",
+			"error", err,
+			"user_id", delivery.UserId,
+			"status", delivery.Status,
+		)
+	}
+}
@@ -0,0 +1,73 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+)
+
+const defaultHTTPTimeout = 5 * time.Second
+
+// HTTPProvider posts every batch as JSON to a push gateway
+type HTTPProvider struct {
+	url       string
+	authToken string
+	client    *http.Client
+}
+
+type httpPushRequest struct {
+	UserId   string    `json:"userId"`
+	Messages []Message `json:"messages"`
+}
+
+func NewHTTPProvider(url, authToken string, timeout time.Duration) *HTTPProvider {
+	if timeout <= 0 {
+		timeout = defaultHTTPTimeout
+	}
+
+	return &HTTPProvider{
+		url:       url,
+		authToken: authToken,
+		client:    &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *HTTPProvider) Name() string {
+	return ProviderHTTP
+}
+
+// Send fails for transport errors and every status outside of 2xx
+func (p *HTTPProvider) Send(ctx context.Context, userId string, messages []Message) *apperrors.AppError {
+	payload, err := json.Marshal(httpPushRequest{UserId: userId, Messages: messages})
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal push request")
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(payload))
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to build push request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if p.authToken != "" {
+		request.Header.Set("Authorization", "Bearer "+p.authToken)
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to send push request")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return apperrors.New(apperrors.CodeInternalServer, fmt.Sprintf("push gateway responded %d: %s", response.StatusCode, body))
+	}
+
+	return nil
+}
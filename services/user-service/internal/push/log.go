@@ -0,0 +1,72 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+)
+
+// LogProvider is the development provider, it logs every push and appends it
+// to a JSON lines file when a file path is set
+type LogProvider struct {
+	filePath string
+	mu       sync.Mutex
+	logger   *logger.Logger
+}
+
+type logPushRecord struct {
+	UserId   string    `json:"userId"`
+	Messages []Message `json:"messages"`
+	SentAt   time.Time `json:"sentAt"`
+}
+
+func NewLogProvider(filePath string, logger *logger.Logger) *LogProvider {
+	return &LogProvider{
+		filePath: filePath,
+		logger:   logger.With("component", "push-log-provider"),
+	}
+}
+
+func (p *LogProvider) Name() string {
+	return ProviderLog
+}
+
+func (p *LogProvider) Send(ctx context.Context, userId string, messages []Message) *apperrors.AppError {
+	for _, message := range messages {
+		p.logger.Info("Push notification",
+			"user_id", userId,
+			"type", message.Type,
+			"title", message.Title,
+			"body", message.Body,
+		)
+	}
+
+	if p.filePath == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(logPushRecord{UserId: userId, Messages: messages, SentAt: time.Now().UTC()})
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal push record")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	file, err := os.OpenFile(p.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to open push log file")
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to write push log file")
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package push
+
+import (
+	"fmt"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+const clockLayout = "15:04"
+
+// ValidatePreferences checks the muted types, quiet hours and timezone. Quiet
+// hours need both a start and an end.
+func ValidatePreferences(preferences *models.PushPreferences) *apperrors.AppError {
+	for _, messageType := range preferences.MutedTypes {
+		if !messageType.IsValid() {
+			return apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("unknown message type: %s", messageType))
+		}
+	}
+
+	if (preferences.QuietHoursStart == "") != (preferences.QuietHoursEnd == "") {
+		return apperrors.New(apperrors.CodeInvalidInput, "quiet hours need both a start and an end")
+	}
+
+	for _, clock := range []string{preferences.QuietHoursStart, preferences.QuietHoursEnd} {
+		if clock == "" {
+			continue
+		}
+		if _, err := time.Parse(clockLayout, clock); err != nil {
+			return apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("quiet hours must be HH:MM, got %q", clock))
+		}
+	}
+
+	if preferences.Timezone != "" {
+		if _, err := time.LoadLocation(preferences.Timezone); err != nil {
+			return apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("unknown timezone: %s", preferences.Timezone))
+		}
+	}
+
+	return nil
+}
+
+// InQuietHours reports whether the given time falls into the user's quiet
+// hours, an end before the start spans midnight
+func InQuietHours(preferences *models.PushPreferences, now time.Time) bool {
+	start, err := time.Parse(clockLayout, preferences.QuietHoursStart)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(clockLayout, preferences.QuietHoursEnd)
+	if err != nil {
+		return false
+	}
+
+	local := now.In(preferencesLocation(preferences))
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+
+	switch {
+	case startMinute == endMinute:
+		return false
+	case startMinute < endMinute:
+		return minute >= startMinute && minute < endMinute
+	default:
+		return minute >= startMinute || minute < endMinute
+	}
+}
+
+// QuietHoursEnd returns the first end of the user's quiet hours after the given time
+func QuietHoursEnd(preferences *models.PushPreferences, now time.Time) time.Time {
+	end, err := time.Parse(clockLayout, preferences.QuietHoursEnd)
+	if err != nil {
+		return now
+	}
+
+	local := now.In(preferencesLocation(preferences))
+	endAt := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, local.Location())
+	if !endAt.After(local) {
+		endAt = endAt.AddDate(0, 0, 1)
+	}
+
+	return endAt.UTC()
+}
+
+// preferencesLocation is the user's timezone, UTC when it is missing or unknown
+func preferencesLocation(preferences *models.PushPreferences) *time.Location {
+	if preferences.Timezone != "" {
+		if location, err := time.LoadLocation(preferences.Timezone); err == nil {
+			return location
+		}
+	}
+	return time.UTC
+}
@@ -0,0 +1,115 @@
+package push
+
+import (
+	"testing"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+func at(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestInQuietHours(t *testing.T) {
+	overnight := &models.PushPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "07:00", Timezone: "Europe/Istanbul"}
+	daytime := &models.PushPreferences{QuietHoursStart: "09:00", QuietHoursEnd: "17:00"}
+
+	tests := []struct {
+		name        string
+		preferences *models.PushPreferences
+		now         string
+		want        bool
+	}{
+		{name: "before an overnight start", preferences: overnight, now: "2026-10-18T18:59:00Z", want: false},
+		{name: "at an overnight start", preferences: overnight, now: "2026-10-18T19:00:00Z", want: true},
+		{name: "before midnight", preferences: overnight, now: "2026-10-18T20:30:00Z", want: true},
+		{name: "after midnight", preferences: overnight, now: "2026-10-18T22:30:00Z", want: true},
+		{name: "before an overnight end", preferences: overnight, now: "2026-10-19T03:59:00Z", want: true},
+		{name: "at an overnight end", preferences: overnight, now: "2026-10-19T04:00:00Z", want: false},
+		{name: "midday outside overnight hours", preferences: overnight, now: "2026-10-19T09:00:00Z", want: false},
+		{name: "inside daytime hours", preferences: daytime, now: "2026-10-18T12:00:00Z", want: true},
+		{name: "before daytime hours", preferences: daytime, now: "2026-10-18T08:59:00Z", want: false},
+		{name: "at the daytime end", preferences: daytime, now: "2026-10-18T17:00:00Z", want: false},
+		{
+			name:        "same start and end",
+			preferences: &models.PushPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "22:00"},
+			now:         "2026-10-18T22:00:00Z",
+			want:        false,
+		},
+		{name: "no quiet hours", preferences: &models.PushPreferences{}, now: "2026-10-18T23:00:00Z", want: false},
+		{
+			name:        "unknown timezone is utc",
+			preferences: &models.PushPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "07:00", Timezone: "Mars/Olympus"},
+			now:         "2026-10-18T22:30:00Z",
+			want:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InQuietHours(tt.preferences, at(tt.now)); got != tt.want {
+				t.Errorf("InQuietHours(%s) = %t, want %t", tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuietHoursEnd(t *testing.T) {
+	overnight := &models.PushPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "07:00", Timezone: "Europe/Istanbul"}
+
+	tests := []struct {
+		name        string
+		preferences *models.PushPreferences
+		now         string
+		want        string
+	}{
+		{name: "before midnight ends the next day", preferences: overnight, now: "2026-10-18T20:00:00Z", want: "2026-10-19T04:00:00Z"},
+		{name: "after midnight ends the same day", preferences: overnight, now: "2026-10-18T22:00:00Z", want: "2026-10-19T04:00:00Z"},
+		{name: "at the end waits a day", preferences: overnight, now: "2026-10-19T04:00:00Z", want: "2026-10-20T04:00:00Z"},
+		{
+			name:        "across a daylight saving change",
+			preferences: &models.PushPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "07:00", Timezone: "America/New_York"},
+			now:         "2026-11-01T03:00:00Z",
+			want:        "2026-11-01T12:00:00Z",
+		},
+		{name: "no end", preferences: &models.PushPreferences{}, now: "2026-10-18T20:00:00Z", want: "2026-10-18T20:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuietHoursEnd(tt.preferences, at(tt.now)); !got.Equal(at(tt.want)) {
+				t.Errorf("QuietHoursEnd(%s) = %s, want %s", tt.now, got.Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePreferences(t *testing.T) {
+	tests := []struct {
+		name        string
+		preferences models.PushPreferences
+		wantErr     bool
+	}{
+		{name: "empty"},
+		{name: "overnight quiet hours", preferences: models.PushPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "07:00", Timezone: "Europe/Istanbul"}},
+		{name: "muted type", preferences: models.PushPreferences{MutedTypes: []models.InboxMessageType{models.InboxMessageTypeOvertaken}}},
+		{name: "unknown muted type", preferences: models.PushPreferences{MutedTypes: []models.InboxMessageType{"SPAM"}}, wantErr: true},
+		{name: "start without end", preferences: models.PushPreferences{QuietHoursStart: "22:00"}, wantErr: true},
+		{name: "clock out of range", preferences: models.PushPreferences{QuietHoursStart: "24:00", QuietHoursEnd: "07:00"}, wantErr: true},
+		{name: "unknown timezone", preferences: models.PushPreferences{Timezone: "Mars/Olympus"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePreferences(&tt.preferences)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePreferences error = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package push
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+)
+
+const (
+	ProviderLog  = "log"
+	ProviderHTTP = "http"
+)
+
+// Message is an inbox message as it is pushed to the device
+type Message struct {
+	MessageId   string `json:"messageId"`
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Body        string `json:"body"`
+	ReferenceId string `json:"referenceId,omitempty"`
+}
+
+// PushProvider delivers a batch of messages to the devices of a user
+type PushProvider interface {
+	Name() string
+	Send(ctx context.Context, userId string, messages []Message) *apperrors.AppError
+}
+
+// NewProvider builds the configured provider, the log provider is the default
+func NewProvider(cfg config.PushConfig, logger *logger.Logger) (PushProvider, *apperrors.AppError) {
+	switch cfg.Provider {
+	case "", ProviderLog:
+		return NewLogProvider(cfg.Log.FilePath, logger), nil
+	case ProviderHTTP:
+		if cfg.HTTP.URL == "" {
+			return nil, apperrors.New(apperrors.CodeInvalidInput, "push http provider needs a url")
+		}
+		return NewHTTPProvider(cfg.HTTP.URL, cfg.HTTP.AuthToken, time.Duration(cfg.HTTP.TimeoutSeconds)*time.Second), nil
+	default:
+		return nil, apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("unknown push provider: %s", cfg.Provider))
+	}
+}
@@ -0,0 +1,107 @@
+package referral
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+const (
+	codeLength = 8
+	// Letters and digits that are easy to tell apart when typed
+	codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// Config is the referral reward policy
+type Config struct {
+	Milestone            models.ReferralMilestone
+	MilestoneLevel       int
+	ReferrerReward       int
+	RefereeReward        int
+	MaxRewardedReferrals int
+	MaxSignupsPerDay     int
+}
+
+// Reached reports whether the milestone a referee reached completes the
+// referral. Any prestige is beyond every level.
+func (c *Config) Reached(milestone models.ReferralMilestone, level, prestige int) bool {
+	if milestone != c.Milestone {
+		return false
+	}
+
+	if milestone == models.ReferralMilestoneLevel {
+		return prestige > 0 || level >= c.MilestoneLevel
+	}
+
+	return true
+}
+
+// GenerateCode returns a random code, uniqueness is enforced when it is stored
+func GenerateCode() (string, *apperrors.AppError) {
+	random := make([]byte, codeLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", apperrors.Wrap(err, apperrors.CodeInternalServer, "failed to generate referral code")
+	}
+
+	code := make([]byte, codeLength)
+	for i, value := range random {
+		code[i] = codeAlphabet[int(value)%len(codeAlphabet)]
+	}
+
+	return string(code), nil
+}
+
+// NormalizeCode makes codes case-insensitive
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// FromConfig builds the policy, unset values fall back to defaults
+func FromConfig(cfg config.ReferralsConfig) (*Config, *apperrors.AppError) {
+	referral := DefaultConfig()
+
+	if cfg.Milestone != "" {
+		milestone := models.ReferralMilestone(cfg.Milestone)
+		if !milestone.IsValid() {
+			return nil, apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("unknown referral milestone: %s", cfg.Milestone))
+		}
+		referral.Milestone = milestone
+	}
+
+	if cfg.ReferrerReward < 0 || cfg.RefereeReward < 0 {
+		return nil, apperrors.New(apperrors.CodeInvalidInput, "referral rewards can not be negative")
+	}
+
+	if cfg.MilestoneLevel > 0 {
+		referral.MilestoneLevel = cfg.MilestoneLevel
+	}
+	if cfg.ReferrerReward > 0 {
+		referral.ReferrerReward = cfg.ReferrerReward
+	}
+	if cfg.RefereeReward > 0 {
+		referral.RefereeReward = cfg.RefereeReward
+	}
+	if cfg.MaxRewardedReferrals > 0 {
+		referral.MaxRewardedReferrals = cfg.MaxRewardedReferrals
+	}
+	if cfg.MaxSignupsPerDay > 0 {
+		referral.MaxSignupsPerDay = cfg.MaxSignupsPerDay
+	}
+
+	return referral, nil
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Milestone:            models.ReferralMilestoneTournamentEntered,
+		MilestoneLevel:       5,
+		ReferrerReward:       500,
+		RefereeReward:        250,
+		MaxRewardedReferrals: 50,
+		MaxSignupsPerDay:     20,
+	}
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// AchievementRepository keeps the unlocked achievements, the stats and the
+// counted event markers of a user in one ACHIEVEMENTS# partition
+type AchievementRepository interface {
+	GetStats(ctx context.Context, userId string) (*models.AchievementStats, *apperrors.AppError)
+	RaiseStat(ctx context.Context, userId string, metric models.AchievementMetric, value int) *apperrors.AppError
+	ListUnlocked(ctx context.Context, userId string) ([]models.Achievement, *apperrors.AppError)
+	Unlock(ctx context.Context, achievement *models.Achievement) *apperrors.AppError
+	DeleteByUser(ctx context.Context, userId string) *apperrors.AppError
+
+	// Transaction operations
+	GetEventMarkerTransaction(ctx context.Context, userId, eventKey string) types.Put
+	GetStatsIncrementTransaction(ctx context.Context, userId string, increments map[models.AchievementMetric]int) types.Update
+}
+
+type achievementRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewAchievementRepository(db *database.DynamoDBClient) AchievementRepository {
+	return &achievementRepo{db: db}
+}
+
+// GetStats returns zero stats for users without any recorded progress
+func (r *achievementRepo) GetStats(ctx context.Context, userId string) (*models.AchievementStats, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(r.db.Table()),
+		Key:            statsKey(userId),
+		ConsistentRead: aws.Bool(true),
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get achievement stats")
+	}
+
+	stats := models.AchievementStats{UserId: userId}
+	if result.Item == nil {
+		return &stats, nil
+	}
+
+	if err := attributevalue.UnmarshalMap(result.Item, &stats); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal achievement stats")
+	}
+
+	return &stats, nil
+}
+
+// RaiseStat stores the value if it is higher than the stored one
+func (r *achievementRepo) RaiseStat(
+	ctx context.Context,
+	userId string,
+	metric models.AchievementMetric,
+	value int,
+) *apperrors.AppError {
+	_, err := r.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           aws.String(r.db.Table()),
+		Key:                 statsKey(userId),
+		UpdateExpression:    aws.String("SET #metric = :value, user_id = :userId, updated_at = :now"),
+		ConditionExpression: aws.String("attribute_not_exists(#metric) OR #metric < :value"),
+		ExpressionAttributeNames: map[string]string{
+			"#metric": string(metric),
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":value":  &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", value)},
+			":userId": &types.AttributeValueMemberS{Value: userId},
+			":now":    &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
+		},
+	})
+
+	// The stored value is already as high
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return nil
+	}
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to update achievement stats")
+	}
+
+	return nil
+}
+
+func (r *achievementRepo) ListUnlocked(ctx context.Context, userId string) ([]models.Achievement, *apperrors.AppError) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: models.AchievementsPK(userId)},
+			":sk": &types.AttributeValueMemberS{Value: models.AchievementSK("")},
+		},
+	}
+
+	achievements := make([]models.Achievement, 0)
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list achievements")
+		}
+
+		var pageAchievements []models.Achievement
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageAchievements); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal achievements")
+		}
+		achievements = append(achievements, pageAchievements...)
+	}
+
+	return achievements, nil
+}
+
+// Unlock stores the achievement, unlocking it again is a no-op
+func (r *achievementRepo) Unlock(ctx context.Context, achievement *models.Achievement) *apperrors.AppError {
+	achievement.PK = models.AchievementsPK(achievement.UserId)
+	achievement.SK = models.AchievementSK(achievement.AchievementId)
+
+	item, err := attributevalue.MarshalMap(achievement)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal achievement")
+	}
+
+	_, err = r.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return nil
+	}
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to unlock achievement")
+	}
+
+	return nil
+}
+
+func (r *achievementRepo) DeleteByUser(ctx context.Context, userId string) *apperrors.AppError {
+	_, err := r.db.DeleteByKeyPrefix(ctx, models.AchievementsPK(userId), "")
+	return err
+}
+
+// Transaction Operations
+
+// GetEventMarkerTransaction fails when the event was already counted
+func (r *achievementRepo) GetEventMarkerTransaction(ctx context.Context, userId, eventKey string) types.Put {
+	return types.Put{
+		TableName: aws.String(r.db.Table()),
+		Item: map[string]types.AttributeValue{
+			"PK":         &types.AttributeValueMemberS{Value: models.AchievementsPK(userId)},
+			"SK":         &types.AttributeValueMemberS{Value: models.AchievementEventSK(eventKey)},
+			"created_at": &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}
+}
+
+func (r *achievementRepo) GetStatsIncrementTransaction(
+	ctx context.Context,
+	userId string,
+	increments map[models.AchievementMetric]int,
+) types.Update {
+	names := make(map[string]string, len(increments))
+	values := map[string]types.AttributeValue{
+		":userId": &types.AttributeValueMemberS{Value: userId},
+		":now":    &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
+	}
+
+	addExpression := ""
+	index := 0
+	for metric, increment := range increments {
+		name, value := fmt.Sprintf("#metric%d", index), fmt.Sprintf(":increment%d", index)
+		names[name] = string(metric)
+		values[value] = &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", increment)}
+
+		if addExpression != "" {
+			addExpression += ", "
+		}
+		addExpression += fmt.Sprintf("%s %s", name, value)
+		index++
+	}
+
+	return types.Update{
+		TableName:                 aws.String(r.db.Table()),
+		Key:                       statsKey(userId),
+		UpdateExpression:          aws.String(fmt.Sprintf("SET user_id = :userId, updated_at = :now ADD %s", addExpression)),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	}
+}
+
+func statsKey(userId string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"PK": &types.AttributeValueMemberS{Value: models.AchievementsPK(userId)},
+		"SK": &types.AttributeValueMemberS{Value: models.AchievementStatsSK()},
+	}
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// BalanceAdjustmentRepository keeps the audit records of a user's admin balance
+// adjustments in an ADJUSTMENT# partition, one item per request id
+type BalanceAdjustmentRepository interface {
+	GetByRequestId(ctx context.Context, userId, requestId string) (*models.BalanceAdjustment, *apperrors.AppError)
+	RedactByUser(ctx context.Context, userId string) *apperrors.AppError
+
+	// Transaction operations
+	GetCreateTransaction(ctx context.Context, adjustment *models.BalanceAdjustment) (types.Put, *apperrors.AppError)
+}
+
+type balanceAdjustmentRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewBalanceAdjustmentRepository(db *database.DynamoDBClient) BalanceAdjustmentRepository {
+	return &balanceAdjustmentRepo{db: db}
+}
+
+// GetByRequestId returns nil when no adjustment was made for the request
+func (r *balanceAdjustmentRepo) GetByRequestId(
+	ctx context.Context,
+	userId, requestId string,
+) (*models.BalanceAdjustment, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.BalanceAdjustmentPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.BalanceAdjustmentSK(requestId)},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get balance adjustment")
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var adjustment models.BalanceAdjustment
+	if err := attributevalue.UnmarshalMap(result.Item, &adjustment); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal balance adjustment")
+	}
+
+	return &adjustment, nil
+}
+
+// RedactByUser keeps the adjustments of a deleted user as audit trail and only
+// removes the free text reason, which may hold personal data. Redacted records
+// are skipped so a retried purge changes nothing.
+func (r *balanceAdjustmentRepo) RedactByUser(ctx context.Context, userId string) *apperrors.AppError {
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk"),
+		FilterExpression:       aws.String("attribute_not_exists(user_deleted_at)"),
+		ProjectionExpression:   aws.String("PK, SK"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: models.BalanceAdjustmentPK(userId)},
+		},
+	})
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to query balance adjustments")
+		}
+
+		for _, key := range page.Items {
+			_, err := r.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+				TableName:        aws.String(r.db.Table()),
+				Key:              key,
+				UpdateExpression: aws.String("SET reason = :reason, user_deleted_at = :now"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":reason": &types.AttributeValueMemberS{Value: models.RedactedReason},
+					":now":    &types.AttributeValueMemberS{Value: now},
+				},
+			})
+			if err != nil {
+				return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to redact balance adjustment")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Transaction Operations
+
+// GetCreateTransaction records the adjustment, a request id is recorded once
+func (r *balanceAdjustmentRepo) GetCreateTransaction(
+	ctx context.Context,
+	adjustment *models.BalanceAdjustment,
+) (types.Put, *apperrors.AppError) {
+	adjustment.PK = models.BalanceAdjustmentPK(adjustment.UserId)
+	adjustment.SK = models.BalanceAdjustmentSK(adjustment.RequestId)
+	adjustment.CreatedAt = time.Now().UTC()
+
+	item, err := attributevalue.MarshalMap(adjustment)
+	if err != nil {
+		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal balance adjustment")
+	}
+
+	return types.Put{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+type CoinLedgerRepository interface {
+	ListByUser(ctx context.Context, userId, pageToken string, pageSize int) ([]models.CoinTransaction, string, *apperrors.AppError)
+	DeleteByUser(ctx context.Context, userId string) *apperrors.AppError
+
+	// Transaction operations
+	GetCreateTransaction(ctx context.Context, coinTransaction *models.CoinTransaction) (types.Put, *apperrors.AppError)
+}
+
+type coinLedgerRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewCoinLedgerRepository(db *database.DynamoDBClient) CoinLedgerRepository {
+	return &coinLedgerRepo{db: db}
+}
+
+// ListByUser returns the newest ledger entries first. The page token is the
+// opaque sort key of the last returned entry.
+func (r *coinLedgerRepo) ListByUser(
+	ctx context.Context,
+	userId, pageToken string,
+	pageSize int,
+) ([]models.CoinTransaction, string, *apperrors.AppError) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: models.CoinLedgerPK(userId)},
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int32(int32(pageSize)),
+	}
+
+	if pageToken != "" {
+		lastSK, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return nil, "", apperrors.Wrap(err, apperrors.CodeInvalidInput, "invalid page token")
+		}
+
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.CoinLedgerPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: string(lastSK)},
+		}
+	}
+
+	result, err := r.db.Client.Query(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list coin transactions")
+	}
+
+	var coinTransactions []models.CoinTransaction
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &coinTransactions); err != nil {
+		return nil, "", apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal coin transactions")
+	}
+
+	nextPageToken := ""
+	if sk, ok := result.LastEvaluatedKey["SK"].(*types.AttributeValueMemberS); ok {
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(sk.Value))
+	}
+
+	return coinTransactions, nextPageToken, nil
+}
+
+func (r *coinLedgerRepo) DeleteByUser(ctx context.Context, userId string) *apperrors.AppError {
+	_, err := r.db.DeleteByKeyPrefix(ctx, models.CoinLedgerPK(userId), "")
+	return err
+}
+
+// Transaction Operations
+
+func (r *coinLedgerRepo) GetCreateTransaction(
+	ctx context.Context,
+	coinTransaction *models.CoinTransaction,
+) (types.Put, *apperrors.AppError) {
+	if coinTransaction.TransactionId == "" {
+		coinTransaction.TransactionId = uuid.New().String()
+	}
+	coinTransaction.PK = models.CoinLedgerPK(coinTransaction.UserId)
+	coinTransaction.SK = models.CoinTransactionSK(coinTransaction.CreatedAt, coinTransaction.TransactionId)
+
+	item, err := attributevalue.MarshalMap(coinTransaction)
+	if err != nil {
+		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal coin transaction")
+	}
+
+	return types.Put{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}, nil
+}
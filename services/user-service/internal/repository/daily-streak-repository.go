@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// DailyStreakRepository keeps the login streak of a user in a DAILYSTREAK# item
+type DailyStreakRepository interface {
+	GetByUser(ctx context.Context, userId string) (*models.DailyStreak, *apperrors.AppError)
+	Delete(ctx context.Context, userId string) *apperrors.AppError
+
+	// Transaction operations
+	GetPutTransaction(ctx context.Context, streak *models.DailyStreak, previous *models.DailyStreak) (types.Put, *apperrors.AppError)
+}
+
+type dailyStreakRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewDailyStreakRepository(db *database.DynamoDBClient) DailyStreakRepository {
+	return &dailyStreakRepo{db: db}
+}
+
+// GetByUser returns nil when the user never claimed a daily reward
+func (r *dailyStreakRepo) GetByUser(ctx context.Context, userId string) (*models.DailyStreak, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.DailyStreakPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get daily streak")
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var streak models.DailyStreak
+	if err := attributevalue.UnmarshalMap(result.Item, &streak); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal daily streak")
+	}
+
+	return &streak, nil
+}
+
+func (r *dailyStreakRepo) Delete(ctx context.Context, userId string) *apperrors.AppError {
+	_, err := r.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.DailyStreakPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+	})
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to delete daily streak")
+	}
+
+	return nil
+}
+
+// Transaction Operations
+
+// GetPutTransaction writes the streak as long as it still matches the previous
+// state that was read, a nil previous state requires the streak to not exist
+func (r *dailyStreakRepo) GetPutTransaction(
+	ctx context.Context,
+	streak *models.DailyStreak,
+	previous *models.DailyStreak,
+) (types.Put, *apperrors.AppError) {
+	streak.PK = models.DailyStreakPK(streak.UserId)
+	streak.SK = models.MetaSK()
+	streak.UpdatedAt = time.Now().UTC()
+
+	item, err := attributevalue.MarshalMap(streak)
+	if err != nil {
+		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal daily streak")
+	}
+
+	put := types.Put{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}
+
+	if previous != nil {
+		put.ConditionExpression = aws.String("streak = :streak AND last_claim_day = :lastClaimDay AND freezes = :freezes")
+		put.ExpressionAttributeValues = map[string]types.AttributeValue{
+			":streak":       &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", previous.Streak)},
+			":lastClaimDay": &types.AttributeValueMemberS{Value: previous.LastClaimDay},
+			":freezes":      &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", previous.Freezes)},
+		}
+	}
+
+	return put, nil
+}
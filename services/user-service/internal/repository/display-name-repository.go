@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// DisplayNameRepository keeps one DISPLAYNAME# item per taken name so uniqueness
+// can be enforced inside the transaction that writes the user
+type DisplayNameRepository interface {
+	Claim(ctx context.Context, userId, displayName string) (bool, *apperrors.AppError)
+	Delete(ctx context.Context, userId, displayName string) *apperrors.AppError
+
+	// Transaction operations
+	GetCreateTransaction(ctx context.Context, userId, displayName string) (types.Put, *apperrors.AppError)
+	GetDeleteTransaction(ctx context.Context, userId, displayName string) types.Delete
+}
+
+type displayNameRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewDisplayNameRepository(db *database.DynamoDBClient) DisplayNameRepository {
+	return &displayNameRepo{db: db}
+}
+
+// Claim takes the name for the user outside of a user write, a name the user
+// holds already is kept. It reports false when another user holds the name.
+func (r *displayNameRepo) Claim(ctx context.Context, userId, displayName string) (bool, *apperrors.AppError) {
+	createTransaction, err := r.GetCreateTransaction(ctx, userId, displayName)
+	if err != nil {
+		return false, err
+	}
+
+	_, putErr := r.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           createTransaction.TableName,
+		Item:                createTransaction.Item,
+		ConditionExpression: aws.String("attribute_not_exists(PK) OR user_id = :userId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId},
+		},
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(putErr, &conditionErr) {
+		return false, nil
+	}
+
+	if putErr != nil {
+		return false, apperrors.Wrap(putErr, apperrors.CodeDatabaseError, "failed to claim display name")
+	}
+
+	return true, nil
+}
+
+func (r *displayNameRepo) Delete(ctx context.Context, userId, displayName string) *apperrors.AppError {
+	deleteTransaction := r.GetDeleteTransaction(ctx, userId, displayName)
+
+	_, err := r.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 deleteTransaction.TableName,
+		Key:                       deleteTransaction.Key,
+		ConditionExpression:       deleteTransaction.ConditionExpression,
+		ExpressionAttributeValues: deleteTransaction.ExpressionAttributeValues,
+	})
+
+	// Held by another user, nothing of ours to release
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return nil
+	}
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to release display name")
+	}
+
+	return nil
+}
+
+// Transaction Operations
+
+func (r *displayNameRepo) GetCreateTransaction(
+	ctx context.Context,
+	userId, displayName string,
+) (types.Put, *apperrors.AppError) {
+	claim := &models.DisplayNameClaim{
+		DisplayName: displayName,
+		UserId:      userId,
+		CreatedAt:   time.Now().UTC(),
+		PK:          models.DisplayNamePK(displayName),
+		SK:          models.MetaSK(),
+	}
+
+	item, err := attributevalue.MarshalMap(claim)
+	if err != nil {
+		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal display name claim")
+	}
+
+	return types.Put{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}, nil
+}
+
+// GetDeleteTransaction releases a name held by the user. Users created before
+// names were claimed have no item, so a missing item is accepted.
+func (r *displayNameRepo) GetDeleteTransaction(ctx context.Context, userId, displayName string) types.Delete {
+	return types.Delete{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.DisplayNamePK(displayName)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(PK) OR user_id = :userId"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":userId": &types.AttributeValueMemberS{Value: userId},
+		},
+	}
+}
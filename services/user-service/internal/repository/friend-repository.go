@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// FriendRepository keeps the friends graph as adjacency items, a FRIENDS#user
+// partition holds one FRIEND#friend item per friend or pending request
+type FriendRepository interface {
+	GetByUsers(ctx context.Context, userId, friendId string) (*models.Friendship, *apperrors.AppError)
+	ListByUser(ctx context.Context, userId string) ([]models.Friendship, *apperrors.AppError)
+	DeleteByUser(ctx context.Context, userId string) *apperrors.AppError
+
+	// Transaction operations
+	GetRequestTransactions(ctx context.Context, userId, friendId string) ([]types.Put, *apperrors.AppError)
+	GetAcceptTransactions(ctx context.Context, userId, friendId string) []types.Update
+	GetRemoveTransactions(ctx context.Context, userId, friendId string) []types.Delete
+}
+
+type friendRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewFriendRepository(db *database.DynamoDBClient) FriendRepository {
+	return &friendRepo{db: db}
+}
+
+// GetByUsers returns the user's side of the friendship, nil when there is none
+func (r *friendRepo) GetByUsers(ctx context.Context, userId, friendId string) (*models.Friendship, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(r.db.Table()),
+		Key:            friendshipKey(userId, friendId),
+		ConsistentRead: aws.Bool(true),
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get friendship")
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var friendship models.Friendship
+	if err := attributevalue.UnmarshalMap(result.Item, &friendship); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal friendship")
+	}
+
+	return &friendship, nil
+}
+
+func (r *friendRepo) ListByUser(ctx context.Context, userId string) ([]models.Friendship, *apperrors.AppError) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: models.FriendsPK(userId)},
+			":sk": &types.AttributeValueMemberS{Value: models.FriendSK("")},
+		},
+	}
+
+	friendships := make([]models.Friendship, 0)
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list friendships")
+		}
+
+		var pageFriendships []models.Friendship
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageFriendships); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal friendships")
+		}
+		friendships = append(friendships, pageFriendships...)
+	}
+
+	return friendships, nil
+}
+
+// DeleteByUser removes the user's partition and the user's edge in every friend's partition
+func (r *friendRepo) DeleteByUser(ctx context.Context, userId string) *apperrors.AppError {
+	friendships, err := r.ListByUser(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	for _, friendship := range friendships {
+		_, deleteErr := r.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+			TableName: aws.String(r.db.Table()),
+			Key:       friendshipKey(friendship.FriendId, userId),
+		})
+		if deleteErr != nil {
+			return apperrors.Wrap(deleteErr, apperrors.CodeDatabaseError, "failed to delete friendship")
+		}
+	}
+
+	_, err = r.db.DeleteByKeyPrefix(ctx, models.FriendsPK(userId), "")
+	return err
+}
+
+// Transaction Operations
+
+// GetRequestTransactions creates the outgoing request of the user and the
+// incoming request of the friend, both sides must not exist yet
+func (r *friendRepo) GetRequestTransactions(ctx context.Context, userId, friendId string) ([]types.Put, *apperrors.AppError) {
+	now := time.Now().UTC()
+	edges := []models.Friendship{
+		{
+			UserId:    userId,
+			FriendId:  friendId,
+			Status:    models.FriendshipStatusPendingOutgoing,
+			CreatedAt: now,
+			UpdatedAt: now,
+			PK:        models.FriendsPK(userId),
+			SK:        models.FriendSK(friendId),
+		},
+		{
+			UserId:    friendId,
+			FriendId:  userId,
+			Status:    models.FriendshipStatusPendingIncoming,
+			CreatedAt: now,
+			UpdatedAt: now,
+			PK:        models.FriendsPK(friendId),
+			SK:        models.FriendSK(userId),
+		},
+	}
+
+	puts := make([]types.Put, 0, len(edges))
+	for _, edge := range edges {
+		item, err := attributevalue.MarshalMap(edge)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal friendship")
+		}
+
+		puts = append(puts, types.Put{
+			TableName:           aws.String(r.db.Table()),
+			Item:                item,
+			ConditionExpression: aws.String("attribute_not_exists(PK)"),
+		})
+	}
+
+	return puts, nil
+}
+
+// GetAcceptTransactions accepts the incoming request of the user, both sides
+// must still be pending
+func (r *friendRepo) GetAcceptTransactions(ctx context.Context, userId, friendId string) []types.Update {
+	now := &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)}
+	accepted := &types.AttributeValueMemberS{Value: string(models.FriendshipStatusAccepted)}
+
+	return []types.Update{
+		{
+			TableName:           aws.String(r.db.Table()),
+			Key:                 friendshipKey(userId, friendId),
+			UpdateExpression:    aws.String("SET #status = :accepted, updated_at = :now"),
+			ConditionExpression: aws.String("#status = :pending"),
+			ExpressionAttributeNames: map[string]string{
+				"#status": "status",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":accepted": accepted,
+				":pending":  &types.AttributeValueMemberS{Value: string(models.FriendshipStatusPendingIncoming)},
+				":now":      now,
+			},
+		},
+		{
+			TableName:           aws.String(r.db.Table()),
+			Key:                 friendshipKey(friendId, userId),
+			UpdateExpression:    aws.String("SET #status = :accepted, updated_at = :now"),
+			ConditionExpression: aws.String("#status = :pending"),
+			ExpressionAttributeNames: map[string]string{
+				"#status": "status",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":accepted": accepted,
+				":pending":  &types.AttributeValueMemberS{Value: string(models.FriendshipStatusPendingOutgoing)},
+				":now":      now,
+			},
+		},
+	}
+}
+
+// GetRemoveTransactions deletes both sides of a friendship or request
+func (r *friendRepo) GetRemoveTransactions(ctx context.Context, userId, friendId string) []types.Delete {
+	return []types.Delete{
+		{
+			TableName:           aws.String(r.db.Table()),
+			Key:                 friendshipKey(userId, friendId),
+			ConditionExpression: aws.String("attribute_exists(PK)"),
+		},
+		{
+			TableName: aws.String(r.db.Table()),
+			Key:       friendshipKey(friendId, userId),
+		},
+	}
+}
+
+func friendshipKey(userId, friendId string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"PK": &types.AttributeValueMemberS{Value: models.FriendsPK(userId)},
+		"SK": &types.AttributeValueMemberS{Value: models.FriendSK(friendId)},
+	}
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// InboxRepository keeps the inbox messages of a user in an INBOX# partition,
+// next to a claim of every delivered event. Expired messages and claims are
+// removed by the table TTL, until then messages are filtered out.
+type InboxRepository interface {
+	Create(ctx context.Context, message *models.InboxMessage, eventKey string) (bool, *apperrors.AppError)
+	ListByUser(ctx context.Context, userId, pageToken string, pageSize int) ([]models.InboxMessage, string, *apperrors.AppError)
+	ListUnread(ctx context.Context, userId string) ([]models.InboxMessage, *apperrors.AppError)
+	CountUnread(ctx context.Context, userId string) (int, *apperrors.AppError)
+	MarkRead(ctx context.Context, userId, messageId string) (bool, *apperrors.AppError)
+	DeleteByUser(ctx context.Context, userId string) *apperrors.AppError
+}
+
+type inboxRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewInboxRepository(db *database.DynamoDBClient) InboxRepository {
+	return &inboxRepo{db: db}
+}
+
+// Create stores the message together with the claim of its event, false is
+// returned when the event was already delivered
+func (r *inboxRepo) Create(ctx context.Context, message *models.InboxMessage, eventKey string) (bool, *apperrors.AppError) {
+	message.PK = models.InboxPK(message.UserId)
+	message.SK = models.InboxMessageSK(message.MessageId)
+
+	item, err := attributevalue.MarshalMap(message)
+	if err != nil {
+		return false, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal inbox message")
+	}
+
+	transactionBuilder := database.NewTransactionBuilder()
+	transactionBuilder.AddPut(types.Put{
+		TableName: aws.String(r.db.Table()),
+		Item: map[string]types.AttributeValue{
+			"PK":         &types.AttributeValueMemberS{Value: message.PK},
+			"SK":         &types.AttributeValueMemberS{Value: models.InboxEventSK(eventKey)},
+			"message_id": &types.AttributeValueMemberS{Value: message.MessageId},
+			"expires_at": &types.AttributeValueMemberN{Value: strconv.FormatInt(message.ExpiresAt, 10)},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	})
+	transactionBuilder.AddPut(types.Put{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	})
+
+	if err := transactionBuilder.Execute(ctx, r.db.Client); err != nil {
+		if database.IsConditionalCheckFailed(err, 0) || database.IsConditionalCheckFailed(err, 1) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ListByUser returns the newest messages first. The page token is the opaque
+// sort key of the last evaluated message.
+func (r *inboxRepo) ListByUser(
+	ctx context.Context,
+	userId, pageToken string,
+	pageSize int,
+) ([]models.InboxMessage, string, *apperrors.AppError) {
+	input := r.activeMessagesQuery(userId)
+	input.ScanIndexForward = aws.Bool(false)
+	input.Limit = aws.Int32(int32(pageSize))
+
+	if pageToken != "" {
+		lastSK, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return nil, "", apperrors.Wrap(err, apperrors.CodeInvalidInput, "invalid page token")
+		}
+
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.InboxPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: string(lastSK)},
+		}
+	}
+
+	result, err := r.db.Client.Query(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list inbox messages")
+	}
+
+	var messages []models.InboxMessage
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &messages); err != nil {
+		return nil, "", apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal inbox messages")
+	}
+
+	nextPageToken := ""
+	if sk, ok := result.LastEvaluatedKey["SK"].(*types.AttributeValueMemberS); ok {
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(sk.Value))
+	}
+
+	return messages, nextPageToken, nil
+}
+
+func (r *inboxRepo) ListUnread(ctx context.Context, userId string) ([]models.InboxMessage, *apperrors.AppError) {
+	input := r.unreadMessagesQuery(userId)
+
+	messages := make([]models.InboxMessage, 0)
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list unread inbox messages")
+		}
+
+		var pageMessages []models.InboxMessage
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageMessages); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal inbox messages")
+		}
+		messages = append(messages, pageMessages...)
+	}
+
+	return messages, nil
+}
+
+func (r *inboxRepo) CountUnread(ctx context.Context, userId string) (int, *apperrors.AppError) {
+	input := r.unreadMessagesQuery(userId)
+	input.Select = types.SelectCount
+
+	count := 0
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to count unread inbox messages")
+		}
+		count += int(page.Count)
+	}
+
+	return count, nil
+}
+
+// MarkRead marks an unread message as read, false is returned for messages
+// that are missing or already read
+func (r *inboxRepo) MarkRead(ctx context.Context, userId, messageId string) (bool, *apperrors.AppError) {
+	_, err := r.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.InboxPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.InboxMessageSK(messageId)},
+		},
+		UpdateExpression:    aws.String("SET is_read = :true, read_at = :now"),
+		ConditionExpression: aws.String("attribute_exists(PK) AND is_read = :false"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":true":  &types.AttributeValueMemberBOOL{Value: true},
+			":false": &types.AttributeValueMemberBOOL{Value: false},
+			":now":   &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
+		},
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to mark inbox message as read")
+	}
+
+	return true, nil
+}
+
+func (r *inboxRepo) DeleteByUser(ctx context.Context, userId string) *apperrors.AppError {
+	_, err := r.db.DeleteByKeyPrefix(ctx, models.InboxPK(userId), "")
+	return err
+}
+
+// activeMessagesQuery queries the messages whose TTL has not passed yet
+func (r *inboxRepo) activeMessagesQuery(userId string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		FilterExpression:       aws.String("expires_at > :now"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":  &types.AttributeValueMemberS{Value: models.InboxPK(userId)},
+			":sk":  &types.AttributeValueMemberS{Value: models.InboxMessageSK("")},
+			":now": &types.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().UTC().Unix(), 10)},
+		},
+	}
+}
+
+func (r *inboxRepo) unreadMessagesQuery(userId string) *dynamodb.QueryInput {
+	input := r.activeMessagesQuery(userId)
+	input.FilterExpression = aws.String("expires_at > :now AND is_read = :false")
+	input.ExpressionAttributeValues[":false"] = &types.AttributeValueMemberBOOL{Value: false}
+	return input
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// MigrationRepository records the one-off data migrations that are done
+type MigrationRepository interface {
+	IsCompleted(ctx context.Context, name string) (bool, *apperrors.AppError)
+	MarkCompleted(ctx context.Context, name string) *apperrors.AppError
+}
+
+type migrationRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewMigrationRepository(db *database.DynamoDBClient) MigrationRepository {
+	return &migrationRepo{db: db}
+}
+
+func (r *migrationRepo) IsCompleted(ctx context.Context, name string) (bool, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.MigrationPK(name)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+		ProjectionExpression: aws.String("PK"),
+	})
+
+	if err != nil {
+		return false, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get migration")
+	}
+
+	return result.Item != nil, nil
+}
+
+func (r *migrationRepo) MarkCompleted(ctx context.Context, name string) *apperrors.AppError {
+	migration := &models.Migration{
+		Name:        name,
+		CompletedAt: time.Now().UTC(),
+		PK:          models.MigrationPK(name),
+		SK:          models.MetaSK(),
+	}
+
+	item, err := attributevalue.MarshalMap(migration)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal migration")
+	}
+
+	_, err = r.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(r.db.Table()),
+		Item:      item,
+	})
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to mark migration completed")
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// OutboxRepository keeps the events that are committed but not yet published in
+// the OUTBOX partition, oldest first
+type OutboxRepository interface {
+	ListPending(ctx context.Context, writtenBefore time.Time, limit int) ([]*models.OutboxEvent, *apperrors.AppError)
+	Delete(ctx context.Context, event *models.OutboxEvent) *apperrors.AppError
+
+	// Transaction operations
+	GetCreateTransaction(ctx context.Context, event *models.OutboxEvent) (types.Put, *apperrors.AppError)
+}
+
+type outboxRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewOutboxRepository(db *database.DynamoDBClient) OutboxRepository {
+	return &outboxRepo{db: db}
+}
+
+// ListPending returns the oldest events written before the given time
+func (r *outboxRepo) ListPending(ctx context.Context, writtenBefore time.Time, limit int) ([]*models.OutboxEvent, *apperrors.AppError) {
+	result, err := r.db.Client.Query(ctx, &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk AND SK < :before"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: models.OutboxPK()},
+			":before": &types.AttributeValueMemberS{Value: models.OutboxSK(writtenBefore, "")},
+		},
+		ConsistentRead: aws.Bool(true),
+		Limit:          aws.Int32(int32(limit)),
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list outbox events")
+	}
+
+	var events []*models.OutboxEvent
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &events); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal outbox events")
+	}
+
+	return events, nil
+}
+
+// Delete removes a published event, an event that is already gone is not an error
+func (r *outboxRepo) Delete(ctx context.Context, event *models.OutboxEvent) *apperrors.AppError {
+	_, err := r.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.OutboxPK()},
+			"SK": &types.AttributeValueMemberS{Value: models.OutboxSK(event.CreatedAt, event.EventId)},
+		},
+	})
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to delete outbox event")
+	}
+
+	return nil
+}
+
+// Transaction Operations
+
+func (r *outboxRepo) GetCreateTransaction(ctx context.Context, event *models.OutboxEvent) (types.Put, *apperrors.AppError) {
+	event.PK = models.OutboxPK()
+	event.SK = models.OutboxSK(event.CreatedAt, event.EventId)
+
+	item, err := attributevalue.MarshalMap(event)
+	if err != nil {
+		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal outbox event")
+	}
+
+	return types.Put{
+		TableName: aws.String(r.db.Table()),
+		Item:      item,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// PushRepository keeps the push preferences of a user in a PUSHPREFS# item and
+// the delivery records and rate limit counters in a PUSHDELIVERY# partition
+type PushRepository interface {
+	GetPreferences(ctx context.Context, userId string) (*models.PushPreferences, *apperrors.AppError)
+	PutPreferences(ctx context.Context, preferences *models.PushPreferences) *apperrors.AppError
+	ConsumeRateLimit(ctx context.Context, userId, window string, limit int, expiresAt time.Time) (bool, *apperrors.AppError)
+	RecordDelivery(ctx context.Context, delivery *models.PushDelivery) *apperrors.AppError
+	DeleteByUser(ctx context.Context, userId string) *apperrors.AppError
+}
+
+type pushRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewPushRepository(db *database.DynamoDBClient) PushRepository {
+	return &pushRepo{db: db}
+}
+
+// GetPreferences returns nil when the user never changed their preferences
+func (r *pushRepo) GetPreferences(ctx context.Context, userId string) (*models.PushPreferences, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.PushPreferencesPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get push preferences")
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var preferences models.PushPreferences
+	if err := attributevalue.UnmarshalMap(result.Item, &preferences); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal push preferences")
+	}
+
+	return &preferences, nil
+}
+
+func (r *pushRepo) PutPreferences(ctx context.Context, preferences *models.PushPreferences) *apperrors.AppError {
+	preferences.PK = models.PushPreferencesPK(preferences.UserId)
+	preferences.SK = models.MetaSK()
+
+	item, err := attributevalue.MarshalMap(preferences)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal push preferences")
+	}
+
+	_, err = r.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(r.db.Table()),
+		Item:      item,
+	})
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to put push preferences")
+	}
+
+	return nil
+}
+
+// ConsumeRateLimit counts a push in the window, false is returned once the
+// user reached the limit of the window
+func (r *pushRepo) ConsumeRateLimit(
+	ctx context.Context,
+	userId, window string,
+	limit int,
+	expiresAt time.Time,
+) (bool, *apperrors.AppError) {
+	_, err := r.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.PushDeliveryPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.PushRateSK(window)},
+		},
+		UpdateExpression:    aws.String("ADD #count :one SET expires_at = :expiresAt"),
+		ConditionExpression: aws.String("attribute_not_exists(#count) OR #count < :limit"),
+		ExpressionAttributeNames: map[string]string{
+			"#count": "count",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":one":       &types.AttributeValueMemberN{Value: "1"},
+			":limit":     &types.AttributeValueMemberN{Value: strconv.Itoa(limit)},
+			":expiresAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(expiresAt.Unix(), 10)},
+		},
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to update push rate limit")
+	}
+
+	return true, nil
+}
+
+func (r *pushRepo) RecordDelivery(ctx context.Context, delivery *models.PushDelivery) *apperrors.AppError {
+	delivery.PK = models.PushDeliveryPK(delivery.UserId)
+	delivery.SK = models.PushDeliverySK(delivery.CreatedAt, delivery.DeliveryId)
+
+	item, err := attributevalue.MarshalMap(delivery)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal push delivery")
+	}
+
+	_, err = r.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(r.db.Table()),
+		Item:      item,
+	})
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to record push delivery")
+	}
+
+	return nil
+}
+
+func (r *pushRepo) DeleteByUser(ctx context.Context, userId string) *apperrors.AppError {
+	if _, err := r.db.DeleteByKeyPrefix(ctx, models.PushDeliveryPK(userId), ""); err != nil {
+		return err
+	}
+
+	_, err := r.db.DeleteByKeyPrefix(ctx, models.PushPreferencesPK(userId), "")
+	return err
+}
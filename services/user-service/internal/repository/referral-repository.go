@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+// ReferralRepository keeps one REFERRALCODE# item per code and the referrals of
+// a referrer in a REFERRALS# partition, next to their reward and sign up counters
+type ReferralRepository interface {
+	GetUserIdByCode(ctx context.Context, code string) (string, *apperrors.AppError)
+	GetByUsers(ctx context.Context, referrerId, refereeId string) (*models.Referral, *apperrors.AppError)
+	ListByReferrer(ctx context.Context, referrerId string) ([]models.Referral, *apperrors.AppError)
+	DeleteByUser(ctx context.Context, userId, referralCode, referredBy string) *apperrors.AppError
+
+	// Transaction operations
+	GetCodeCreateTransaction(ctx context.Context, userId, code string) (types.Put, *apperrors.AppError)
+	GetReferralCreateTransaction(ctx context.Context, referrerId, refereeId string) (types.Put, *apperrors.AppError)
+	GetSignupCountTransaction(ctx context.Context, referrerId, day string, limit int, expiresAt time.Time) types.Update
+	GetCompleteTransaction(ctx context.Context, referrerId, refereeId string, referrerRewarded bool) types.Update
+	GetRewardCountTransaction(ctx context.Context, referrerId string, limit int) types.Update
+}
+
+type referralRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewReferralRepository(db *database.DynamoDBClient) ReferralRepository {
+	return &referralRepo{db: db}
+}
+
+// GetUserIdByCode returns the owner of the code, empty when the code is unknown
+func (r *referralRepo) GetUserIdByCode(ctx context.Context, code string) (string, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.ReferralCodePK(code)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+	})
+
+	if err != nil {
+		return "", apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get referral code")
+	}
+
+	if result.Item == nil {
+		return "", nil
+	}
+
+	var referralCode models.ReferralCode
+	if err := attributevalue.UnmarshalMap(result.Item, &referralCode); err != nil {
+		return "", apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal referral code")
+	}
+
+	return referralCode.UserId, nil
+}
+
+// GetByUsers returns nil when the referee did not sign up with the referrer's code
+func (r *referralRepo) GetByUsers(ctx context.Context, referrerId, refereeId string) (*models.Referral, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(r.db.Table()),
+		Key:            referralKey(referrerId, refereeId),
+		ConsistentRead: aws.Bool(true),
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get referral")
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var referral models.Referral
+	if err := attributevalue.UnmarshalMap(result.Item, &referral); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal referral")
+	}
+
+	return &referral, nil
+}
+
+func (r *referralRepo) ListByReferrer(ctx context.Context, referrerId string) ([]models.Referral, *apperrors.AppError) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(r.db.Table()),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: models.ReferralsPK(referrerId)},
+			":sk": &types.AttributeValueMemberS{Value: models.RefereeSK("")},
+		},
+	}
+
+	referrals := make([]models.Referral, 0)
+	paginator := dynamodb.NewQueryPaginator(r.db.Client, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to list referrals")
+		}
+
+		var pageReferrals []models.Referral
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageReferrals); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal referrals")
+		}
+		referrals = append(referrals, pageReferrals...)
+	}
+
+	return referrals, nil
+}
+
+// DeleteByUser removes the user's code, their referrals as a referrer and their
+// own referral in the partition of the user who referred them
+func (r *referralRepo) DeleteByUser(ctx context.Context, userId, referralCode, referredBy string) *apperrors.AppError {
+	if referralCode != "" {
+		_, err := r.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+			TableName: aws.String(r.db.Table()),
+			Key: map[string]types.AttributeValue{
+				"PK": &types.AttributeValueMemberS{Value: models.ReferralCodePK(referralCode)},
+				"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+			},
+		})
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to delete referral code")
+		}
+	}
+
+	if referredBy != "" {
+		_, err := r.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+			TableName: aws.String(r.db.Table()),
+			Key:       referralKey(referredBy, userId),
+		})
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to delete referral")
+		}
+	}
+
+	_, err := r.db.DeleteByKeyPrefix(ctx, models.ReferralsPK(userId), "")
+	return err
+}
+
+// Transaction Operations
+
+// GetCodeCreateTransaction claims the code, it must not be taken yet
+func (r *referralRepo) GetCodeCreateTransaction(ctx context.Context, userId, code string) (types.Put, *apperrors.AppError) {
+	referralCode := &models.ReferralCode{
+		Code:      code,
+		UserId:    userId,
+		CreatedAt: time.Now().UTC(),
+		PK:        models.ReferralCodePK(code),
+		SK:        models.MetaSK(),
+	}
+
+	item, err := attributevalue.MarshalMap(referralCode)
+	if err != nil {
+		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal referral code")
+	}
+
+	return types.Put{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}, nil
+}
+
+func (r *referralRepo) GetReferralCreateTransaction(ctx context.Context, referrerId, refereeId string) (types.Put, *apperrors.AppError) {
+	referral := &models.Referral{
+		ReferrerId: referrerId,
+		RefereeId:  refereeId,
+		Status:     models.ReferralStatusPending,
+		CreatedAt:  time.Now().UTC(),
+		PK:         models.ReferralsPK(referrerId),
+		SK:         models.RefereeSK(refereeId),
+	}
+
+	item, err := attributevalue.MarshalMap(referral)
+	if err != nil {
+		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal referral")
+	}
+
+	return types.Put{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}, nil
+}
+
+// GetSignupCountTransaction counts a sign up with the referrer's code on the
+// day, it fails once the day's limit is reached
+func (r *referralRepo) GetSignupCountTransaction(
+	ctx context.Context,
+	referrerId, day string,
+	limit int,
+	expiresAt time.Time,
+) types.Update {
+	return types.Update{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.ReferralsPK(referrerId)},
+			"SK": &types.AttributeValueMemberS{Value: models.ReferralDaySK(day)},
+		},
+		UpdateExpression:    aws.String("ADD signups :one SET expires_at = :expiresAt"),
+		ConditionExpression: aws.String("attribute_not_exists(signups) OR signups < :limit"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":one":       &types.AttributeValueMemberN{Value: "1"},
+			":limit":     &types.AttributeValueMemberN{Value: strconv.Itoa(limit)},
+			":expiresAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(expiresAt.Unix(), 10)},
+		},
+	}
+}
+
+// GetCompleteTransaction completes a pending referral
+func (r *referralRepo) GetCompleteTransaction(
+	ctx context.Context,
+	referrerId, refereeId string,
+	referrerRewarded bool,
+) types.Update {
+	return types.Update{
+		TableName:           aws.String(r.db.Table()),
+		Key:                 referralKey(referrerId, refereeId),
+		UpdateExpression:    aws.String("SET #status = :completed, referrer_rewarded = :rewarded, completed_at = :now"),
+		ConditionExpression: aws.String("#status = :pending"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":completed": &types.AttributeValueMemberS{Value: string(models.ReferralStatusCompleted)},
+			":pending":   &types.AttributeValueMemberS{Value: string(models.ReferralStatusPending)},
+			":rewarded":  &types.AttributeValueMemberBOOL{Value: referrerRewarded},
+			":now":       &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
+		},
+	}
+}
+
+// GetRewardCountTransaction counts a rewarded referral of the referrer, it fails
+// once the referrer reached the limit
+func (r *referralRepo) GetRewardCountTransaction(ctx context.Context, referrerId string, limit int) types.Update {
+	return types.Update{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.ReferralsPK(referrerId)},
+			"SK": &types.AttributeValueMemberS{Value: models.ReferralStatsSK()},
+		},
+		UpdateExpression:    aws.String("ADD rewarded :one"),
+		ConditionExpression: aws.String("attribute_not_exists(rewarded) OR rewarded < :limit"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":one":   &types.AttributeValueMemberN{Value: "1"},
+			":limit": &types.AttributeValueMemberN{Value: strconv.Itoa(limit)},
+		},
+	}
+}
+
+func referralKey(referrerId, refereeId string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"PK": &types.AttributeValueMemberS{Value: models.ReferralsPK(referrerId)},
+		"SK": &types.AttributeValueMemberS{Value: models.RefereeSK(refereeId)},
+	}
+}
@@ -16,6 +16,7 @@ import (
 type ReservationRepository interface {
 	GetById(ctx context.Context, userId, tournamentId string) (*models.Reservation, *apperrors.AppError)
 	UpdateStatus(ctx context.Context, userId, tournamentId string, status models.ReservationStatus) *apperrors.AppError
+	DeleteByUser(ctx context.Context, userId string) *apperrors.AppError
 
 	// Transaction operations
 	GetCreateTransaction(ctx context.Context, reservation *models.Reservation) (types.Put, *apperrors.AppError)
@@ -77,6 +78,11 @@ func (r *reservationRepo) UpdateStatus(
 	return nil
 }
 
+func (r *reservationRepo) DeleteByUser(ctx context.Context, userId string) *apperrors.AppError {
+	_, err := r.db.DeleteByKeyPrefix(ctx, models.ReservationPK(userId), "")
+	return err
+}
+
 // Transaction Operations
 
 func (r *reservationRepo) GetCreateTransaction(ctx context.Context, reservation *models.Reservation) (types.Put, *apperrors.AppError) {
@@ -13,10 +13,14 @@ import (
 	"github.com/burakmert236/goodswipe-common/models"
 )
 
+// RewardClaimRepository stores one claim item per user and rewarded entity.
+// claimKey is the sort key of the claim, e.g. models.TournamentPK or models.SeasonPK.
 type RewardClaimRepository interface {
-	Create(ctx context.Context, userId, tournamentId string) *apperrors.AppError
-	GetByIdempotency(ctx context.Context, userId, tournamentId string) (*models.RewardClaim, *apperrors.AppError)
-	Delete(ctx context.Context, userId, tournamentId string) *apperrors.AppError
+	GetByIdempotency(ctx context.Context, userId, claimKey string) (*models.RewardClaim, *apperrors.AppError)
+	DeleteByUser(ctx context.Context, userId string) *apperrors.AppError
+
+	// Transaction operations
+	GetCreateTransaction(ctx context.Context, rewardClaim *models.RewardClaim, claimKey string) (types.Put, *apperrors.AppError)
 }
 
 type rewardClaimRepo struct {
@@ -27,43 +31,15 @@ func NewRewardClaimRepository(db *database.DynamoDBClient) RewardClaimRepository
 	return &rewardClaimRepo{db: db}
 }
 
-func (r *rewardClaimRepo) Create(ctx context.Context, userId, tournamentId string) *apperrors.AppError {
-	rewardClaim := &models.RewardClaim{
-		UserId:       userId,
-		TournamentId: tournamentId,
-		CreatedAt:    time.Now().UTC(),
-
-		PK: models.RewardClaimPK(userId),
-		SK: models.TournamentPK(tournamentId),
-	}
-
-	item, err := attributevalue.MarshalMap(rewardClaim)
-	if err != nil {
-		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal reward claim")
-	}
-
-	_, err = r.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
-		TableName:           aws.String(r.db.Table()),
-		Item:                item,
-		ConditionExpression: aws.String("attribute_not_exists(PK)"),
-	})
-
-	if err != nil {
-		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to create reward claim")
-	}
-
-	return nil
-}
-
 func (r *rewardClaimRepo) GetByIdempotency(
 	ctx context.Context,
-	userId, tournamentId string,
+	userId, claimKey string,
 ) (*models.RewardClaim, *apperrors.AppError) {
 	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
 		TableName: aws.String(r.db.Table()),
 		Key: map[string]types.AttributeValue{
 			"PK": &types.AttributeValueMemberS{Value: models.RewardClaimPK(userId)},
-			"SK": &types.AttributeValueMemberS{Value: models.TournamentPK(tournamentId)},
+			"SK": &types.AttributeValueMemberS{Value: claimKey},
 		},
 	})
 
@@ -83,18 +59,30 @@ func (r *rewardClaimRepo) GetByIdempotency(
 	return &rewardClaim, nil
 }
 
-func (r *rewardClaimRepo) Delete(ctx context.Context, userId, tournamentId string) *apperrors.AppError {
-	_, err := r.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
-		TableName: aws.String(r.db.Table()),
-		Key: map[string]types.AttributeValue{
-			"PK": &types.AttributeValueMemberS{Value: models.RewardClaimPK(userId)},
-			"SK": &types.AttributeValueMemberS{Value: models.TournamentPK(tournamentId)},
-		},
-	})
+func (r *rewardClaimRepo) DeleteByUser(ctx context.Context, userId string) *apperrors.AppError {
+	_, err := r.db.DeleteByKeyPrefix(ctx, models.RewardClaimPK(userId), "")
+	return err
+}
 
+// Transaction Operations
+
+func (r *rewardClaimRepo) GetCreateTransaction(
+	ctx context.Context,
+	rewardClaim *models.RewardClaim,
+	claimKey string,
+) (types.Put, *apperrors.AppError) {
+	rewardClaim.PK = models.RewardClaimPK(rewardClaim.UserId)
+	rewardClaim.SK = claimKey
+	rewardClaim.CreatedAt = time.Now().UTC()
+
+	item, err := attributevalue.MarshalMap(rewardClaim)
 	if err != nil {
-		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to delete reward claim")
+		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal reward claim")
 	}
 
-	return nil
+	return types.Put{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}, nil
 }
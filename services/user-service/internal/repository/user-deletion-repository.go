@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/models"
+)
+
+type UserDeletionRepository interface {
+	Create(ctx context.Context, deletion *models.UserDeletion) *apperrors.AppError
+	GetById(ctx context.Context, userId string) (*models.UserDeletion, *apperrors.AppError)
+	CompleteStep(ctx context.Context, userId, step string) (*models.UserDeletion, *apperrors.AppError)
+	MarkCompleted(ctx context.Context, userId string) *apperrors.AppError
+}
+
+type userDeletionRepo struct {
+	db *database.DynamoDBClient
+}
+
+func NewUserDeletionRepository(db *database.DynamoDBClient) UserDeletionRepository {
+	return &userDeletionRepo{db: db}
+}
+
+func (r *userDeletionRepo) Create(ctx context.Context, deletion *models.UserDeletion) *apperrors.AppError {
+	deletion.PK = models.UserDeletionPK(deletion.UserId)
+	deletion.SK = models.MetaSK()
+
+	item, err := attributevalue.MarshalMap(deletion)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal user deletion")
+	}
+
+	_, err = r.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	})
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to create user deletion")
+	}
+
+	return nil
+}
+
+func (r *userDeletionRepo) GetById(ctx context.Context, userId string) (*models.UserDeletion, *apperrors.AppError) {
+	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.UserDeletionPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to get user deletion")
+	}
+
+	if result.Item == nil {
+		return nil, apperrors.New(apperrors.CodeNotFound, "user deletion not found")
+	}
+
+	var deletion models.UserDeletion
+	if err := attributevalue.UnmarshalMap(result.Item, &deletion); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal user deletion")
+	}
+
+	return &deletion, nil
+}
+
+// CompleteStep marks a service as done and returns the updated tracking item
+func (r *userDeletionRepo) CompleteStep(ctx context.Context, userId, step string) (*models.UserDeletion, *apperrors.AppError) {
+	result, err := r.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.UserDeletionPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+		UpdateExpression: aws.String("SET steps.#step = :done"),
+		ExpressionAttributeNames: map[string]string{
+			"#step": step,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":done": &types.AttributeValueMemberBOOL{Value: true},
+		},
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+		ReturnValues:        types.ReturnValueAllNew,
+	})
+
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to complete user deletion step")
+	}
+
+	var deletion models.UserDeletion
+	if err := attributevalue.UnmarshalMap(result.Attributes, &deletion); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal user deletion")
+	}
+
+	return &deletion, nil
+}
+
+func (r *userDeletionRepo) MarkCompleted(ctx context.Context, userId string) *apperrors.AppError {
+	_, err := r.db.Client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: models.UserDeletionPK(userId)},
+			"SK": &types.AttributeValueMemberS{Value: models.MetaSK()},
+		},
+		UpdateExpression: aws.String("SET #status = :completed, completed_at = :now"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":completed":  &types.AttributeValueMemberS{Value: string(models.UserDeletionCompleted)},
+			":inProgress": &types.AttributeValueMemberS{Value: string(models.UserDeletionInProgress)},
+			":now":        &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339Nano)},
+		},
+		ConditionExpression: aws.String("attribute_exists(PK) AND #status = :inProgress"),
+	})
+
+	// Completed by a concurrent confirmation
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return nil
+	}
+
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to complete user deletion")
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -16,14 +17,18 @@ import (
 )
 
 type UserRepository interface {
-	Create(ctx context.Context, user *models.User) *apperrors.AppError
 	GetById(ctx context.Context, userId string) (*models.User, *apperrors.AppError)
-	UpdateLevelProgress(ctx context.Context, userId string, levelIncrease int, coinReward int) (*models.User, *apperrors.AppError)
-	AddCoin(ctx context.Context, userId string, coin int) *apperrors.AppError
+	GetByIds(ctx context.Context, userIds []string) ([]*models.User, *apperrors.AppError)
+	ForEach(ctx context.Context, visit func(user *models.User) *apperrors.AppError) *apperrors.AppError
+	Delete(ctx context.Context, userId string) *apperrors.AppError
 
 	// Transactions operations
-	GetCoinDeductionTransaction(ctx context.Context, userId string, amount int) types.Update
-	GetCoinAdditionTransaction(ctx context.Context, userId string, amount int) types.Update
+	GetCreateTransaction(ctx context.Context, user *models.User) (types.Put, *apperrors.AppError)
+	GetBalanceUpdateTransaction(ctx context.Context, user *models.User, currency models.Currency, delta int, progress models.Progress) types.Update
+	GetDisplayNameUpdateTransaction(ctx context.Context, user *models.User, displayName string) types.Update
+	GetReferralCodeUpdateTransaction(ctx context.Context, user *models.User, code string) types.Update
+	GetModerationUpdateTransaction(ctx context.Context, user *models.User, moderation *models.Moderation) (types.Update, *apperrors.AppError)
+	GetCountryUpdateTransaction(ctx context.Context, user *models.User, country string) types.Update
 }
 
 type userRepo struct {
@@ -34,36 +39,10 @@ func NewUserRepository(db *database.DynamoDBClient) UserRepository {
 	return &userRepo{db: db}
 }
 
-func (r *userRepo) Create(ctx context.Context, user *models.User) *apperrors.AppError {
-	user.PK = models.UserPK(user.UserId)
-	user.SK = models.ProfileSK()
-	user.CreatedAt = time.Now().UTC()
-
-	item, err := attributevalue.MarshalMap(user)
-	if err != nil {
-		return apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshall user")
-	}
-
-	_, err = r.db.Client.PutItem(ctx, &dynamodb.PutItemInput{
-		TableName:           aws.String(r.db.Table()),
-		Item:                item,
-		ConditionExpression: aws.String("attribute_not_exists(PK)"),
-	})
-
-	if err != nil {
-		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to create user")
-	}
-
-	return nil
-}
-
 func (r *userRepo) GetById(ctx context.Context, userId string) (*models.User, *apperrors.AppError) {
 	result, err := r.db.Client.GetItem(ctx, &dynamodb.GetItemInput{
 		TableName: aws.String(r.db.Table()),
-		Key: map[string]types.AttributeValue{
-			"PK": &types.AttributeValueMemberS{Value: models.UserPK(userId)},
-			"SK": &types.AttributeValueMemberS{Value: models.ProfileSK()},
-		},
+		Key:       userKey(userId),
 	})
 
 	if err != nil {
@@ -82,61 +61,76 @@ func (r *userRepo) GetById(ctx context.Context, userId string) (*models.User, *a
 	return &user, nil
 }
 
-func (r *userRepo) UpdateLevelProgress(
-	ctx context.Context,
-	userId string,
-	levelIncrease int,
-	coinReward int,
-) (*models.User, *apperrors.AppError) {
-	if levelIncrease == 0 {
-		return nil, nil
-	}
-
-	input := &dynamodb.UpdateItemInput{
-		TableName: aws.String(r.db.Table()),
-		Key: map[string]types.AttributeValue{
+// GetByIds returns the users that exist among the given ids in no particular order
+func (r *userRepo) GetByIds(ctx context.Context, userIds []string) ([]*models.User, *apperrors.AppError) {
+	keys := make([]map[string]types.AttributeValue, 0, len(userIds))
+	for _, userId := range userIds {
+		keys = append(keys, map[string]types.AttributeValue{
 			"PK": &types.AttributeValueMemberS{Value: models.UserPK(userId)},
 			"SK": &types.AttributeValueMemberS{Value: models.ProfileSK()},
-		},
-		UpdateExpression: aws.String("ADD #level :levelInc, coin :coinInc SET updated_at = :updatedAt"),
-		ExpressionAttributeNames: map[string]string{
-			"#level": "level",
-		},
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":levelInc":  &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", levelIncrease)},
-			":coinInc":   &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", coinReward)},
-			":updatedAt": &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)},
-		},
-		ConditionExpression: aws.String("attribute_exists(PK)"),
-		ReturnValues:        types.ReturnValueAllNew,
+		})
 	}
 
-	result, err := r.db.Client.UpdateItem(ctx, input)
+	items, err := r.db.BatchGetItems(ctx, keys)
 	if err != nil {
-		return nil, apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to update level progress")
+		return nil, err
 	}
 
-	var user models.User
-	if err := attributevalue.UnmarshalMap(result.Attributes, &user); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal user")
+	users := make([]*models.User, 0, len(items))
+	for _, item := range items {
+		var user models.User
+		if err := attributevalue.UnmarshalMap(item, &user); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal user")
+		}
+		users = append(users, &user)
 	}
 
-	return &user, nil
+	return users, nil
 }
 
-func (r *userRepo) AddCoin(ctx context.Context, userId string, coin int) *apperrors.AppError {
-	coinAdditionTransaction := r.GetCoinAdditionTransaction(ctx, userId, coin)
+// ForEach scans every user profile, a visit error stops the scan. It reads the
+// whole table and is meant for one-off migrations.
+func (r *userRepo) ForEach(ctx context.Context, visit func(user *models.User) *apperrors.AppError) *apperrors.AppError {
+	paginator := dynamodb.NewScanPaginator(r.db.Client, &dynamodb.ScanInput{
+		TableName:        aws.String(r.db.Table()),
+		FilterExpression: aws.String("begins_with(PK, :pk) AND SK = :sk"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: models.UserPK("")},
+			":sk": &types.AttributeValueMemberS{Value: models.ProfileSK()},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to scan users")
+		}
 
-	input := &dynamodb.UpdateItemInput{
-		TableName:                 aws.String(r.db.Table()),
-		Key:                       coinAdditionTransaction.Key,
-		UpdateExpression:          coinAdditionTransaction.UpdateExpression,
-		ExpressionAttributeValues: coinAdditionTransaction.ExpressionAttributeValues,
+		for _, item := range page.Items {
+			var user models.User
+			if err := attributevalue.UnmarshalMap(item, &user); err != nil {
+				return apperrors.Wrap(err, apperrors.CodeObjectUnmarshalError, "failed to unmarshal user")
+			}
+
+			if err := visit(&user); err != nil {
+				return err
+			}
+		}
 	}
 
-	_, err := r.db.Client.UpdateItem(ctx, input)
+	return nil
+}
+
+// Delete removes only the profile, the USER# partition also holds the
+// participations owned by the tournament service
+func (r *userRepo) Delete(ctx context.Context, userId string) *apperrors.AppError {
+	_, err := r.db.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(r.db.Table()),
+		Key:       userKey(userId),
+	})
+
 	if err != nil {
-		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to update coin")
+		return apperrors.Wrap(err, apperrors.CodeDatabaseError, "failed to delete user")
 	}
 
 	return nil
@@ -144,37 +138,187 @@ func (r *userRepo) AddCoin(ctx context.Context, userId string, coin int) *apperr
 
 // Transaction Operations
 
-func (r *userRepo) GetCoinDeductionTransaction(ctx context.Context, userId string, amount int) types.Update {
+func (r *userRepo) GetCreateTransaction(ctx context.Context, user *models.User) (types.Put, *apperrors.AppError) {
+	user.PK = models.UserPK(user.UserId)
+	user.SK = models.ProfileSK()
+	user.CreatedAt = time.Now().UTC()
+	user.Version = 1
+
+	item, err := attributevalue.MarshalMap(user)
+	if err != nil {
+		return types.Put{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshall user")
+	}
+
+	return types.Put{
+		TableName:           aws.String(r.db.Table()),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}, nil
+}
+
+// GetBalanceUpdateTransaction moves the balance of the given user snapshot by delta in
+// the given currency and stores its new progress. The write only succeeds while the stored
+// version still matches the snapshot, so the ledger entry written alongside knows the
+// exact balance after.
+func (r *userRepo) GetBalanceUpdateTransaction(
+	ctx context.Context,
+	user *models.User,
+	currency models.Currency,
+	delta int,
+	progress models.Progress,
+) types.Update {
 	now := time.Now().UTC()
 
+	balancePath := "coin"
+	names := versionNames()
+	names["#level"] = "level"
+
+	values := versionValues(user.Version)
+	values[":newBalance"] = &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", user.Balance(currency)+delta)}
+	values[":newLevel"] = &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", progress.Level)}
+	values[":newXp"] = &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", progress.XP)}
+	values[":newPrestige"] = &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", progress.Prestige)}
+	values[":now"] = &types.AttributeValueMemberS{Value: now.Format(time.RFC3339)}
+
+	if currency != models.CurrencyCoin {
+		names["#wallet"] = "wallet"
+
+		if user.Wallet == nil {
+			// Nested paths can not be set before the map exists, so the first
+			// non-coin balance creates the whole wallet
+			balancePath = "#wallet"
+			values[":newBalance"] = &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{
+				string(currency): values[":newBalance"],
+			}}
+		} else {
+			balancePath = "#wallet.#currency"
+			names["#currency"] = string(currency)
+		}
+	}
+
 	return types.Update{
 		TableName: aws.String(r.db.Table()),
-		Key: map[string]types.AttributeValue{
-			"PK": &types.AttributeValueMemberS{Value: models.UserPK(userId)},
-			"SK": &types.AttributeValueMemberS{Value: models.ProfileSK()},
-		},
-		UpdateExpression:    aws.String("SET coin = coin - :amount, updated_at = :now"),
-		ConditionExpression: aws.String("coin >= :amount"),
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":amount": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", amount)},
-			":now":    &types.AttributeValueMemberS{Value: now.Format(time.RFC3339)},
-		},
+		Key:       userKey(user.UserId),
+		UpdateExpression: aws.String(fmt.Sprintf(
+			"SET %s = :newBalance, #level = :newLevel, xp = :newXp, prestige = :newPrestige, updated_at = :now, #version = :newVersion",
+			balancePath,
+		)),
+		ConditionExpression:       aws.String("attribute_exists(PK) AND " + versionCondition(user.Version)),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
 	}
 }
 
-func (r *userRepo) GetCoinAdditionTransaction(ctx context.Context, userId string, amount int) types.Update {
-	now := time.Now().UTC()
+// GetDisplayNameUpdateTransaction renames the user snapshot as long as its version is unchanged
+func (r *userRepo) GetDisplayNameUpdateTransaction(ctx context.Context, user *models.User, displayName string) types.Update {
+	values := versionValues(user.Version)
+	values[":displayName"] = &types.AttributeValueMemberS{Value: displayName}
+	values[":now"] = &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)}
 
 	return types.Update{
-		TableName: aws.String(r.db.Table()),
-		Key: map[string]types.AttributeValue{
-			"PK": &types.AttributeValueMemberS{Value: models.UserPK(userId)},
-			"SK": &types.AttributeValueMemberS{Value: models.ProfileSK()},
-		},
-		UpdateExpression: aws.String("SET coin = coin + :amount, updated_at = :now"),
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":amount": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", amount)},
-			":now":    &types.AttributeValueMemberS{Value: now.Format(time.RFC3339)},
-		},
+		TableName:                 aws.String(r.db.Table()),
+		Key:                       userKey(user.UserId),
+		UpdateExpression:          aws.String("SET display_name = :displayName, updated_at = :now, #version = :newVersion"),
+		ConditionExpression:       aws.String("attribute_exists(PK) AND " + versionCondition(user.Version)),
+		ExpressionAttributeNames:  versionNames(),
+		ExpressionAttributeValues: values,
+	}
+}
+
+// GetReferralCodeUpdateTransaction assigns a code to a user created before referrals existed
+func (r *userRepo) GetReferralCodeUpdateTransaction(ctx context.Context, user *models.User, code string) types.Update {
+	values := versionValues(user.Version)
+	values[":code"] = &types.AttributeValueMemberS{Value: code}
+	values[":now"] = &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)}
+
+	return types.Update{
+		TableName:        aws.String(r.db.Table()),
+		Key:              userKey(user.UserId),
+		UpdateExpression: aws.String("SET referral_code = :code, updated_at = :now, #version = :newVersion"),
+		ConditionExpression: aws.String(
+			"attribute_exists(PK) AND attribute_not_exists(referral_code) AND " + versionCondition(user.Version),
+		),
+		ExpressionAttributeNames:  versionNames(),
+		ExpressionAttributeValues: values,
+	}
+}
+
+// GetModerationUpdateTransaction replaces the moderation state of the user snapshot
+// as long as its version is unchanged
+func (r *userRepo) GetModerationUpdateTransaction(
+	ctx context.Context,
+	user *models.User,
+	moderation *models.Moderation,
+) (types.Update, *apperrors.AppError) {
+	value, err := attributevalue.Marshal(moderation)
+	if err != nil {
+		return types.Update{}, apperrors.Wrap(err, apperrors.CodeObjectMarshalError, "failed to marshal moderation")
+	}
+
+	values := versionValues(user.Version)
+	values[":moderation"] = value
+	values[":now"] = &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)}
+
+	return types.Update{
+		TableName:                 aws.String(r.db.Table()),
+		Key:                       userKey(user.UserId),
+		UpdateExpression:          aws.String("SET moderation = :moderation, updated_at = :now, #version = :newVersion"),
+		ConditionExpression:       aws.String("attribute_exists(PK) AND " + versionCondition(user.Version)),
+		ExpressionAttributeNames:  versionNames(),
+		ExpressionAttributeValues: values,
+	}, nil
+}
+
+// GetCountryUpdateTransaction sets the country of the user snapshot as long as
+// its version is unchanged, an empty country removes it
+func (r *userRepo) GetCountryUpdateTransaction(ctx context.Context, user *models.User, country string) types.Update {
+	values := versionValues(user.Version)
+	values[":now"] = &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339)}
+
+	updateExpression := "SET updated_at = :now, #version = :newVersion REMOVE country"
+	if country != "" {
+		values[":country"] = &types.AttributeValueMemberS{Value: country}
+		updateExpression = "SET country = :country, updated_at = :now, #version = :newVersion"
+	}
+
+	return types.Update{
+		TableName:                 aws.String(r.db.Table()),
+		Key:                       userKey(user.UserId),
+		UpdateExpression:          aws.String(updateExpression),
+		ConditionExpression:       aws.String("attribute_exists(PK) AND " + versionCondition(user.Version)),
+		ExpressionAttributeNames:  versionNames(),
+		ExpressionAttributeValues: values,
+	}
+}
+
+// Private methods
+
+func userKey(userId string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"PK": &types.AttributeValueMemberS{Value: models.UserPK(userId)},
+		"SK": &types.AttributeValueMemberS{Value: models.ProfileSK()},
+	}
+}
+
+// versionCondition matches the version that was read, users created before
+// versioning have no attribute for it yet
+func versionCondition(version int) string {
+	if version == 0 {
+		return "(attribute_not_exists(#version) OR #version = :version)"
+	}
+	return "#version = :version"
+}
+
+func versionNames() map[string]string {
+	return map[string]string{
+		"#version": "version",
+	}
+}
+
+// versionValues holds the version that was read and the one a write stores
+func versionValues(version int) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		":version":    &types.AttributeValueMemberN{Value: strconv.Itoa(version)},
+		":newVersion": &types.AttributeValueMemberN{Value: strconv.Itoa(version + 1)},
 	}
 }
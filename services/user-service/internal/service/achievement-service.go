@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-user-service/internal/achievements"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+)
+
+type AchievementService interface {
+	RecordCounters(ctx context.Context, userId, eventKey string, increments map[models.AchievementMetric]int) *apperrors.AppError
+	RecordLevel(ctx context.Context, userId string, level, prestige int) *apperrors.AppError
+	ListAchievements(ctx context.Context, userId string) ([]AchievementProgress, *apperrors.AppError)
+}
+
+// AchievementProgress is a definition with the user's current value on its metric,
+// Unlocked is nil while the achievement is locked
+type AchievementProgress struct {
+	Definition achievements.Definition
+	Progress   int
+	Unlocked   *models.Achievement
+}
+
+type achievementService struct {
+	achievementRepo repository.AchievementRepository
+	transactionRepo database.TransactionRepository
+	userService     UserService
+	catalog         *achievements.Catalog
+	logger          *logger.Logger
+}
+
+func NewAchievementService(
+	achievementRepo repository.AchievementRepository,
+	transactionRepo database.TransactionRepository,
+	userService UserService,
+	catalog *achievements.Catalog,
+	logger *logger.Logger,
+) AchievementService {
+	return &achievementService{
+		achievementRepo: achievementRepo,
+		transactionRepo: transactionRepo,
+		userService:     userService,
+		catalog:         catalog,
+		logger:          logger,
+	}
+}
+
+// RecordCounters adds the increments to the user's counters once per event key.
+// Unlocks are evaluated on redeliveries too, so an event that was counted but
+// failed to unlock is completed on the next attempt.
+func (s *achievementService) RecordCounters(
+	ctx context.Context,
+	userId, eventKey string,
+	increments map[models.AchievementMetric]int,
+) *apperrors.AppError {
+	if len(increments) == 0 {
+		return nil
+	}
+
+	transactionBuilder := database.NewTransactionBuilder()
+	markerIndex := transactionBuilder.Count()
+	transactionBuilder.AddPut(s.achievementRepo.GetEventMarkerTransaction(ctx, userId, eventKey))
+	transactionBuilder.AddUpdate(s.achievementRepo.GetStatsIncrementTransaction(ctx, userId, increments))
+
+	err := s.transactionRepo.Execute(ctx, transactionBuilder)
+	if database.IsConditionalCheckFailed(err, markerIndex) {
+		s.logger.Debug("Achievement event already counted",
+			"user_id", userId,
+			"event_key", eventKey,
+		)
+	} else if err != nil {
+		return err
+	}
+
+	metrics := make([]models.AchievementMetric, 0, len(increments))
+	for metric := range increments {
+		metrics = append(metrics, metric)
+	}
+
+	return s.unlockReached(ctx, userId, metrics...)
+}
+
+// RecordLevel keeps the highest level and prestige the user reached
+func (s *achievementService) RecordLevel(ctx context.Context, userId string, level, prestige int) *apperrors.AppError {
+	if err := s.achievementRepo.RaiseStat(ctx, userId, models.AchievementMetricLevel, level); err != nil {
+		return err
+	}
+
+	if err := s.achievementRepo.RaiseStat(ctx, userId, models.AchievementMetricPrestige, prestige); err != nil {
+		return err
+	}
+
+	return s.unlockReached(ctx, userId, models.AchievementMetricLevel, models.AchievementMetricPrestige)
+}
+
+func (s *achievementService) ListAchievements(ctx context.Context, userId string) ([]AchievementProgress, *apperrors.AppError) {
+	if _, err := s.userService.GetById(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.achievementRepo.GetStats(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocked, err := s.getUnlocked(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]AchievementProgress, 0, len(s.catalog.Definitions))
+	for _, definition := range s.catalog.Definitions {
+		progress = append(progress, AchievementProgress{
+			Definition: definition,
+			Progress:   stats.Value(definition.Metric),
+			Unlocked:   unlocked[definition.Id],
+		})
+	}
+
+	return progress, nil
+}
+
+// unlockReached unlocks the reached definitions on the given metrics. The reward
+// is collected before the achievement is stored, the reward claim keeps a retry
+// from paying twice. A user deleted in the meantime unlocks nothing, retrying
+// would never succeed.
+func (s *achievementService) unlockReached(
+	ctx context.Context,
+	userId string,
+	metrics ...models.AchievementMetric,
+) *apperrors.AppError {
+	stats, err := s.achievementRepo.GetStats(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	reached := s.catalog.Reached(stats, metrics...)
+	if len(reached) == 0 {
+		return nil
+	}
+
+	unlocked, err := s.getUnlocked(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	for _, definition := range reached {
+		if unlocked[definition.Id] != nil {
+			continue
+		}
+
+		if definition.Reward > 0 {
+			err := s.userService.CollectAchievementReward(ctx, userId, definition.Id, definition.Reward)
+			if err != nil && err.Code == apperrors.CodeNotFound {
+				s.logger.Warn("User of the achievement no longer exists",
+					"user_id", userId,
+					"achievement_id", definition.Id,
+				)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := s.achievementRepo.Unlock(ctx, &models.Achievement{
+			UserId:        userId,
+			AchievementId: definition.Id,
+			Reward:        definition.Reward,
+			UnlockedAt:    time.Now().UTC(),
+		}); err != nil {
+			return err
+		}
+
+		s.logger.Info("Achievement unlocked",
+			"user_id", userId,
+			"achievement_id", definition.Id,
+			"reward", definition.Reward,
+		)
+	}
+
+	return nil
+}
+
+func (s *achievementService) getUnlocked(ctx context.Context, userId string) (map[string]*models.Achievement, *apperrors.AppError) {
+	unlockedAchievements, err := s.achievementRepo.ListUnlocked(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocked := make(map[string]*models.Achievement, len(unlockedAchievements))
+	for i := range unlockedAchievements {
+		unlocked[unlockedAchievements[i].AchievementId] = &unlockedAchievements[i]
+	}
+
+	return unlocked, nil
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	usererrors "github.com/burakmert236/goodswipe-user-service/internal/errors"
+	"github.com/burakmert236/goodswipe-user-service/internal/events"
+	"github.com/burakmert236/goodswipe-user-service/internal/outbox"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+)
+
+const defaultMaxFriends = 200
+
+type FriendService interface {
+	SendFriendRequest(ctx context.Context, userId, friendId string) (*models.Friendship, *apperrors.AppError)
+	AcceptFriendRequest(ctx context.Context, userId, friendId string) (*models.Friendship, *apperrors.AppError)
+	RemoveFriend(ctx context.Context, userId, friendId string) *apperrors.AppError
+	ListFriends(ctx context.Context, userId string, status models.FriendshipStatus) ([]Friend, *apperrors.AppError)
+}
+
+// Friend is a friendship of the user together with the friend's display name
+type Friend struct {
+	Friendship  models.Friendship
+	DisplayName string
+}
+
+type friendService struct {
+	friendRepo      repository.FriendRepository
+	userRepo        repository.UserRepository
+	outboxRepo      repository.OutboxRepository
+	transactionRepo database.TransactionRepository
+	maxFriends      int
+	outbox          *outbox.Relay
+	logger          *logger.Logger
+}
+
+func NewFriendService(
+	friendRepo repository.FriendRepository,
+	userRepo repository.UserRepository,
+	outboxRepo repository.OutboxRepository,
+	transactionRepo database.TransactionRepository,
+	friendsConfig config.FriendsConfig,
+	outbox *outbox.Relay,
+	logger *logger.Logger,
+) FriendService {
+	maxFriends := friendsConfig.MaxFriends
+	if maxFriends <= 0 {
+		maxFriends = defaultMaxFriends
+	}
+
+	return &friendService{
+		friendRepo:      friendRepo,
+		userRepo:        userRepo,
+		outboxRepo:      outboxRepo,
+		transactionRepo: transactionRepo,
+		maxFriends:      maxFriends,
+		outbox:          outbox,
+		logger:          logger,
+	}
+}
+
+// SendFriendRequest sends a request to the friend. Sending it again returns the
+// pending request, sending it to a user who already asked accepts their request.
+func (s *friendService) SendFriendRequest(ctx context.Context, userId, friendId string) (*models.Friendship, *apperrors.AppError) {
+	if userId == friendId {
+		return nil, usererrors.SelfFriendRequestError()
+	}
+
+	existing, err := s.friendRepo.GetByUsers(ctx, userId, friendId)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		switch existing.Status {
+		case models.FriendshipStatusAccepted:
+			return nil, usererrors.AlreadyFriendsError()
+		case models.FriendshipStatusPendingOutgoing:
+			return existing, nil
+		case models.FriendshipStatusPendingIncoming:
+			return s.AcceptFriendRequest(ctx, userId, friendId)
+		}
+	}
+
+	if _, err := s.userRepo.GetById(ctx, friendId); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkFriendLimits(ctx, userId, friendId); err != nil {
+		return nil, err
+	}
+
+	requestTransactions, err := s.friendRepo.GetRequestTransactions(ctx, userId, friendId)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionBuilder := database.NewTransactionBuilder()
+	for _, put := range requestTransactions {
+		transactionBuilder.AddPut(put)
+	}
+
+	if err := s.transactionRepo.Execute(ctx, transactionBuilder); err != nil {
+		if isFriendshipConflict(err) {
+			return nil, usererrors.FriendshipChangedConcurrentlyError()
+		}
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &models.Friendship{
+		UserId:    userId,
+		FriendId:  friendId,
+		Status:    models.FriendshipStatusPendingOutgoing,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (s *friendService) AcceptFriendRequest(ctx context.Context, userId, friendId string) (*models.Friendship, *apperrors.AppError) {
+	existing, err := s.friendRepo.GetByUsers(ctx, userId, friendId)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil || existing.Status == models.FriendshipStatusPendingOutgoing {
+		return nil, usererrors.FriendRequestNotFoundError()
+	}
+
+	// Accepting twice keeps the friendship
+	if existing.Status == models.FriendshipStatusAccepted {
+		return existing, nil
+	}
+
+	if err := s.checkFriendLimits(ctx, userId, friendId); err != nil {
+		return nil, err
+	}
+
+	addedEvent, err := events.NewUserFriendAddedEvent(userId, friendId)
+	if err != nil {
+		return nil, err
+	}
+
+	eventPutTransaction, err := s.outboxRepo.GetCreateTransaction(ctx, addedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionBuilder := database.NewTransactionBuilder()
+	for _, update := range s.friendRepo.GetAcceptTransactions(ctx, userId, friendId) {
+		transactionBuilder.AddUpdate(update)
+	}
+	transactionBuilder.AddPut(eventPutTransaction)
+
+	if err := s.transactionRepo.Execute(ctx, transactionBuilder); err != nil {
+		if isFriendshipConflict(err) {
+			return nil, usererrors.FriendshipChangedConcurrentlyError()
+		}
+		return nil, err
+	}
+
+	existing.Status = models.FriendshipStatusAccepted
+	existing.UpdatedAt = time.Now().UTC()
+
+	s.outbox.Publish(ctx, addedEvent)
+
+	return existing, nil
+}
+
+// RemoveFriend removes a friend, declines an incoming request or cancels an
+// outgoing one. Only removing an accepted friend is announced.
+func (s *friendService) RemoveFriend(ctx context.Context, userId, friendId string) *apperrors.AppError {
+	existing, err := s.friendRepo.GetByUsers(ctx, userId, friendId)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		return usererrors.FriendshipNotFoundError()
+	}
+
+	transactionBuilder := database.NewTransactionBuilder()
+	for _, deleteTransaction := range s.friendRepo.GetRemoveTransactions(ctx, userId, friendId) {
+		transactionBuilder.AddDelete(deleteTransaction)
+	}
+
+	var removedEvent *models.OutboxEvent
+	if existing.Status == models.FriendshipStatusAccepted {
+		removedEvent, err = events.NewUserFriendRemovedEvent(userId, friendId)
+		if err != nil {
+			return err
+		}
+
+		eventPutTransaction, err := s.outboxRepo.GetCreateTransaction(ctx, removedEvent)
+		if err != nil {
+			return err
+		}
+		transactionBuilder.AddPut(eventPutTransaction)
+	}
+
+	if err := s.transactionRepo.Execute(ctx, transactionBuilder); err != nil {
+		if isFriendshipConflict(err) {
+			return usererrors.FriendshipNotFoundError()
+		}
+		return err
+	}
+
+	if removedEvent != nil {
+		s.outbox.Publish(ctx, removedEvent)
+	}
+
+	return nil
+}
+
+// ListFriends returns the user's friendships with the given status, an empty
+// status returns every friendship and pending request
+func (s *friendService) ListFriends(
+	ctx context.Context,
+	userId string,
+	status models.FriendshipStatus,
+) ([]Friend, *apperrors.AppError) {
+	friendships, err := s.friendRepo.ListByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	friendIds := make([]string, 0, len(friendships))
+	for _, friendship := range friendships {
+		if status == "" || friendship.Status == status {
+			friendIds = append(friendIds, friendship.FriendId)
+		}
+	}
+
+	if len(friendIds) == 0 {
+		return []Friend{}, nil
+	}
+
+	users, err := s.userRepo.GetByIds(ctx, friendIds)
+	if err != nil {
+		return nil, err
+	}
+
+	displayNames := make(map[string]string, len(users))
+	for _, user := range users {
+		displayNames[user.UserId] = user.DisplayName
+	}
+
+	friends := make([]Friend, 0, len(friendIds))
+	for _, friendship := range friendships {
+		if status != "" && friendship.Status != status {
+			continue
+		}
+
+		friends = append(friends, Friend{
+			Friendship:  friendship,
+			DisplayName: displayNames[friendship.FriendId],
+		})
+	}
+
+	return friends, nil
+}
+
+// checkFriendLimits checks that both users stay within the friend limit, a
+// request between the two users is not counted again
+func (s *friendService) checkFriendLimits(ctx context.Context, userId, friendId string) *apperrors.AppError {
+	userCount, err := s.countFriendships(ctx, userId, friendId)
+	if err != nil {
+		return err
+	}
+
+	if userCount >= s.maxFriends {
+		return usererrors.FriendLimitError(s.maxFriends)
+	}
+
+	friendCount, err := s.countFriendships(ctx, friendId, userId)
+	if err != nil {
+		return err
+	}
+
+	if friendCount >= s.maxFriends {
+		return usererrors.FriendLimitOfFriendError(s.maxFriends)
+	}
+
+	return nil
+}
+
+// countFriendships counts the user's friendships and pending requests except
+// the one with the given user
+func (s *friendService) countFriendships(ctx context.Context, userId, exceptId string) (int, *apperrors.AppError) {
+	friendships, err := s.friendRepo.ListByUser(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, friendship := range friendships {
+		if friendship.FriendId != exceptId {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// isFriendshipConflict reports a failed condition on either side of a friendship
+func isFriendshipConflict(err *apperrors.AppError) bool {
+	return database.IsConditionalCheckFailed(err, 0) || database.IsConditionalCheckFailed(err, 1)
+}
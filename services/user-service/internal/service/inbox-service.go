@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-user-service/internal/inbox"
+	"github.com/burakmert236/goodswipe-user-service/internal/push"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+)
+
+const (
+	defaultInboxPageSize = 20
+	maxInboxPageSize     = 100
+)
+
+type InboxService interface {
+	Deliver(ctx context.Context, notification inbox.Notification) *apperrors.AppError
+	ListInbox(ctx context.Context, userId, pageToken string, pageSize int) ([]models.InboxMessage, string, *apperrors.AppError)
+	MarkRead(ctx context.Context, userId string, messageIds []string) (int, *apperrors.AppError)
+	GetUnreadCount(ctx context.Context, userId string) (int, *apperrors.AppError)
+}
+
+type inboxService struct {
+	inboxRepo      repository.InboxRepository
+	renderer       *inbox.Renderer
+	pushDispatcher *push.Dispatcher
+	logger         *logger.Logger
+}
+
+func NewInboxService(
+	inboxRepo repository.InboxRepository,
+	renderer *inbox.Renderer,
+	pushDispatcher *push.Dispatcher,
+	logger *logger.Logger,
+) InboxService {
+	return &inboxService{
+		inboxRepo:      inboxRepo,
+		renderer:       renderer,
+		pushDispatcher: pushDispatcher,
+		logger:         logger,
+	}
+}
+
+func (s *inboxService) Deliver(ctx context.Context, notification inbox.Notification) *apperrors.AppError {
+	title, body, err := s.renderer.Render(notification.Type, notification.Data)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	message := &models.InboxMessage{
+		UserId:      notification.UserId,
+		MessageId:   models.InboxMessageId(notification.OccurredAt, notification.EventKey),
+		Type:        notification.Type,
+		Title:       title,
+		Body:        body,
+		ReferenceId: notification.ReferenceId,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.renderer.TTL).Unix(),
+	}
+
+	created, err := s.inboxRepo.Create(ctx, message, notification.EventKey)
+	if err != nil {
+		return err
+	}
+
+	// Only the first delivery is pushed, a redelivered event was pushed already
+	if !created {
+		s.logger.Debug("Inbox message already delivered",
+			"user_id", notification.UserId,
+			"message_id", message.MessageId,
+		)
+		return nil
+	}
+
+	s.pushDispatcher.Enqueue(*message)
+	return nil
+}
+
+func (s *inboxService) ListInbox(
+	ctx context.Context,
+	userId, pageToken string,
+	pageSize int,
+) ([]models.InboxMessage, string, *apperrors.AppError) {
+	if pageSize <= 0 {
+		pageSize = defaultInboxPageSize
+	}
+	if pageSize > maxInboxPageSize {
+		pageSize = maxInboxPageSize
+	}
+
+	return s.inboxRepo.ListByUser(ctx, userId, pageToken, pageSize)
+}
+
+// MarkRead marks the given messages as read, without message ids every unread
+// message is marked. Returns the number of messages that changed.
+func (s *inboxService) MarkRead(ctx context.Context, userId string, messageIds []string) (int, *apperrors.AppError) {
+	if len(messageIds) == 0 {
+		unread, err := s.inboxRepo.ListUnread(ctx, userId)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, message := range unread {
+			messageIds = append(messageIds, message.MessageId)
+		}
+	}
+
+	marked := 0
+	for _, messageId := range messageIds {
+		updated, err := s.inboxRepo.MarkRead(ctx, userId, messageId)
+		if err != nil {
+			return marked, err
+		}
+		if updated {
+			marked++
+		}
+	}
+
+	return marked, nil
+}
+
+func (s *inboxService) GetUnreadCount(ctx context.Context, userId string) (int, *apperrors.AppError) {
+	return s.inboxRepo.CountUnread(ctx, userId)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	usererrors "github.com/burakmert236/goodswipe-user-service/internal/errors"
+	"github.com/burakmert236/goodswipe-user-service/internal/events"
+	"github.com/burakmert236/goodswipe-user-service/internal/outbox"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+)
+
+type ModerationService interface {
+	SuspendUser(ctx context.Context, userId string, until time.Time, operatorId, reason string) (*models.Moderation, *apperrors.AppError)
+	BanUser(ctx context.Context, userId, operatorId, reason string) (*models.Moderation, *apperrors.AppError)
+	ReinstateUser(ctx context.Context, userId, operatorId, reason string) (*models.Moderation, *apperrors.AppError)
+}
+
+type moderationService struct {
+	userRepo        repository.UserRepository
+	outboxRepo      repository.OutboxRepository
+	transactionRepo database.TransactionRepository
+	outbox          *outbox.Relay
+	logger          *logger.Logger
+}
+
+func NewModerationService(
+	userRepo repository.UserRepository,
+	outboxRepo repository.OutboxRepository,
+	transactionRepo database.TransactionRepository,
+	outbox *outbox.Relay,
+	logger *logger.Logger,
+) ModerationService {
+	return &moderationService{
+		userRepo:        userRepo,
+		outboxRepo:      outboxRepo,
+		transactionRepo: transactionRepo,
+		outbox:          outbox,
+		logger:          logger,
+	}
+}
+
+// SuspendUser blocks gameplay until the given time, a later call replaces the suspension
+func (s *moderationService) SuspendUser(
+	ctx context.Context,
+	userId string,
+	until time.Time,
+	operatorId, reason string,
+) (*models.Moderation, *apperrors.AppError) {
+	if !until.After(time.Now()) {
+		return nil, apperrors.New(apperrors.CodeInvalidInput, "suspension must end in the future")
+	}
+
+	until = until.UTC()
+	return s.setModeration(ctx, userId, &models.Moderation{
+		Status:         models.ModerationStatusSuspended,
+		SuspendedUntil: &until,
+		Reason:         reason,
+		OperatorId:     operatorId,
+	})
+}
+
+func (s *moderationService) BanUser(ctx context.Context, userId, operatorId, reason string) (*models.Moderation, *apperrors.AppError) {
+	return s.setModeration(ctx, userId, &models.Moderation{
+		Status:     models.ModerationStatusBanned,
+		Reason:     reason,
+		OperatorId: operatorId,
+	})
+}
+
+// ReinstateUser lifts a suspension or ban, the reason is kept as the last moderation record
+func (s *moderationService) ReinstateUser(ctx context.Context, userId, operatorId, reason string) (*models.Moderation, *apperrors.AppError) {
+	return s.setModeration(ctx, userId, &models.Moderation{
+		Status:     models.ModerationStatusActive,
+		Reason:     reason,
+		OperatorId: operatorId,
+	})
+}
+
+// Private methods
+
+// setModeration stores the state against the version of the user that was read,
+// retrying when the user changed in between. UserModerationChanged is written to
+// the outbox in the same transaction, the leaderboard service hides banned users
+// on it.
+func (s *moderationService) setModeration(
+	ctx context.Context,
+	userId string,
+	moderation *models.Moderation,
+) (*models.Moderation, *apperrors.AppError) {
+	switch {
+	case moderation.OperatorId == "":
+		return nil, apperrors.New(apperrors.CodeInvalidInput, "operator id is required")
+	case moderation.Reason == "":
+		return nil, apperrors.New(apperrors.CodeInvalidInput, "reason is required")
+	}
+
+	var err *apperrors.AppError
+	var changedEvent *models.OutboxEvent
+
+	for attempt := 0; attempt < userUpdateMaxAttempts; attempt++ {
+		user, getErr := s.userRepo.GetById(ctx, userId)
+		if getErr != nil {
+			return nil, getErr
+		}
+
+		moderation.UpdatedAt = time.Now().UTC()
+		changedEvent, err = s.writeModeration(ctx, user, moderation)
+		if err == nil {
+			break
+		}
+
+		if err.Code != apperrors.CodeConflict {
+			return nil, err
+		}
+
+		s.logger.Warn("User changed concurrently, retrying moderation update",
+			"user_id", userId,
+			"attempt", attempt+1,
+		)
+	}
+
+	if err != nil {
+		return nil, usererrors.UserChangedConcurrentlyError()
+	}
+
+	s.logger.Info("User moderation changed",
+		"user_id", userId,
+		"status", moderation.Status,
+		"operator_id", moderation.OperatorId,
+	)
+
+	s.outbox.Publish(ctx, changedEvent)
+
+	return moderation, nil
+}
+
+// writeModeration stores the state together with its event, a user changed since
+// it was read fails with a conflict
+func (s *moderationService) writeModeration(
+	ctx context.Context,
+	user *models.User,
+	moderation *models.Moderation,
+) (*models.OutboxEvent, *apperrors.AppError) {
+	moderationUpdateTransaction, err := s.userRepo.GetModerationUpdateTransaction(ctx, user, moderation)
+	if err != nil {
+		return nil, err
+	}
+
+	changedEvent, err := events.NewUserModerationChangedEvent(user.UserId, moderation)
+	if err != nil {
+		return nil, err
+	}
+
+	eventPutTransaction, err := s.outboxRepo.GetCreateTransaction(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionBuilder := database.NewTransactionBuilder()
+	transactionBuilder.AddUpdate(moderationUpdateTransaction)
+	transactionBuilder.AddPut(eventPutTransaction)
+
+	if err := s.transactionRepo.Execute(ctx, transactionBuilder); err != nil {
+		if database.IsConditionalCheckFailed(err, 0) {
+			return nil, usererrors.UserChangedConcurrentlyError()
+		}
+		return nil, err
+	}
+
+	return changedEvent, nil
+}
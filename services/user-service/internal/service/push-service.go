@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-user-service/internal/push"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+)
+
+type PushService interface {
+	GetPreferences(ctx context.Context, userId string) (*models.PushPreferences, *apperrors.AppError)
+	UpdatePreferences(ctx context.Context, preferences *models.PushPreferences) (*models.PushPreferences, *apperrors.AppError)
+}
+
+type pushService struct {
+	pushRepo repository.PushRepository
+	logger   *logger.Logger
+}
+
+func NewPushService(pushRepo repository.PushRepository, logger *logger.Logger) PushService {
+	return &pushService{
+		pushRepo: pushRepo,
+		logger:   logger,
+	}
+}
+
+// GetPreferences returns the defaults for users who never changed their preferences
+func (s *pushService) GetPreferences(ctx context.Context, userId string) (*models.PushPreferences, *apperrors.AppError) {
+	preferences, err := s.pushRepo.GetPreferences(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if preferences == nil {
+		return models.DefaultPushPreferences(userId), nil
+	}
+
+	return preferences, nil
+}
+
+// UpdatePreferences replaces every preference of the user
+func (s *pushService) UpdatePreferences(
+	ctx context.Context,
+	preferences *models.PushPreferences,
+) (*models.PushPreferences, *apperrors.AppError) {
+	if err := push.ValidatePreferences(preferences); err != nil {
+		return nil, err
+	}
+
+	preferences.UpdatedAt = time.Now().UTC()
+	if err := s.pushRepo.PutPreferences(ctx, preferences); err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
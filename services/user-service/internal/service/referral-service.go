@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	usererrors "github.com/burakmert236/goodswipe-user-service/internal/errors"
+	"github.com/burakmert236/goodswipe-user-service/internal/referral"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+)
+
+// Reward counter update is always the second item of a completion transaction
+const referralRewardCountIndex = 1
+
+type ReferralService interface {
+	GetReferralInfo(ctx context.Context, userId string) (*ReferralInfo, *apperrors.AppError)
+	CompleteReferral(ctx context.Context, refereeId string, milestone models.ReferralMilestone, level, prestige int) *apperrors.AppError
+}
+
+// ReferralInfo is the user's own code, the referrer they signed up with and the
+// users who signed up with their code
+type ReferralInfo struct {
+	Code       string
+	ReferredBy string
+	Referrals  []Referee
+}
+
+// Referee is a referral of the user together with the referee's display name
+type Referee struct {
+	Referral    models.Referral
+	DisplayName string
+}
+
+type referralService struct {
+	referralRepo    repository.ReferralRepository
+	userRepo        repository.UserRepository
+	transactionRepo database.TransactionRepository
+	userService     UserService
+	referral        *referral.Config
+	logger          *logger.Logger
+}
+
+func NewReferralService(
+	referralRepo repository.ReferralRepository,
+	userRepo repository.UserRepository,
+	transactionRepo database.TransactionRepository,
+	userService UserService,
+	referral *referral.Config,
+	logger *logger.Logger,
+) ReferralService {
+	return &referralService{
+		referralRepo:    referralRepo,
+		userRepo:        userRepo,
+		transactionRepo: transactionRepo,
+		userService:     userService,
+		referral:        referral,
+		logger:          logger,
+	}
+}
+
+// GetReferralInfo returns the user's referrals. Users created before referrals
+// existed get their code on the first call.
+func (s *referralService) GetReferralInfo(ctx context.Context, userId string) (*ReferralInfo, *apperrors.AppError) {
+	user, err := s.userRepo.GetById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	code := user.ReferralCode
+	if code == "" {
+		code, err = s.assignCode(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	referrals, err := s.referralRepo.ListByReferrer(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	refereeIds := make([]string, 0, len(referrals))
+	for _, item := range referrals {
+		refereeIds = append(refereeIds, item.RefereeId)
+	}
+
+	referees, err := s.userRepo.GetByIds(ctx, refereeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	displayNames := make(map[string]string, len(referees))
+	for _, referee := range referees {
+		displayNames[referee.UserId] = referee.DisplayName
+	}
+
+	info := &ReferralInfo{
+		Code:       code,
+		ReferredBy: user.ReferredBy,
+		Referrals:  make([]Referee, 0, len(referrals)),
+	}
+	for _, item := range referrals {
+		info.Referrals = append(info.Referrals, Referee{
+			Referral:    item,
+			DisplayName: displayNames[item.RefereeId],
+		})
+	}
+
+	return info, nil
+}
+
+// CompleteReferral completes the referee's referral once they reach the configured
+// milestone and rewards both sides. The referrer is only rewarded while they are
+// below the reward limit. Rewards are claimed idempotently, so redelivered
+// milestones pay out what an earlier attempt left unpaid.
+func (s *referralService) CompleteReferral(
+	ctx context.Context,
+	refereeId string,
+	milestone models.ReferralMilestone,
+	level, prestige int,
+) *apperrors.AppError {
+	if !s.referral.Reached(milestone, level, prestige) {
+		return nil
+	}
+
+	referee, err := s.userRepo.GetById(ctx, refereeId)
+	if err != nil {
+		// Deleted before the milestone was processed
+		if err.Code == apperrors.CodeNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if referee.ReferredBy == "" {
+		return nil
+	}
+
+	current, err := s.referralRepo.GetByUsers(ctx, referee.ReferredBy, refereeId)
+	if err != nil {
+		return err
+	}
+
+	// Removed together with the referrer's data
+	if current == nil {
+		return nil
+	}
+
+	if current.Status == models.ReferralStatusPending {
+		current, err = s.complete(ctx, current)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return nil
+		}
+	}
+
+	if err := s.userService.CollectReferralReward(ctx, refereeId, refereeId, s.referral.RefereeReward); err != nil {
+		return err
+	}
+
+	if current.ReferrerRewarded {
+		if err := s.userService.CollectReferralReward(ctx, current.ReferrerId, refereeId, s.referral.ReferrerReward); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Private methods
+
+// complete marks the referral completed and counts it towards the referrer's
+// reward limit. A referrer at the limit completes it without their reward.
+func (s *referralService) complete(ctx context.Context, pending *models.Referral) (*models.Referral, *apperrors.AppError) {
+	referrerId, refereeId := pending.ReferrerId, pending.RefereeId
+
+	transactionBuilder := database.NewTransactionBuilder()
+	transactionBuilder.AddUpdate(s.referralRepo.GetCompleteTransaction(ctx, referrerId, refereeId, true))
+	transactionBuilder.AddUpdate(s.referralRepo.GetRewardCountTransaction(ctx, referrerId, s.referral.MaxRewardedReferrals))
+
+	err := s.transactionRepo.Execute(ctx, transactionBuilder)
+	if database.IsConditionalCheckFailed(err, referralRewardCountIndex) {
+		s.logger.Info("Referrer reached the referral reward limit",
+			"referrer_id", referrerId,
+			"referee_id", refereeId,
+		)
+
+		transactionBuilder = database.NewTransactionBuilder()
+		transactionBuilder.AddUpdate(s.referralRepo.GetCompleteTransaction(ctx, referrerId, refereeId, false))
+		err = s.transactionRepo.Execute(ctx, transactionBuilder)
+	}
+
+	// Completed concurrently by another delivery, the stored outcome decides the rewards
+	if err != nil && !database.IsConditionalCheckFailed(err, 0) {
+		return nil, err
+	}
+
+	return s.referralRepo.GetByUsers(ctx, referrerId, refereeId)
+}
+
+// assignCode claims a new code for an existing user, a concurrent call that
+// assigned one first wins. A user changed otherwise since it was read is read
+// again and the code is claimed against the new version.
+func (s *referralService) assignCode(ctx context.Context, user *models.User) (string, *apperrors.AppError) {
+	var err *apperrors.AppError
+
+	for attempt := 0; attempt < referralCodeMaxAttempts; attempt++ {
+		code, codeErr := referral.GenerateCode()
+		if codeErr != nil {
+			return "", codeErr
+		}
+
+		codePutTransaction, putErr := s.referralRepo.GetCodeCreateTransaction(ctx, user.UserId, code)
+		if putErr != nil {
+			return "", putErr
+		}
+
+		transactionBuilder := database.NewTransactionBuilder()
+		transactionBuilder.AddUpdate(s.userRepo.GetReferralCodeUpdateTransaction(ctx, user, code))
+		transactionBuilder.AddPut(codePutTransaction)
+
+		err = s.transactionRepo.Execute(ctx, transactionBuilder)
+		if err == nil {
+			return code, nil
+		}
+
+		if database.IsConditionalCheckFailed(err, 0) {
+			current, getErr := s.userRepo.GetById(ctx, user.UserId)
+			if getErr != nil {
+				return "", getErr
+			}
+			if current.ReferralCode != "" {
+				return current.ReferralCode, nil
+			}
+
+			user = current
+			err = usererrors.UserChangedConcurrentlyError()
+			continue
+		}
+
+		if !database.IsConditionalCheckFailed(err, 1) {
+			return "", err
+		}
+	}
+
+	return "", err
+}
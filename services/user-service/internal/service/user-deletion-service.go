@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-user-service/internal/events"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+)
+
+type UserDeletionService interface {
+	DeleteUser(ctx context.Context, userId string) (*models.UserDeletion, *apperrors.AppError)
+	GetDeletionStatus(ctx context.Context, userId string) (*models.UserDeletion, *apperrors.AppError)
+	CompleteDeletionStep(ctx context.Context, userId, step string) *apperrors.AppError
+	IsUserDeleted(ctx context.Context, userId string) (bool, *apperrors.AppError)
+}
+
+type userDeletionService struct {
+	userDeletionRepo      repository.UserDeletionRepository
+	userRepo              repository.UserRepository
+	reservationRepo       repository.ReservationRepository
+	rewardClaimRepository repository.RewardClaimRepository
+	coinLedgerRepo        repository.CoinLedgerRepository
+	displayNameRepo       repository.DisplayNameRepository
+	dailyStreakRepo       repository.DailyStreakRepository
+	achievementRepo       repository.AchievementRepository
+	friendRepo            repository.FriendRepository
+	inboxRepo             repository.InboxRepository
+	pushRepo              repository.PushRepository
+	referralRepo          repository.ReferralRepository
+	balanceAdjustmentRepo repository.BalanceAdjustmentRepository
+	publisher             *events.EventPublisher
+	logger                *logger.Logger
+}
+
+func NewUserDeletionService(
+	userDeletionRepo repository.UserDeletionRepository,
+	userRepo repository.UserRepository,
+	reservationRepo repository.ReservationRepository,
+	rewardClaimRepository repository.RewardClaimRepository,
+	coinLedgerRepo repository.CoinLedgerRepository,
+	displayNameRepo repository.DisplayNameRepository,
+	dailyStreakRepo repository.DailyStreakRepository,
+	achievementRepo repository.AchievementRepository,
+	friendRepo repository.FriendRepository,
+	inboxRepo repository.InboxRepository,
+	pushRepo repository.PushRepository,
+	referralRepo repository.ReferralRepository,
+	balanceAdjustmentRepo repository.BalanceAdjustmentRepository,
+	publisher *events.EventPublisher,
+	logger *logger.Logger,
+) UserDeletionService {
+	return &userDeletionService{
+		userDeletionRepo:      userDeletionRepo,
+		userRepo:              userRepo,
+		reservationRepo:       reservationRepo,
+		rewardClaimRepository: rewardClaimRepository,
+		coinLedgerRepo:        coinLedgerRepo,
+		displayNameRepo:       displayNameRepo,
+		dailyStreakRepo:       dailyStreakRepo,
+		achievementRepo:       achievementRepo,
+		friendRepo:            friendRepo,
+		inboxRepo:             inboxRepo,
+		pushRepo:              pushRepo,
+		referralRepo:          referralRepo,
+		balanceAdjustmentRepo: balanceAdjustmentRepo,
+		publisher:             publisher,
+		logger:                logger.With("component", "user-deletion-service"),
+	}
+}
+
+// DeleteUser removes the user's data owned by this service and asks the other
+// services to do the same through the UserDeleted event. Calling it again for an
+// unfinished deletion repeats every step, all of them are idempotent.
+func (s *userDeletionService) DeleteUser(ctx context.Context, userId string) (*models.UserDeletion, *apperrors.AppError) {
+	deletion, err := s.userDeletionRepo.GetById(ctx, userId)
+	if err != nil && err.Code != apperrors.CodeNotFound {
+		return nil, err
+	}
+
+	if deletion == nil {
+		if _, err := s.userRepo.GetById(ctx, userId); err != nil {
+			return nil, err
+		}
+
+		deletion = &models.UserDeletion{
+			UserId:      userId,
+			Status:      models.UserDeletionInProgress,
+			Steps:       make(map[string]bool),
+			RequestedAt: time.Now().UTC(),
+		}
+		for _, step := range models.DeletionSteps() {
+			deletion.Steps[step] = false
+		}
+
+		if err := s.userDeletionRepo.Create(ctx, deletion); err != nil {
+			return nil, err
+		}
+	}
+
+	if deletion.Status == models.UserDeletionCompleted {
+		return deletion, nil
+	}
+
+	if err := s.purgeUserData(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	if err := s.publisher.PublishUserDeleted(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	if err := s.CompleteDeletionStep(ctx, userId, models.DeletionStepUserService); err != nil {
+		return nil, err
+	}
+
+	return s.userDeletionRepo.GetById(ctx, userId)
+}
+
+func (s *userDeletionService) GetDeletionStatus(ctx context.Context, userId string) (*models.UserDeletion, *apperrors.AppError) {
+	return s.userDeletionRepo.GetById(ctx, userId)
+}
+
+// CompleteDeletionStep records a service confirmation and closes the deletion once all confirmed
+func (s *userDeletionService) CompleteDeletionStep(ctx context.Context, userId, step string) *apperrors.AppError {
+	deletion, err := s.userDeletionRepo.CompleteStep(ctx, userId, step)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("User deletion step completed",
+		"user_id", userId,
+		"step", step,
+	)
+
+	if len(deletion.PendingSteps()) > 0 {
+		return nil
+	}
+
+	if err := s.userDeletionRepo.MarkCompleted(ctx, userId); err != nil {
+		return err
+	}
+
+	s.logger.Info("User deletion completed", "user_id", userId)
+	return nil
+}
+
+// IsUserDeleted reports whether a deletion was requested for the user. The
+// tracking item is kept as a tombstone, so this holds after completion too.
+func (s *userDeletionService) IsUserDeleted(ctx context.Context, userId string) (bool, *apperrors.AppError) {
+	_, err := s.userDeletionRepo.GetById(ctx, userId)
+	if err != nil && err.Code == apperrors.CodeNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Private methods
+
+func (s *userDeletionService) purgeUserData(ctx context.Context, userId string) *apperrors.AppError {
+	// The profile goes last, a retried purge still needs it to find the display name
+	user, err := s.userRepo.GetById(ctx, userId)
+	if err != nil && err.Code != apperrors.CodeNotFound {
+		return err
+	}
+
+	if user != nil {
+		if err := s.displayNameRepo.Delete(ctx, userId, user.DisplayName); err != nil {
+			return err
+		}
+
+		if err := s.referralRepo.DeleteByUser(ctx, userId, user.ReferralCode, user.ReferredBy); err != nil {
+			return err
+		}
+	}
+
+	if err := s.reservationRepo.DeleteByUser(ctx, userId); err != nil {
+		return err
+	}
+
+	if err := s.rewardClaimRepository.DeleteByUser(ctx, userId); err != nil {
+		return err
+	}
+
+	if err := s.coinLedgerRepo.DeleteByUser(ctx, userId); err != nil {
+		return err
+	}
+
+	if err := s.dailyStreakRepo.Delete(ctx, userId); err != nil {
+		return err
+	}
+
+	if err := s.achievementRepo.DeleteByUser(ctx, userId); err != nil {
+		return err
+	}
+
+	if err := s.friendRepo.DeleteByUser(ctx, userId); err != nil {
+		return err
+	}
+
+	if err := s.inboxRepo.DeleteByUser(ctx, userId); err != nil {
+		return err
+	}
+
+	if err := s.pushRepo.DeleteByUser(ctx, userId); err != nil {
+		return err
+	}
+
+	// Adjustments are the admin audit trail, they are kept without the reason
+	if err := s.balanceAdjustmentRepo.RedactByUser(ctx, userId); err != nil {
+		return err
+	}
+
+	return s.userRepo.Delete(ctx, userId)
+}
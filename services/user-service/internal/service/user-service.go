@@ -2,38 +2,119 @@ package service
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/burakmert236/goodswipe-common/database"
 	apperrors "github.com/burakmert236/goodswipe-common/errors"
 	"github.com/burakmert236/goodswipe-common/logger"
 	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-user-service/internal/dailyreward"
+	"github.com/burakmert236/goodswipe-user-service/internal/displayname"
 	usererrors "github.com/burakmert236/goodswipe-user-service/internal/errors"
 	"github.com/burakmert236/goodswipe-user-service/internal/events"
+	"github.com/burakmert236/goodswipe-user-service/internal/outbox"
+	"github.com/burakmert236/goodswipe-user-service/internal/progression"
+	"github.com/burakmert236/goodswipe-user-service/internal/referral"
 	"github.com/burakmert236/goodswipe-user-service/internal/repository"
 	"github.com/google/uuid"
 )
 
+const (
+	defaultCoinTransactionPageSize = 20
+	maxCoinTransactionPageSize     = 100
+	maxBatchUserIds                = 500
+
+	// Balance update is always the first item of a coin mutation transaction
+	coinMutationBalanceIndex = 0
+
+	// Profile writes are conditioned on the version that was read, a conflicting
+	// write re-reads the user and tries again
+	userUpdateMaxAttempts = 3
+
+	// Codes are random, a collision with an existing code draws a new one
+	referralCodeMaxAttempts = 3
+	referralDayLayout       = "2006-01-02"
+
+	displayNameClaimsMigration = "display-name-claims"
+)
+
+// coinMutation describes a balance change and the ledger entry recording it. The
+// optional event announces the change, it is written to the outbox together with
+// the balance.
+type coinMutation struct {
+	currency    models.Currency
+	amount      int
+	xp          int
+	reason      models.CoinTransactionReason
+	referenceId string
+	event       func(balanceAfter int) (*models.OutboxEvent, *apperrors.AppError)
+}
+
 type UserService interface {
-	CreateUser(ctx context.Context, displayName string) (*models.User, *apperrors.AppError)
+	CreateUser(ctx context.Context, displayName, referralCode, country string) (*models.User, *apperrors.AppError)
+	UpdateDisplayName(ctx context.Context, userId, displayName string) (*models.User, *apperrors.AppError)
+	UpdateCountry(ctx context.Context, userId, country string) (*models.User, *apperrors.AppError)
 	GetById(ctx context.Context, userId string) (*models.User, *apperrors.AppError)
-	UpdateProgress(ctx context.Context, userId string, levelIncrease int) (*models.User, *apperrors.AppError)
-	CollectTournamentReward(ctx context.Context, userId, tournamentId string, coin int) *apperrors.AppError
+	GetUsersByIds(ctx context.Context, userIds []string) ([]*models.User, []string, *apperrors.AppError)
+	UpdateProgress(ctx context.Context, userId string, xp int) (*models.User, *apperrors.AppError)
+	CollectTournamentReward(ctx context.Context, userId, tournamentId string, currency models.Currency, amount int) *apperrors.AppError
+	CollectSeasonReward(ctx context.Context, userId, seasonId string, coin int) *apperrors.AppError
+	CollectAchievementReward(ctx context.Context, userId, achievementId string, coin int) *apperrors.AppError
+	CollectReferralReward(ctx context.Context, userId, refereeId string, coin int) *apperrors.AppError
+	ListCoinTransactions(ctx context.Context, userId, pageToken string, pageSize int) ([]models.CoinTransaction, string, *apperrors.AppError)
+
+	// Admin methods
+	AdjustBalance(ctx context.Context, adjustment *models.BalanceAdjustment) (*BalanceAdjustmentResult, *apperrors.AppError)
+
+	// Daily reward methods
+	ClaimDailyReward(ctx context.Context, userId string) (*DailyRewardClaim, *apperrors.AppError)
+	PurchaseStreakFreeze(ctx context.Context, userId string) (*models.DailyStreak, *models.User, *apperrors.AppError)
 
 	// Reservation methods
-	ReserveCoins(ctx context.Context, userId string, amount int, tournamentId string) *apperrors.AppError
+	ReserveCoins(ctx context.Context, userId string, currency models.Currency, amount int, tournamentId string) *apperrors.AppError
 	ConfirmReservation(ctx context.Context, userId, tournamentId string) *apperrors.AppError
 	RollbackReservation(ctx context.Context, userId, tournamentId string) *apperrors.AppError
+
+	// Migration methods
+	BackfillDisplayNameClaims(ctx context.Context) *apperrors.AppError
+}
+
+// DailyRewardClaim is the result of a daily reward claim. AlreadyClaimed is set
+// when the reward of the day was claimed before, Claim then describes that claim.
+type DailyRewardClaim struct {
+	Claim          dailyreward.Claim
+	Streak         *models.DailyStreak
+	User           *models.User
+	AlreadyClaimed bool
+}
+
+// BalanceAdjustmentResult is the recorded adjustment with the balance after it.
+// AlreadyApplied is set when the request id was adjusted before, BalanceAfter
+// is then the current balance.
+type BalanceAdjustmentResult struct {
+	Adjustment     *models.BalanceAdjustment
+	BalanceAfter   int
+	AlreadyApplied bool
 }
 
 type userService struct {
 	userRepo              repository.UserRepository
 	reservationRepo       repository.ReservationRepository
 	rewardClaimRepository repository.RewardClaimRepository
+	coinLedgerRepo        repository.CoinLedgerRepository
+	displayNameRepo       repository.DisplayNameRepository
+	dailyStreakRepo       repository.DailyStreakRepository
+	referralRepo          repository.ReferralRepository
+	balanceAdjustmentRepo repository.BalanceAdjustmentRepository
+	migrationRepo         repository.MigrationRepository
+	outboxRepo            repository.OutboxRepository
 	transactionRepo       database.TransactionRepository
-	publisher             *events.EventPublisher
+	progression           *progression.Config
+	displayNamePolicy     *displayname.Policy
+	dailyReward           *dailyreward.Config
+	referral              *referral.Config
+	outbox                *outbox.Relay
 	logger                *logger.Logger
 }
 
@@ -41,37 +122,227 @@ func NewUserService(
 	userRepo repository.UserRepository,
 	reservationRepo repository.ReservationRepository,
 	rewardClaimRepository repository.RewardClaimRepository,
+	coinLedgerRepo repository.CoinLedgerRepository,
+	displayNameRepo repository.DisplayNameRepository,
+	dailyStreakRepo repository.DailyStreakRepository,
+	referralRepo repository.ReferralRepository,
+	balanceAdjustmentRepo repository.BalanceAdjustmentRepository,
+	migrationRepo repository.MigrationRepository,
+	outboxRepo repository.OutboxRepository,
 	transactionRepo database.TransactionRepository,
-	publisher *events.EventPublisher,
+	progression *progression.Config,
+	displayNamePolicy *displayname.Policy,
+	dailyReward *dailyreward.Config,
+	referral *referral.Config,
+	outbox *outbox.Relay,
 	logger *logger.Logger,
 ) UserService {
 	return &userService{
 		userRepo:              userRepo,
 		reservationRepo:       reservationRepo,
 		rewardClaimRepository: rewardClaimRepository,
+		coinLedgerRepo:        coinLedgerRepo,
+		displayNameRepo:       displayNameRepo,
+		dailyStreakRepo:       dailyStreakRepo,
+		referralRepo:          referralRepo,
+		balanceAdjustmentRepo: balanceAdjustmentRepo,
+		migrationRepo:         migrationRepo,
+		outboxRepo:            outboxRepo,
 		transactionRepo:       transactionRepo,
-		publisher:             publisher,
+		progression:           progression,
+		displayNamePolicy:     displayNamePolicy,
+		dailyReward:           dailyReward,
+		referral:              referral,
+		outbox:                outbox,
 		logger:                logger,
 	}
 }
 
-func (s *userService) CreateUser(ctx context.Context, displayName string) (*models.User, *apperrors.AppError) {
-	if displayName == "" {
-		return nil, apperrors.New(apperrors.CodeInvalidInput, "display name is required")
+// CreateUser creates the user with their own referral code. A referral code
+// of another user links the new user to them as a pending referral, which is
+// counted against the referrer's daily sign up limit. The country is optional.
+// UserCreated is written to the outbox with the user, so the request succeeds
+// once the user is committed.
+func (s *userService) CreateUser(ctx context.Context, displayName, referralCode, country string) (*models.User, *apperrors.AppError) {
+	displayName, err := s.displayNamePolicy.Validate(displayName)
+	if err != nil {
+		return nil, err
+	}
+
+	country, err = validateCountry(country)
+	if err != nil {
+		return nil, err
 	}
 
 	user := s.getDefaultUser()
 	user.DisplayName = displayName
+	user.Country = country
+
+	if referralCode != "" {
+		referrerId, err := s.referralRepo.GetUserIdByCode(ctx, referral.NormalizeCode(referralCode))
+		if err != nil {
+			return nil, err
+		}
+		if referrerId == "" {
+			return nil, usererrors.InvalidReferralCodeError()
+		}
+		user.ReferredBy = referrerId
+	}
 
-	if err := s.userRepo.Create(ctx, user); err != nil {
+	createdEvent, err := events.NewUserCreatedEvent(user.UserId, user.DisplayName, user.Country)
+	if err != nil {
 		return nil, err
 	}
 
+	for attempt := 0; attempt < referralCodeMaxAttempts; attempt++ {
+		code, err := referral.GenerateCode()
+		if err != nil {
+			return nil, err
+		}
+		user.ReferralCode = code
+
+		codeIndex, err := s.createUser(ctx, user, createdEvent)
+		if err == nil {
+			break
+		}
+
+		if !database.IsConditionalCheckFailed(err, codeIndex) || attempt == referralCodeMaxAttempts-1 {
+			return nil, err
+		}
+
+		s.logger.Warn("Referral code collided, retrying user creation",
+			"user_id", user.UserId,
+			"attempt", attempt+1,
+		)
+	}
+
 	s.logger.Info("User created: %s", user.UserId)
 
-	err := s.publisher.PublishUserCreated(ctx, user.UserId, user.DisplayName)
+	s.outbox.Publish(ctx, createdEvent)
 
-	return user, err
+	return user, nil
+}
+
+// UpdateDisplayName moves the user's name claim to the new name. Changes in letter
+// case only keep the existing claim since names are unique case-insensitively.
+func (s *userService) UpdateDisplayName(ctx context.Context, userId, displayName string) (*models.User, *apperrors.AppError) {
+	displayName, err := s.displayNamePolicy.Validate(displayName)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *models.User
+	var changedEvent *models.OutboxEvent
+
+	for attempt := 0; attempt < userUpdateMaxAttempts; attempt++ {
+		user, err = s.userRepo.GetById(ctx, userId)
+		if err != nil {
+			return nil, err
+		}
+
+		if displayName == user.DisplayName {
+			return user, nil
+		}
+
+		changedEvent, err = s.renameUser(ctx, user, displayName)
+		if err == nil {
+			break
+		}
+
+		if err.Code != apperrors.CodeConflict {
+			return nil, err
+		}
+
+		s.logger.Warn("User changed concurrently, retrying display name update",
+			"user_id", userId,
+			"attempt", attempt+1,
+		)
+	}
+
+	if err != nil {
+		return nil, usererrors.DisplayNameChangedConcurrentlyError()
+	}
+
+	user.DisplayName = displayName
+	user.Version++
+
+	s.outbox.Publish(ctx, changedEvent)
+
+	return user, nil
+}
+
+// UpdateCountry sets the country the user plays from, an empty country clears it
+func (s *userService) UpdateCountry(ctx context.Context, userId, country string) (*models.User, *apperrors.AppError) {
+	country, err := validateCountry(country)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *models.User
+	var changedEvent *models.OutboxEvent
+
+	for attempt := 0; attempt < userUpdateMaxAttempts; attempt++ {
+		user, err = s.userRepo.GetById(ctx, userId)
+		if err != nil {
+			return nil, err
+		}
+
+		if country == user.Country {
+			return user, nil
+		}
+
+		changedEvent, err = s.writeCountry(ctx, user, country)
+		if err == nil {
+			break
+		}
+
+		if err.Code != apperrors.CodeConflict {
+			return nil, err
+		}
+
+		s.logger.Warn("User changed concurrently, retrying country update",
+			"user_id", userId,
+			"attempt", attempt+1,
+		)
+	}
+
+	if err != nil {
+		return nil, usererrors.UserChangedConcurrentlyError()
+	}
+
+	user.Country = country
+	user.Version++
+
+	s.outbox.Publish(ctx, changedEvent)
+
+	return user, nil
+}
+
+// writeCountry stores the country together with its event, a user changed since
+// it was read fails with a conflict
+func (s *userService) writeCountry(ctx context.Context, user *models.User, country string) (*models.OutboxEvent, *apperrors.AppError) {
+	changedEvent, err := events.NewUserCountryChangedEvent(user.UserId, country, user.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	eventPutTransaction, err := s.outboxRepo.GetCreateTransaction(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionBuilder := database.NewTransactionBuilder()
+	transactionBuilder.AddUpdate(s.userRepo.GetCountryUpdateTransaction(ctx, user, country))
+	transactionBuilder.AddPut(eventPutTransaction)
+
+	if err := s.transactionRepo.Execute(ctx, transactionBuilder); err != nil {
+		if database.IsConditionalCheckFailed(err, 0) {
+			return nil, usererrors.UserChangedConcurrentlyError()
+		}
+		return nil, err
+	}
+
+	return changedEvent, nil
 }
 
 func (s *userService) GetById(ctx context.Context, userId string) (*models.User, *apperrors.AppError) {
@@ -83,14 +354,66 @@ func (s *userService) GetById(ctx context.Context, userId string) (*models.User,
 	return user, nil
 }
 
-func (s *userService) UpdateProgress(ctx context.Context, userId string, levelIncrease int) (*models.User, *apperrors.AppError) {
-	reward := levelIncrease * s.getCoinRewardPerLevelUpgrade()
-	user, err := s.userRepo.UpdateLevelProgress(ctx, userId, levelIncrease, reward)
+// GetUsersByIds returns the found users in the order of the first occurrence of
+// their id, and the ids that have no user
+func (s *userService) GetUsersByIds(ctx context.Context, userIds []string) ([]*models.User, []string, *apperrors.AppError) {
+	uniqueIds := make([]string, 0, len(userIds))
+	seen := make(map[string]bool, len(userIds))
+	for _, userId := range userIds {
+		if userId == "" || seen[userId] {
+			continue
+		}
+		seen[userId] = true
+		uniqueIds = append(uniqueIds, userId)
+	}
+
+	if len(uniqueIds) > maxBatchUserIds {
+		return nil, nil, apperrors.New(apperrors.CodeInvalidInput, fmt.Sprintf("at most %d user ids can be requested at once", maxBatchUserIds))
+	}
+
+	found, err := s.userRepo.GetByIds(ctx, uniqueIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usersById := make(map[string]*models.User, len(found))
+	for _, user := range found {
+		usersById[user.UserId] = user
+	}
+
+	users := make([]*models.User, 0, len(found))
+	missingIds := make([]string, 0)
+	for _, userId := range uniqueIds {
+		if user, exists := usersById[userId]; exists {
+			users = append(users, user)
+		} else {
+			missingIds = append(missingIds, userId)
+		}
+	}
+
+	return users, missingIds, nil
+}
+
+func (s *userService) UpdateProgress(ctx context.Context, userId string, xp int) (*models.User, *apperrors.AppError) {
+	user, err := s.userRepo.GetById(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.publisher.PublishUserLevelUp(ctx, userId, levelIncrease, user.Level); err != nil {
+	if err := checkModeration(user, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if xp <= 0 {
+		return user, nil
+	}
+
+	user, _, err = s.mutateCoins(ctx, userId, coinMutation{
+		currency: models.CurrencyCoin,
+		xp:       xp,
+		reason:   models.CoinReasonLevelUpReward,
+	}, nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -100,35 +423,311 @@ func (s *userService) UpdateProgress(ctx context.Context, userId string, levelIn
 func (s *userService) CollectTournamentReward(
 	ctx context.Context,
 	userId, tournamentId string,
+	currency models.Currency,
+	amount int,
+) *apperrors.AppError {
+	rewardClaim := &models.RewardClaim{
+		UserId:       userId,
+		TournamentId: tournamentId,
+	}
+
+	return s.collectReward(ctx, rewardClaim, models.TournamentPK(tournamentId), coinMutation{
+		currency:    currency,
+		amount:      amount,
+		reason:      models.CoinReasonTournamentReward,
+		referenceId: tournamentId,
+	})
+}
+
+func (s *userService) CollectSeasonReward(
+	ctx context.Context,
+	userId, seasonId string,
+	coin int,
+) *apperrors.AppError {
+	rewardClaim := &models.RewardClaim{
+		UserId:   userId,
+		SeasonId: seasonId,
+	}
+
+	return s.collectReward(ctx, rewardClaim, models.SeasonPK(seasonId), coinMutation{
+		currency:    models.CurrencyCoin,
+		amount:      coin,
+		reason:      models.CoinReasonSeasonReward,
+		referenceId: seasonId,
+	})
+}
+
+func (s *userService) CollectAchievementReward(
+	ctx context.Context,
+	userId, achievementId string,
+	coin int,
+) *apperrors.AppError {
+	rewardClaim := &models.RewardClaim{
+		UserId:        userId,
+		AchievementId: achievementId,
+	}
+
+	return s.collectReward(ctx, rewardClaim, models.AchievementClaimSK(achievementId), coinMutation{
+		currency:    models.CurrencyCoin,
+		amount:      coin,
+		reason:      models.CoinReasonAchievementReward,
+		referenceId: achievementId,
+	})
+}
+
+// CollectReferralReward credits the reward of a completed referral, referrer and
+// referee both claim it under the referee's id
+func (s *userService) CollectReferralReward(
+	ctx context.Context,
+	userId, refereeId string,
 	coin int,
 ) *apperrors.AppError {
-	rewardClaim, err := s.rewardClaimRepository.GetByIdempotency(ctx, userId, tournamentId)
+	rewardClaim := &models.RewardClaim{
+		UserId:    userId,
+		RefereeId: refereeId,
+	}
+
+	return s.collectReward(ctx, rewardClaim, models.ReferralClaimSK(refereeId), coinMutation{
+		currency:    models.CurrencyCoin,
+		amount:      coin,
+		reason:      models.CoinReasonReferralReward,
+		referenceId: refereeId,
+	})
+}
+
+func (s *userService) ListCoinTransactions(
+	ctx context.Context,
+	userId, pageToken string,
+	pageSize int,
+) ([]models.CoinTransaction, string, *apperrors.AppError) {
+	if pageSize <= 0 {
+		pageSize = defaultCoinTransactionPageSize
+	}
+	if pageSize > maxCoinTransactionPageSize {
+		pageSize = maxCoinTransactionPageSize
+	}
+
+	return s.coinLedgerRepo.ListByUser(ctx, userId, pageToken, pageSize)
+}
+
+// Admin methods
+
+// AdjustBalance grants or revokes currency on behalf of support staff. The audit
+// record and UserBalanceAdjusted are written in the same transaction as the
+// balance, the record keyed by the request id, so a repeated request is applied
+// and announced once. Revokes never drive the balance negative.
+func (s *userService) AdjustBalance(
+	ctx context.Context,
+	adjustment *models.BalanceAdjustment,
+) (*BalanceAdjustmentResult, *apperrors.AppError) {
+	if err := validateBalanceAdjustment(adjustment); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.balanceAdjustmentRepo.GetByRequestId(ctx, adjustment.UserId, adjustment.RequestId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if rewardClaim != nil {
-		return nil
+	if existing != nil {
+		return s.getAppliedBalanceAdjustment(ctx, adjustment, existing)
 	}
 
-	addCoinErr := s.userRepo.AddCoin(ctx, userId, coin)
-	if addCoinErr != nil {
-		if err := s.rewardClaimRepository.Delete(ctx, userId, tournamentId); err != nil {
-			return err
+	adjustmentPutTransaction, err := s.balanceAdjustmentRepo.GetCreateTransaction(ctx, adjustment)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := models.CoinReasonAdminGrant
+	if adjustment.Type == models.BalanceAdjustmentTypeRevoke {
+		reason = models.CoinReasonAdminRevoke
+	}
+
+	adjustmentIndex := -1
+	user, _, err := s.mutateCoins(ctx, adjustment.UserId, coinMutation{
+		currency:    adjustment.Currency,
+		amount:      adjustment.Delta(),
+		reason:      reason,
+		referenceId: adjustment.RequestId,
+		event: func(balanceAfter int) (*models.OutboxEvent, *apperrors.AppError) {
+			return events.NewUserBalanceAdjustedEvent(adjustment, balanceAfter)
+		},
+	}, func(transactionBuilder *database.TransactionBuilder) {
+		adjustmentIndex = transactionBuilder.Count()
+		transactionBuilder.AddPut(adjustmentPutTransaction)
+	})
+
+	// Applied concurrently by a request with the same id
+	if database.IsConditionalCheckFailed(err, adjustmentIndex) {
+		existing, getErr := s.balanceAdjustmentRepo.GetByRequestId(ctx, adjustment.UserId, adjustment.RequestId)
+		if getErr != nil {
+			return nil, getErr
 		}
-		return addCoinErr
+		return s.getAppliedBalanceAdjustment(ctx, adjustment, existing)
 	}
 
-	if err = s.rewardClaimRepository.Create(ctx, userId, tournamentId); err != nil {
-		return err
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	balanceAfter := user.Balance(adjustment.Currency)
+
+	s.logger.Info("Balance adjusted",
+		"user_id", adjustment.UserId,
+		"request_id", adjustment.RequestId,
+		"type", adjustment.Type,
+		"currency", adjustment.Currency,
+		"amount", adjustment.Amount,
+		"operator_id", adjustment.OperatorId,
+		"ticket_reference", adjustment.TicketReference,
+	)
+
+	return &BalanceAdjustmentResult{
+		Adjustment:   adjustment,
+		BalanceAfter: balanceAfter,
+	}, nil
+}
+
+// Daily reward methods
+
+// ClaimDailyReward pays the reward of the current day and continues the login
+// streak. The day is recorded as a reward claim in the same transaction, so a
+// day is paid once and repeated claims return the recorded claim.
+func (s *userService) ClaimDailyReward(ctx context.Context, userId string) (*DailyRewardClaim, *apperrors.AppError) {
+	previous, err := s.dailyStreakRepo.GetByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	day := s.dailyReward.Day(time.Now())
+	claim := s.dailyReward.Next(previous, day)
+	if claim.AlreadyClaimed {
+		return s.getClaimedDailyReward(ctx, userId, previous)
+	}
+
+	streak := &models.DailyStreak{
+		UserId:       userId,
+		Streak:       claim.Streak,
+		LastClaimDay: day,
+	}
+	if previous != nil {
+		streak.Freezes = previous.Freezes - claim.FreezesUsed
+	}
+
+	streakPutTransaction, err := s.dailyStreakRepo.GetPutTransaction(ctx, streak, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardClaimPutTransaction, err := s.rewardClaimRepository.GetCreateTransaction(ctx, &models.RewardClaim{
+		UserId: userId,
+		Day:    day,
+	}, models.DailyRewardClaimSK(day))
+	if err != nil {
+		return nil, err
+	}
+
+	rewardClaimIndex, streakIndex := -1, -1
+	user, _, err := s.mutateCoins(ctx, userId, coinMutation{
+		currency:    models.CurrencyCoin,
+		amount:      claim.Reward,
+		reason:      models.CoinReasonDailyReward,
+		referenceId: models.DailyRewardClaimSK(day),
+	}, func(transactionBuilder *database.TransactionBuilder) {
+		rewardClaimIndex = transactionBuilder.Count()
+		transactionBuilder.AddPut(rewardClaimPutTransaction)
+		streakIndex = transactionBuilder.Count()
+		transactionBuilder.AddPut(streakPutTransaction)
+	})
+
+	// Claimed concurrently by another request
+	if database.IsConditionalCheckFailed(err, rewardClaimIndex) {
+		current, getErr := s.dailyStreakRepo.GetByUser(ctx, userId)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if current == nil {
+			return nil, usererrors.DailyStreakChangedConcurrentlyError()
+		}
+		return s.getClaimedDailyReward(ctx, userId, current)
+	}
+
+	if database.IsConditionalCheckFailed(err, streakIndex) {
+		return nil, usererrors.DailyStreakChangedConcurrentlyError()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Daily reward claimed",
+		"user_id", userId,
+		"day", day,
+		"streak", claim.Streak,
+		"freezes_used", claim.FreezesUsed,
+		"reward", claim.Reward,
+	)
+
+	return &DailyRewardClaim{
+		Claim:  claim,
+		Streak: streak,
+		User:   user,
+	}, nil
+}
+
+// PurchaseStreakFreeze buys a streak freeze with coins, a freeze covers one
+// missed day of the login streak
+func (s *userService) PurchaseStreakFreeze(ctx context.Context, userId string) (*models.DailyStreak, *models.User, *apperrors.AppError) {
+	previous, err := s.dailyStreakRepo.GetByUser(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	streak := models.DailyStreak{UserId: userId}
+	if previous != nil {
+		streak = *previous
+	}
+
+	if streak.Freezes >= s.dailyReward.MaxStreakFreezes {
+		return nil, nil, usererrors.StreakFreezeLimitError(s.dailyReward.MaxStreakFreezes)
+	}
+	streak.Freezes++
+
+	streakPutTransaction, err := s.dailyStreakRepo.GetPutTransaction(ctx, &streak, previous)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	streakIndex := -1
+	user, _, err := s.mutateCoins(ctx, userId, coinMutation{
+		currency: models.CurrencyCoin,
+		amount:   -s.dailyReward.StreakFreezePrice,
+		reason:   models.CoinReasonStreakFreezePurchase,
+	}, func(transactionBuilder *database.TransactionBuilder) {
+		streakIndex = transactionBuilder.Count()
+		transactionBuilder.AddPut(streakPutTransaction)
+	})
+
+	if database.IsConditionalCheckFailed(err, streakIndex) {
+		return nil, nil, usererrors.DailyStreakChangedConcurrentlyError()
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &streak, user, nil
 }
 
 // Reservation methods
 
-func (s *userService) ReserveCoins(ctx context.Context, userId string, amount int, tournamentId string) *apperrors.AppError {
+func (s *userService) ReserveCoins(
+	ctx context.Context,
+	userId string,
+	currency models.Currency,
+	amount int,
+	tournamentId string,
+) *apperrors.AppError {
 	existing, err := s.reservationRepo.GetById(ctx, userId, tournamentId)
 	if existing != nil {
 		if existing.Status == models.ReservationStatusConfirmed || existing.Status == models.ReservationStatusReserved {
@@ -136,40 +735,29 @@ func (s *userService) ReserveCoins(ctx context.Context, userId string, amount in
 		}
 	}
 
-	reservation := s.getDefaultReservation(userId, tournamentId, amount)
+	reservation := s.getDefaultReservation(userId, tournamentId, currency, amount)
 	reservationItemPutTransaction, err := s.reservationRepo.GetCreateTransaction(ctx, &reservation)
 	if err != nil {
 		return err
 	}
 
-	userCoinDeductionTransaction := s.userRepo.GetCoinDeductionTransaction(ctx, userId, amount)
+	reservationIndex := -1
+	_, _, err = s.mutateCoins(ctx, userId, coinMutation{
+		currency:    currency,
+		amount:      -amount,
+		reason:      models.CoinReasonTournamentEntryFee,
+		referenceId: tournamentId,
+	}, func(transactionBuilder *database.TransactionBuilder) {
+		reservationIndex = transactionBuilder.Count()
+		transactionBuilder.AddPut(reservationItemPutTransaction)
+	})
 
-	transactionBuilder := database.NewTransactionBuilder()
-	transactionBuilder.AddUpdate(userCoinDeductionTransaction)
-	transactionBuilder.AddPut(reservationItemPutTransaction)
-
-	transactionErr := s.transactionRepo.Execute(ctx, transactionBuilder)
-
-	if transactionErr != nil {
-		if transactionErr.Err != nil {
-			var txErr *types.TransactionCanceledException
-			if errors.As(transactionErr.Err, &txErr) {
-				for i, reason := range txErr.CancellationReasons {
-					switch i {
-					case 0:
-						if reason.Code != nil && *reason.Code == "ConditionalCheckFailed" {
-							return usererrors.WrapInsufficientCoinError(txErr)
-						}
-					case 1:
-						return nil
-					}
-				}
-			}
-		}
-		return transactionErr
+	// Reservation already exists, coins were reserved by an earlier attempt
+	if database.IsConditionalCheckFailed(err, reservationIndex) {
+		return nil
 	}
 
-	return nil
+	return err
 }
 
 func (s *userService) ConfirmReservation(ctx context.Context, userId, tournamentId string) *apperrors.AppError {
@@ -193,20 +781,423 @@ func (s *userService) RollbackReservation(ctx context.Context, userId, tournamen
 		return usererrors.CoinReservationRollbackError()
 	}
 
-	coinAdditionTransaction := s.userRepo.GetCoinAdditionTransaction(ctx, reservation.UserId, int(reservation.Amount))
 	updateReservationStatusTransaction := s.reservationRepo.GetUpdateStatusTransaction(ctx, userId, tournamentId, models.ReservationStatusRolledBack)
 
-	transactionBuilder := database.NewTransactionBuilder()
-	transactionBuilder.AddUpdate(coinAdditionTransaction)
-	transactionBuilder.AddUpdate(updateReservationStatusTransaction)
+	currency := models.CurrencyOrDefault(string(reservation.Currency))
+	_, _, err = s.mutateCoins(ctx, reservation.UserId, coinMutation{
+		currency:    currency,
+		amount:      int(reservation.Amount),
+		reason:      models.CoinReasonTournamentEntryRefund,
+		referenceId: tournamentId,
+		event: func(balanceAfter int) (*models.OutboxEvent, *apperrors.AppError) {
+			return events.NewUserReservationRolledBackEvent(userId, tournamentId, currency, int(reservation.Amount))
+		},
+	}, func(transactionBuilder *database.TransactionBuilder) {
+		transactionBuilder.AddUpdate(updateReservationStatusTransaction)
+	})
+
+	return err
+}
+
+// Migration methods
+
+// BackfillDisplayNameClaims claims the names of users created before names were
+// unique, once. A name that two legacy users share stays with whoever is claimed
+// first, the other keeps their name without a claim until they rename.
+func (s *userService) BackfillDisplayNameClaims(ctx context.Context) *apperrors.AppError {
+	completed, err := s.migrationRepo.IsCompleted(ctx, displayNameClaimsMigration)
+	if err != nil {
+		return err
+	}
+	if completed {
+		return nil
+	}
+
+	claimed, shared := 0, 0
+	err = s.userRepo.ForEach(ctx, func(user *models.User) *apperrors.AppError {
+		ok, err := s.displayNameRepo.Claim(ctx, user.UserId, user.DisplayName)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			shared++
+			s.logger.Warn("Display name of legacy user is held by another user",
+				"user_id", user.UserId,
+				"display_name", user.DisplayName,
+			)
+			return nil
+		}
+
+		claimed++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.migrationRepo.MarkCompleted(ctx, displayNameClaimsMigration); err != nil {
+		return err
+	}
 
-	return s.transactionRepo.Execute(ctx, transactionBuilder)
+	s.logger.Info("Display name claims backfilled",
+		"claimed", claimed,
+		"shared", shared,
+	)
+	return nil
 }
 
 // Private methods
 
-func (s *userService) getCoinRewardPerLevelUpgrade() int {
-	return 100
+// createUser writes the user with its initial grant, name claim, referral code
+// claim and created event, and the referral when the user was referred. The index
+// of the code claim is returned so a colliding code can be told apart from other
+// failures.
+func (s *userService) createUser(ctx context.Context, user *models.User, createdEvent *models.OutboxEvent) (int, *apperrors.AppError) {
+	userPutTransaction, err := s.userRepo.GetCreateTransaction(ctx, user)
+	if err != nil {
+		return -1, err
+	}
+
+	ledgerPutTransaction, err := s.coinLedgerRepo.GetCreateTransaction(ctx, &models.CoinTransaction{
+		UserId:       user.UserId,
+		Currency:     models.CurrencyCoin,
+		Amount:       user.Coin,
+		BalanceAfter: user.Coin,
+		Reason:       models.CoinReasonInitialGrant,
+		ReferenceId:  user.UserId,
+		CreatedAt:    user.CreatedAt,
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	displayNamePutTransaction, err := s.displayNameRepo.GetCreateTransaction(ctx, user.UserId, user.DisplayName)
+	if err != nil {
+		return -1, err
+	}
+
+	codePutTransaction, err := s.referralRepo.GetCodeCreateTransaction(ctx, user.UserId, user.ReferralCode)
+	if err != nil {
+		return -1, err
+	}
+
+	eventPutTransaction, err := s.outboxRepo.GetCreateTransaction(ctx, createdEvent)
+	if err != nil {
+		return -1, err
+	}
+
+	transactionBuilder := database.NewTransactionBuilder()
+	transactionBuilder.AddPut(userPutTransaction)
+	transactionBuilder.AddPut(ledgerPutTransaction)
+	displayNameIndex := transactionBuilder.Count()
+	transactionBuilder.AddPut(displayNamePutTransaction)
+	codeIndex := transactionBuilder.Count()
+	transactionBuilder.AddPut(codePutTransaction)
+	transactionBuilder.AddPut(eventPutTransaction)
+
+	signupIndex := -1
+	if user.ReferredBy != "" {
+		referralPutTransaction, err := s.referralRepo.GetReferralCreateTransaction(ctx, user.ReferredBy, user.UserId)
+		if err != nil {
+			return -1, err
+		}
+		transactionBuilder.AddPut(referralPutTransaction)
+
+		now := time.Now().UTC()
+		signupIndex = transactionBuilder.Count()
+		transactionBuilder.AddUpdate(s.referralRepo.GetSignupCountTransaction(
+			ctx,
+			user.ReferredBy,
+			now.Format(referralDayLayout),
+			s.referral.MaxSignupsPerDay,
+			now.Add(48*time.Hour),
+		))
+	}
+
+	if err := s.transactionRepo.Execute(ctx, transactionBuilder); err != nil {
+		switch {
+		case database.IsConditionalCheckFailed(err, displayNameIndex):
+			return codeIndex, usererrors.DisplayNameTakenError()
+		case database.IsConditionalCheckFailed(err, signupIndex):
+			return codeIndex, usererrors.ReferralSignupLimitError()
+		}
+		return codeIndex, err
+	}
+
+	return codeIndex, nil
+}
+
+// renameUser writes the new name and its event and moves the name claim, a user
+// changed since it was read fails with a conflict
+func (s *userService) renameUser(ctx context.Context, user *models.User, displayName string) (*models.OutboxEvent, *apperrors.AppError) {
+	changedEvent, err := events.NewUserDisplayNameChangedEvent(user.UserId, displayName, user.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+
+	eventPutTransaction, err := s.outboxRepo.GetCreateTransaction(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionBuilder := database.NewTransactionBuilder()
+	transactionBuilder.AddUpdate(s.userRepo.GetDisplayNameUpdateTransaction(ctx, user, displayName))
+	transactionBuilder.AddPut(eventPutTransaction)
+
+	displayNameIndex := -1
+	if models.NormalizeDisplayName(displayName) != models.NormalizeDisplayName(user.DisplayName) {
+		displayNamePutTransaction, err := s.displayNameRepo.GetCreateTransaction(ctx, user.UserId, displayName)
+		if err != nil {
+			return nil, err
+		}
+
+		displayNameIndex = transactionBuilder.Count()
+		transactionBuilder.AddPut(displayNamePutTransaction)
+		transactionBuilder.AddDelete(s.displayNameRepo.GetDeleteTransaction(ctx, user.UserId, user.DisplayName))
+	}
+
+	if err := s.transactionRepo.Execute(ctx, transactionBuilder); err != nil {
+		switch {
+		case database.IsConditionalCheckFailed(err, displayNameIndex):
+			return nil, usererrors.DisplayNameTakenError()
+		case database.IsConditionalCheckFailed(err, 0):
+			return nil, usererrors.UserChangedConcurrentlyError()
+		}
+		return nil, err
+	}
+
+	return changedEvent, nil
+}
+
+// collectReward credits coin once per claim key, the claim item is written in the
+// same transaction as the balance change so repeated calls are no-ops
+func (s *userService) collectReward(
+	ctx context.Context,
+	rewardClaim *models.RewardClaim,
+	claimKey string,
+	mutation coinMutation,
+) *apperrors.AppError {
+	existingClaim, err := s.rewardClaimRepository.GetByIdempotency(ctx, rewardClaim.UserId, claimKey)
+	if err != nil {
+		return err
+	}
+
+	if existingClaim != nil {
+		return nil
+	}
+
+	rewardClaimPutTransaction, err := s.rewardClaimRepository.GetCreateTransaction(ctx, rewardClaim, claimKey)
+	if err != nil {
+		return err
+	}
+
+	rewardClaimIndex := -1
+	_, _, err = s.mutateCoins(ctx, rewardClaim.UserId, mutation, func(transactionBuilder *database.TransactionBuilder) {
+		rewardClaimIndex = transactionBuilder.Count()
+		transactionBuilder.AddPut(rewardClaimPutTransaction)
+	})
+
+	// Claimed concurrently by another request
+	if database.IsConditionalCheckFailed(err, rewardClaimIndex) {
+		return nil
+	}
+
+	return err
+}
+
+// mutateCoins applies the mutation against the current balance and writes the ledger
+// entry in the same transaction. The balance update is conditioned on the version
+// that was read, so a concurrent change makes the attempt fail and it is retried.
+// XP is applied the same way, level-up rewards are credited alongside and every
+// level-up is written to the outbox with the event of the mutation.
+func (s *userService) mutateCoins(
+	ctx context.Context,
+	userId string,
+	mutation coinMutation,
+	extend func(transactionBuilder *database.TransactionBuilder),
+) (*models.User, []progression.LevelUp, *apperrors.AppError) {
+	var err *apperrors.AppError
+
+	for attempt := 0; attempt < userUpdateMaxAttempts; attempt++ {
+		user, getErr := s.userRepo.GetById(ctx, userId)
+		if getErr != nil {
+			return nil, nil, getErr
+		}
+
+		amount, progress := mutation.amount, user.Progress()
+		var levelUps []progression.LevelUp
+		if mutation.xp > 0 {
+			result := s.progression.Apply(progress, mutation.xp)
+			progress, levelUps = result.Progress, result.LevelUps
+			amount += result.TotalReward()
+		}
+
+		balanceAfter := user.Balance(mutation.currency) + amount
+		if balanceAfter < 0 {
+			return nil, nil, usererrors.InsufficientBalanceError(mutation.currency)
+		}
+
+		transactionBuilder := database.NewTransactionBuilder()
+		transactionBuilder.AddUpdate(s.userRepo.GetBalanceUpdateTransaction(ctx, user, mutation.currency, amount, progress))
+
+		// XP without a level-up leaves the balance untouched, nothing to record
+		if amount != 0 || mutation.xp == 0 {
+			referenceId := mutation.referenceId
+			if referenceId == "" && len(levelUps) > 0 {
+				referenceId = fmt.Sprintf("LEVEL#%d", progress.Level)
+			}
+
+			ledgerPutTransaction, ledgerErr := s.coinLedgerRepo.GetCreateTransaction(ctx, &models.CoinTransaction{
+				UserId:       userId,
+				Currency:     mutation.currency,
+				Amount:       amount,
+				BalanceAfter: balanceAfter,
+				Reason:       mutation.reason,
+				ReferenceId:  referenceId,
+				CreatedAt:    time.Now().UTC(),
+			})
+			if ledgerErr != nil {
+				return nil, nil, ledgerErr
+			}
+			transactionBuilder.AddPut(ledgerPutTransaction)
+		}
+
+		outboxEvents := make([]*models.OutboxEvent, 0, len(levelUps)+1)
+		for _, levelUp := range levelUps {
+			// A prestige reset is announced with the new level but gains no levels
+			levelIncrease := 1
+			if levelUp.Reset {
+				levelIncrease = 0
+			}
+
+			levelUpEvent, eventErr := events.NewUserLevelUpEvent(userId, levelIncrease, levelUp.Level, levelUp.Prestige)
+			if eventErr != nil {
+				return nil, nil, eventErr
+			}
+			outboxEvents = append(outboxEvents, levelUpEvent)
+		}
+
+		if mutation.event != nil {
+			mutationEvent, eventErr := mutation.event(balanceAfter)
+			if eventErr != nil {
+				return nil, nil, eventErr
+			}
+			outboxEvents = append(outboxEvents, mutationEvent)
+		}
+
+		for _, outboxEvent := range outboxEvents {
+			eventPutTransaction, eventErr := s.outboxRepo.GetCreateTransaction(ctx, outboxEvent)
+			if eventErr != nil {
+				return nil, nil, eventErr
+			}
+			transactionBuilder.AddPut(eventPutTransaction)
+		}
+
+		if extend != nil {
+			extend(transactionBuilder)
+		}
+
+		err = s.transactionRepo.Execute(ctx, transactionBuilder)
+		if err == nil {
+			user.SetBalance(mutation.currency, balanceAfter)
+			user.SetProgress(progress)
+			user.Version++
+			s.outbox.Publish(ctx, outboxEvents...)
+			return user, levelUps, nil
+		}
+
+		if !database.IsConditionalCheckFailed(err, coinMutationBalanceIndex) {
+			return nil, nil, err
+		}
+
+		s.logger.Warn("User changed concurrently, retrying coin mutation",
+			"user_id", userId,
+			"attempt", attempt+1,
+		)
+	}
+
+	return nil, nil, usererrors.UserChangedConcurrentlyError()
+}
+
+// getAppliedBalanceAdjustment answers a repeated adjustment request with the
+// recorded adjustment, a request id reused for another adjustment is rejected
+func (s *userService) getAppliedBalanceAdjustment(
+	ctx context.Context,
+	requested, applied *models.BalanceAdjustment,
+) (*BalanceAdjustmentResult, *apperrors.AppError) {
+	if !applied.Matches(requested) {
+		return nil, usererrors.BalanceAdjustmentConflictError(requested.RequestId)
+	}
+
+	user, err := s.userRepo.GetById(ctx, applied.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BalanceAdjustmentResult{
+		Adjustment:     applied,
+		BalanceAfter:   user.Balance(applied.Currency),
+		AlreadyApplied: true,
+	}, nil
+}
+
+func (s *userService) getClaimedDailyReward(
+	ctx context.Context,
+	userId string,
+	streak *models.DailyStreak,
+) (*DailyRewardClaim, *apperrors.AppError) {
+	user, err := s.userRepo.GetById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DailyRewardClaim{
+		Claim: dailyreward.Claim{
+			Day:    streak.LastClaimDay,
+			Streak: streak.Streak,
+			Reward: s.dailyReward.RewardFor(streak.Streak),
+		},
+		Streak:         streak,
+		User:           user,
+		AlreadyClaimed: true,
+	}, nil
+}
+
+// checkModeration rejects users who are suspended or banned
+func checkModeration(user *models.User, now time.Time) *apperrors.AppError {
+	switch user.ModerationStatus(now) {
+	case models.ModerationStatusSuspended:
+		return usererrors.UserSuspendedError(*user.Moderation.SuspendedUntil)
+	case models.ModerationStatusBanned:
+		return usererrors.UserBannedError()
+	}
+	return nil
+}
+
+// validateCountry normalizes an optional country code, empty stays empty
+func validateCountry(country string) (string, *apperrors.AppError) {
+	country = models.NormalizeCountry(country)
+	if country != "" && !models.IsValidCountry(country) {
+		return "", usererrors.InvalidCountryError(country)
+	}
+	return country, nil
+}
+
+func validateBalanceAdjustment(adjustment *models.BalanceAdjustment) *apperrors.AppError {
+	switch {
+	case adjustment.Amount <= 0:
+		return apperrors.New(apperrors.CodeInvalidInput, "amount must be positive")
+	case adjustment.OperatorId == "":
+		return apperrors.New(apperrors.CodeInvalidInput, "operator id is required")
+	case adjustment.Reason == "":
+		return apperrors.New(apperrors.CodeInvalidInput, "reason is required")
+	case adjustment.TicketReference == "":
+		return apperrors.New(apperrors.CodeInvalidInput, "ticket reference is required")
+	case adjustment.RequestId == "":
+		return apperrors.New(apperrors.CodeInvalidInput, "request id is required")
+	}
+	return nil
 }
 
 func (s *userService) getDefaultUser() *models.User {
@@ -217,12 +1208,17 @@ func (s *userService) getDefaultUser() *models.User {
 	}
 }
 
-func (s *userService) getDefaultReservation(userId, tournamentId string, amount int) models.Reservation {
+func (s *userService) getDefaultReservation(
+	userId, tournamentId string,
+	currency models.Currency,
+	amount int,
+) models.Reservation {
 	now := time.Now().UTC()
 
 	reservation := &models.Reservation{
 		UserId:       userId,
 		TournamentId: tournamentId,
+		Currency:     currency,
 		Amount:       int64(amount),
 		Status:       models.ReservationStatusReserved,
 		Purpose:      "TOURNAMENT_ENTRY",
@@ -0,0 +1,348 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/burakmert236/goodswipe-common/config"
+	"github.com/burakmert236/goodswipe-common/database"
+	apperrors "github.com/burakmert236/goodswipe-common/errors"
+	commonevents "github.com/burakmert236/goodswipe-common/events"
+	protogrpc "github.com/burakmert236/goodswipe-common/generated/v1/grpc"
+	"github.com/burakmert236/goodswipe-common/idempotency"
+	"github.com/burakmert236/goodswipe-common/logger"
+	"github.com/burakmert236/goodswipe-common/models"
+	"github.com/burakmert236/goodswipe-user-service/internal/dailyreward"
+	"github.com/burakmert236/goodswipe-user-service/internal/displayname"
+	"github.com/burakmert236/goodswipe-user-service/internal/handler"
+	"github.com/burakmert236/goodswipe-user-service/internal/outbox"
+	"github.com/burakmert236/goodswipe-user-service/internal/progression"
+	"github.com/burakmert236/goodswipe-user-service/internal/referral"
+	"github.com/burakmert236/goodswipe-user-service/internal/repository"
+	"github.com/burakmert236/goodswipe-user-service/internal/service"
+)
+
+// A request whose write commits while its events fail to publish succeeds, so
+// the idempotency interceptor stores the response and a retry with the same key
+// replays it instead of writing again. The events stay in the outbox until the
+// relay publishes them.
+func TestCommittedRequestIsReplayedWhenPublishFails(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		request    proto.Message
+		call       func(h *handler.UserHandler, ctx context.Context, req any) (any, error)
+		wantEvents []string
+	}{
+		{
+			name:   "create user",
+			method: protogrpc.UserService_CreateUser_FullMethodName,
+			request: &protogrpc.CreateUserRequest{
+				DisplayName: "Swiper",
+			},
+			call: func(h *handler.UserHandler, ctx context.Context, req any) (any, error) {
+				return h.CreateUser(ctx, req.(*protogrpc.CreateUserRequest))
+			},
+			wantEvents: []string{commonevents.UserCreated},
+		},
+		{
+			name:   "update progress",
+			method: protogrpc.UserService_UpdateProgress_FullMethodName,
+			request: &protogrpc.UpdateProgressRequest{
+				UserId:         "user-1",
+				ProgressAmount: 300,
+			},
+			call: func(h *handler.UserHandler, ctx context.Context, req any) (any, error) {
+				return h.UpdateProgress(ctx, req.(*protogrpc.UpdateProgressRequest))
+			},
+			wantEvents: []string{commonevents.UserLevelUp, commonevents.UserLevelUp},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logger.Development("user-service-test")
+			table := newFakeTable()
+			table.users["user-1"] = &models.User{UserId: "user-1", DisplayName: "Player", Level: 1, Coin: 1000, Version: 1}
+
+			outboxRepo := &fakeOutboxRepo{table: table}
+			publisher := &fakePublisher{err: apperrors.New(apperrors.CodeEventPublishError, "nats is down")}
+			relay := outbox.NewRelay(outboxRepo, publisher, log)
+
+			dailyReward, err := dailyreward.FromConfig(config.DailyRewardConfig{})
+			if err != nil {
+				t.Fatalf("daily reward config: %v", err)
+			}
+			referralConfig, err := referral.FromConfig(config.ReferralsConfig{})
+			if err != nil {
+				t.Fatalf("referral config: %v", err)
+			}
+
+			userService := service.NewUserService(
+				&fakeUserRepo{table: table},
+				nil,
+				nil,
+				fakeCoinLedgerRepo{},
+				fakeDisplayNameRepo{},
+				nil,
+				fakeReferralRepo{},
+				nil,
+				nil,
+				outboxRepo,
+				&fakeTransactionRepo{table: table},
+				progression.DefaultConfig(),
+				displayname.FromConfig(config.DisplayNameConfig{}),
+				dailyReward,
+				referralConfig,
+				relay,
+				log,
+			)
+			userHandler := handler.NewUserHandler(userService, nil, nil, nil, nil, nil, nil, nil, log)
+
+			interceptor := idempotency.UnaryServerInterceptor(newFakeIdempotencyStore(), log, time.Hour, tt.method)
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotency.IdempotencyKeyHeader, "key-1"))
+			call := func(ctx context.Context, req any) (any, error) {
+				return tt.call(userHandler, ctx, req)
+			}
+
+			first, callErr := interceptor(ctx, tt.request, info, call)
+			if callErr != nil {
+				t.Fatalf("first call failed although the write committed: %v", callErr)
+			}
+
+			retry, callErr := interceptor(ctx, tt.request, info, call)
+			if callErr != nil {
+				t.Fatalf("retry failed: %v", callErr)
+			}
+
+			if !proto.Equal(first.(proto.Message), retry.(proto.Message)) {
+				t.Errorf("retry = %v, want the stored response %v", retry, first)
+			}
+			if table.commits != 1 {
+				t.Errorf("committed %d times, want 1", table.commits)
+			}
+			if len(publisher.published) != 0 {
+				t.Fatalf("published %d events while publishing fails", len(publisher.published))
+			}
+			if got := subjects(table.outbox); !equalStrings(got, tt.wantEvents) {
+				t.Fatalf("outbox = %v, want %v", got, tt.wantEvents)
+			}
+
+			// The relay publishes what is left once the stream is back
+			publisher.err = nil
+			table.age(time.Minute)
+			published, err := relay.Sweep(context.Background())
+			if err != nil {
+				t.Fatalf("sweep: %v", err)
+			}
+
+			if published != len(tt.wantEvents) || !equalStrings(subjects(publisher.published), tt.wantEvents) {
+				t.Errorf("published %v, want %v", subjects(publisher.published), tt.wantEvents)
+			}
+			if len(table.outbox) != 0 {
+				t.Errorf("%d events left in the outbox after they were published", len(table.outbox))
+			}
+		})
+	}
+}
+
+func subjects(events []*models.OutboxEvent) []string {
+	result := make([]string, 0, len(events))
+	for _, event := range events {
+		result = append(result, event.Subject)
+	}
+	return result
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// fakeTable stands in for DynamoDB. The fake repositories stage their writes and
+// the transaction repository applies everything staged on execute.
+type fakeTable struct {
+	users   map[string]*models.User
+	outbox  []*models.OutboxEvent
+	staged  []func()
+	commits int
+}
+
+func newFakeTable() *fakeTable {
+	return &fakeTable{users: make(map[string]*models.User)}
+}
+
+func (t *fakeTable) stage(write func()) {
+	t.staged = append(t.staged, write)
+}
+
+// age moves the outbox events back in time, past the grace period of the sweep
+func (t *fakeTable) age(by time.Duration) {
+	for _, event := range t.outbox {
+		event.CreatedAt = event.CreatedAt.Add(-by)
+	}
+}
+
+type fakeTransactionRepo struct {
+	table *fakeTable
+}
+
+func (r *fakeTransactionRepo) Execute(ctx context.Context, transactionBuilder *database.TransactionBuilder) *apperrors.AppError {
+	for _, write := range r.table.staged {
+		write()
+	}
+	r.table.staged = nil
+	r.table.commits++
+	return nil
+}
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	table *fakeTable
+}
+
+func (r *fakeUserRepo) GetById(ctx context.Context, userId string) (*models.User, *apperrors.AppError) {
+	user, ok := r.table.users[userId]
+	if !ok {
+		return nil, apperrors.New(apperrors.CodeNotFound, "user not found")
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *fakeUserRepo) GetCreateTransaction(ctx context.Context, user *models.User) (types.Put, *apperrors.AppError) {
+	created := *user
+	created.Version = 1
+	r.table.stage(func() { r.table.users[created.UserId] = &created })
+	return types.Put{}, nil
+}
+
+func (r *fakeUserRepo) GetBalanceUpdateTransaction(
+	ctx context.Context,
+	user *models.User,
+	currency models.Currency,
+	delta int,
+	progress models.Progress,
+) types.Update {
+	balance := user.Balance(currency) + delta
+	r.table.stage(func() {
+		stored := r.table.users[user.UserId]
+		stored.SetBalance(currency, balance)
+		stored.SetProgress(progress)
+		stored.Version++
+	})
+	return types.Update{}
+}
+
+type fakeCoinLedgerRepo struct {
+	repository.CoinLedgerRepository
+}
+
+func (fakeCoinLedgerRepo) GetCreateTransaction(ctx context.Context, coinTransaction *models.CoinTransaction) (types.Put, *apperrors.AppError) {
+	return types.Put{}, nil
+}
+
+type fakeDisplayNameRepo struct {
+	repository.DisplayNameRepository
+}
+
+func (fakeDisplayNameRepo) GetCreateTransaction(ctx context.Context, userId, displayName string) (types.Put, *apperrors.AppError) {
+	return types.Put{}, nil
+}
+
+type fakeReferralRepo struct {
+	repository.ReferralRepository
+}
+
+func (fakeReferralRepo) GetCodeCreateTransaction(ctx context.Context, userId, code string) (types.Put, *apperrors.AppError) {
+	return types.Put{}, nil
+}
+
+type fakeOutboxRepo struct {
+	table *fakeTable
+}
+
+func (r *fakeOutboxRepo) ListPending(ctx context.Context, writtenBefore time.Time, limit int) ([]*models.OutboxEvent, *apperrors.AppError) {
+	pending := make([]*models.OutboxEvent, 0)
+	for _, event := range r.table.outbox {
+		if event.CreatedAt.Before(writtenBefore) && len(pending) < limit {
+			pending = append(pending, event)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeOutboxRepo) Delete(ctx context.Context, event *models.OutboxEvent) *apperrors.AppError {
+	remaining := r.table.outbox[:0]
+	for _, stored := range r.table.outbox {
+		if stored.EventId != event.EventId {
+			remaining = append(remaining, stored)
+		}
+	}
+	r.table.outbox = remaining
+	return nil
+}
+
+func (r *fakeOutboxRepo) GetCreateTransaction(ctx context.Context, event *models.OutboxEvent) (types.Put, *apperrors.AppError) {
+	r.table.stage(func() { r.table.outbox = append(r.table.outbox, event) })
+	return types.Put{}, nil
+}
+
+type fakePublisher struct {
+	err       *apperrors.AppError
+	published []*models.OutboxEvent
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, event *models.OutboxEvent) *apperrors.AppError {
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, event)
+	return nil
+}
+
+// fakeIdempotencyStore keeps idempotency records in memory
+type fakeIdempotencyStore struct {
+	records map[string]*models.IdempotencyRecord
+}
+
+func newFakeIdempotencyStore() *fakeIdempotencyStore {
+	return &fakeIdempotencyStore{records: make(map[string]*models.IdempotencyRecord)}
+}
+
+func (s *fakeIdempotencyStore) Claim(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, *apperrors.AppError) {
+	if existing, ok := s.records[record.PK]; ok {
+		copied := *existing
+		return &copied, nil
+	}
+	copied := *record
+	s.records[record.PK] = &copied
+	return nil, nil
+}
+
+func (s *fakeIdempotencyStore) Complete(ctx context.Context, pk string, response []byte) *apperrors.AppError {
+	s.records[pk].Status = models.IdempotencyStatusCompleted
+	s.records[pk].Response = response
+	return nil
+}
+
+func (s *fakeIdempotencyStore) Release(ctx context.Context, pk string) *apperrors.AppError {
+	if record, ok := s.records[pk]; ok && record.Status == models.IdempotencyStatusInProgress {
+		delete(s.records, pk)
+	}
+	return nil
+}
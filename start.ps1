@@ -1,4 +1,17 @@
 # start.ps1
+
+# Generate the local secrets once, docker compose reads them from .env
+function Ensure-Secret($name) {
+    if (-not (Test-Path .env) -or -not (Select-String -Path .env -Pattern "^$name=" -Quiet)) {
+        $bytes = New-Object byte[] 32
+        [System.Security.Cryptography.RandomNumberGenerator]::Create().GetBytes($bytes)
+        Add-Content -Path .env -Value "$name=$([Convert]::ToBase64String($bytes) -replace '[=+/]', '')"
+    }
+}
+
+Ensure-Secret "GOODSWIPE_AUTH_DEV_SECRET"
+Ensure-Secret "GOODSWIPE_TOURNAMENT_API_KEY"
+
 Write-Host "Starting application..." -ForegroundColor Green
 docker compose up --build -d
 
@@ -1,5 +1,15 @@
 #!/bin/bash
 
+# Generate the local secrets once, docker compose reads them from .env
+ensure_secret() {
+    if ! grep -q "^$1=" .env 2>/dev/null; then
+        echo "$1=$(head -c 32 /dev/urandom | base64 | tr -d '\n=+/')" >> .env
+    fi
+}
+
+ensure_secret GOODSWIPE_AUTH_DEV_SECRET
+ensure_secret GOODSWIPE_TOURNAMENT_API_KEY
+
 # Start Docker Compose services
 echo "Starting application..."
 docker compose up --build -d